@@ -12,10 +12,22 @@ import (
 type (
 	// Config -.
 	Config struct {
-		App  App
-		HTTP HTTP
-		Log  Log
-		PG   PG
+		App       App
+		HTTP      HTTP
+		Log       Log
+		PG        PG
+		Locale    Locale
+		News      News
+		Page      Page
+		Menu      Menu
+		Render    Render
+		Site      Site
+		Feed      Feed
+		Sitemap   Sitemap
+		HTML      HTML
+		Media     Media
+		Comment   Comment
+		RateLimit RateLimit
 		JWT
 	}
 
@@ -27,7 +39,8 @@ type (
 
 	// HTTP -.
 	HTTP struct {
-		Port string `env-required:"true" env:"HTTP_PORT"`
+		Port           string   `env-required:"true" env:"HTTP_PORT"`
+		TrustedProxies []string `env-separator:"," env:"HTTP_TRUSTED_PROXIES"`
 	}
 
 	// Log -.
@@ -45,6 +58,122 @@ type (
 		PoolMax  int    `env-required:"true" env:"POSTGRES_POOL_MAX"`
 	}
 
+	// Locale -.
+	Locale struct {
+		Supported []string `env-default:"en,id" env-separator:"," env:"LOCALES"`
+	}
+
+	// News -.
+	News struct {
+		Reactions     []string `env-default:"like,love,insightful,funny,sad" env-separator:"," env:"NEWS_REACTIONS"`
+		ExcerptLength int      `env-default:"200" env:"NEWS_EXCERPT_LENGTH"`
+	}
+
+	// Page -.
+	Page struct {
+		ServeCustomURLs bool `env-default:"false" env:"PAGE_SERVE_CUSTOM_URLS"`
+	}
+
+	// Menu -.
+	Menu struct {
+		CategoryHref string `env-default:"/categories/{id}" env:"MENU_CATEGORY_HREF"`
+		NewsHref     string `env-default:"/news/{id}" env:"MENU_NEWS_HREF"`
+	}
+
+	// Render -.
+	Render struct {
+		Enabled   bool   `env-default:"false" env:"RENDER_ENABLED"`
+		ThemeDir  string `env-default:"themes/default" env:"RENDER_THEME_DIR"`
+		HotReload bool   `env-default:"false" env:"RENDER_HOT_RELOAD"`
+		Menu      string `env-default:"main" env:"RENDER_MENU"`
+	}
+
+	// Site -.
+	Site struct {
+		URL         string `env-default:"http://localhost:8080" env:"SITE_URL,FEED_SITE_URL"`
+		NewsURL     string `env-default:"http://localhost:8080/news/{id}" env:"SITE_NEWS_URL,FEED_NEWS_URL"`
+		CategoryURL string `env-default:"http://localhost:8080/categories/{id}" env:"SITE_CATEGORY_URL,FEED_CATEGORY_URL"`
+	}
+
+	// Feed -.
+	Feed struct {
+		Title       string `env-default:"News" env:"FEED_TITLE"`
+		Description string `env-default:"The latest news" env:"FEED_DESCRIPTION"`
+		ItemCount   int    `env-default:"20" env:"FEED_ITEM_COUNT"`
+		FullContent bool   `env-default:"false" env:"FEED_FULL_CONTENT"`
+	}
+
+	// Sitemap -.
+	Sitemap struct {
+		URLsPerFile     int    `env-default:"50000" env:"SITEMAP_URLS_PER_FILE"`
+		Gzip            bool   `env-default:"false" env:"SITEMAP_GZIP"`
+		NewsPublication string `env-default:"News" env:"SITEMAP_NEWS_PUBLICATION"`
+		NewsLanguage    string `env-default:"en" env:"SITEMAP_NEWS_LANGUAGE"`
+	}
+
+	// HTML -.
+	HTML struct {
+		Sanitize        bool     `env-default:"true" env:"HTML_SANITIZE"`
+		Elements        []string `env-default:"p,br,hr,h1,h2,h3,h4,h5,h6,strong,b,em,i,u,s,sub,sup,blockquote,pre,code,ul,ol,li,a,img,figure,figcaption,table,thead,tbody,tr,th,td,span,div" env-separator:"," env:"HTML_ALLOWED_ELEMENTS"`
+		Attributes      []string `env-default:"a:href,a:title,img:src,img:alt,img:title,img:width,img:height,th:colspan,th:rowspan,th:align,td:colspan,td:rowspan,td:align,*:id,*:class,*:style" env-separator:"," env:"HTML_ALLOWED_ATTRIBUTES"`
+		URLSchemes      []string `env-default:"http,https,mailto" env-separator:"," env:"HTML_ALLOWED_URL_SCHEMES"`
+		StyleProperties []string `env-default:"color,background-color,text-align,font-weight,font-style,text-decoration" env-separator:"," env:"HTML_ALLOWED_STYLES"`
+		RawAuthors      []string `env-separator:"," env:"HTML_RAW_AUTHORS"`
+	}
+
+	// Media -.
+	Media struct {
+		Storage      string   `env-default:"local" env:"MEDIA_STORAGE"`
+		LocalDir     string   `env-default:"media" env:"MEDIA_LOCAL_DIR"`
+		PublicURL    string   `env-default:"/media" env:"MEDIA_PUBLIC_URL"`
+		MaxSize      int64    `env-default:"10485760" env:"MEDIA_MAX_SIZE"`
+		AllowedTypes []string `env-default:"image/jpeg,image/png,image/gif,image/webp,application/pdf" env-separator:"," env:"MEDIA_ALLOWED_TYPES"`
+		S3           MediaS3
+
+		Sizes               []string `env-default:"thumbnail:150x150,small:480,medium:960,large:1600" env-separator:"," env:"MEDIA_SIZES"`
+		JPEGQuality         int      `env-default:"85" env:"MEDIA_JPEG_QUALITY"`
+		MaxPixels           int      `env-default:"40000000" env:"MEDIA_MAX_PIXELS"`
+		DerivativesOnUpload bool     `env-default:"false" env:"MEDIA_DERIVATIVES_ON_UPLOAD"`
+	}
+
+	// MediaS3 -.
+	MediaS3 struct {
+		Endpoint  string `env:"MEDIA_S3_ENDPOINT"`
+		Region    string `env-default:"us-east-1" env:"MEDIA_S3_REGION"`
+		Bucket    string `env:"MEDIA_S3_BUCKET"`
+		AccessKey string `env:"MEDIA_S3_ACCESS_KEY"`
+		SecretKey string `env:"MEDIA_S3_SECRET_KEY"`
+		PathStyle bool   `env-default:"true" env:"MEDIA_S3_PATH_STYLE"`
+	}
+
+	// Comment -.
+	Comment struct {
+		MaxDepth          int           `env-default:"5" env:"COMMENT_MAX_DEPTH"`
+		ModerationPolicy  string        `env-default:"auto_approve" env:"COMMENT_MODERATION_POLICY"`
+		ProfanityWords    []string      `env-separator:"," env:"COMMENT_PROFANITY_WORDS"`
+		ProfanityPatterns []string      `env-separator:";" env:"COMMENT_PROFANITY_PATTERNS"`
+		ProfanityAction   string        `env-default:"mask" env:"COMMENT_PROFANITY_ACTION"`
+		MaxLinks          int           `env-default:"2" env:"COMMENT_MAX_LINKS"`
+		DuplicateWindow   time.Duration `env-default:"24h" env:"COMMENT_DUPLICATE_WINDOW"`
+		SpamThreshold     float64       `env-default:"0.9" env:"COMMENT_SPAM_THRESHOLD"`
+		PowDifficulty     int           `env-default:"18" env:"COMMENT_POW_DIFFICULTY"`
+		PowTTL            time.Duration `env-default:"10m" env:"COMMENT_POW_TTL"`
+		PowSecret         string        `env:"COMMENT_POW_SECRET"`
+		EditWindow        time.Duration `env-default:"15m" env:"COMMENT_EDIT_WINDOW"`
+		CloseAfterDays    int           `env-default:"0" env:"COMMENT_CLOSE_AFTER_DAYS"`
+		ReportThreshold   int           `env-default:"3" env:"COMMENT_REPORT_THRESHOLD"`
+	}
+
+	// RateLimit -.
+	RateLimit struct {
+		Backend       string        `env-default:"memory" env:"RATE_LIMIT_BACKEND"`
+		LoginLimit    int           `env-default:"5" env:"RATE_LIMIT_LOGIN_LIMIT"`
+		LoginPeriod   time.Duration `env-default:"1m" env:"RATE_LIMIT_LOGIN_PERIOD"`
+		CommentLimit  int           `env-default:"10" env:"RATE_LIMIT_COMMENT_LIMIT"`
+		CommentPeriod time.Duration `env-default:"1m" env:"RATE_LIMIT_COMMENT_PERIOD"`
+		PurgeInterval time.Duration `env-default:"5m" env:"RATE_LIMIT_PURGE_INTERVAL"`
+	}
+
 	// JWT -.
 	JWT struct {
 		AccessTokenSecretKey  string        `env-required:"true" env:"ACCESS_TOKEN_SECRET_KEY"`
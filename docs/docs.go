@@ -64,6 +64,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -127,7 +133,7 @@ const docTemplate = `{
         },
         "/categories": {
             "get": {
-                "description": "Retrieve a list of all categories",
+                "description": "Retrieve a list of all categories, one translation of each in the locale the reader prefers",
                 "consumes": [
                     "application/json"
                 ],
@@ -138,6 +144,15 @@ const docTemplate = `{
                     "Categories"
                 ],
                 "summary": "Get all categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "id,en",
+                        "description": "Comma separated locales to list translations in, best first, overriding Accept-Language; * lists every translation",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "List of categories",
@@ -154,11 +169,6 @@ const docTemplate = `{
                 }
             },
             "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
                 "description": "Create a new category (requires authentication)",
                 "consumes": [
                     "application/json"
@@ -189,9 +199,9 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid request payload, locale or translation",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
                         }
                     },
                     "401": {
@@ -200,13 +210,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Translation already exists in the locale",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
         "/categories/{id}": {
@@ -229,6 +250,13 @@ const docTemplate = `{
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,en",
+                        "description": "Comma separated locales, best first, to return the translation ranked best in instead",
+                        "name": "lang",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -253,11 +281,6 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
                 "description": "Update an existing category (requires authentication)",
                 "consumes": [
                     "application/json"
@@ -295,9 +318,9 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid request payload or locale",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
                         }
                     },
                     "401": {
@@ -312,20 +335,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Translation already exists in the locale",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
+                ]
+            },
+            "delete": {
                 "description": "Delete a category by ID (requires authentication)",
                 "consumes": [
                     "application/json"
@@ -371,12 +400,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/news": {
+        "/comments/moderation": {
             "get": {
-                "description": "Retrieve a list of all news articles",
+                "description": "Retrieve comments waiting for moderation, or comments in another moderation status (requires authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -384,31 +418,134 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
+                ],
+                "summary": "Get the comment moderation queue",
+                "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "approved",
+                            "rejected",
+                            "spam"
+                        ],
+                        "type": "string",
+                        "default": "pending",
+                        "description": "Moderation status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of comments",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of comments to skip",
+                        "name": "offset",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Get all news",
                 "responses": {
                     "200": {
-                        "description": "List of news",
+                        "description": "List of comments",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "400": {
+                        "description": "Invalid status, limit or offset",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "post": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
+                ]
+            }
+        },
+        "/comments/moderation/approve": {
+            "post": {
+                "description": "Publish one or more comments (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Approve comments",
+                "parameters": [
+                    {
+                        "description": "Comment IDs",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ModerateComments"
+                        }
+                    }
                 ],
-                "description": "Create a new news article (requires authentication)",
+                "responses": {
+                    "200": {
+                        "description": "Comments approved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/comments/moderation/reject": {
+            "post": {
+                "description": "Hide one or more comments, optionally marking them as spam (requires authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -416,23 +553,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
                 ],
-                "summary": "Create a new news article",
+                "summary": "Reject comments",
                 "parameters": [
                     {
-                        "description": "News information",
+                        "description": "Comment IDs",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.News"
+                            "$ref": "#/definitions/request.RejectComments"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "News created successfully",
+                    "200": {
+                        "description": "Comments rejected successfully",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -455,12 +592,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/news/{id}": {
+        "/comments/moderation/reports": {
             "get": {
-                "description": "Retrieve a single news article by its ID",
+                "description": "Retrieve comments with open reports, most reported first (requires authentication).\nApproving or rejecting a comment closes its reports.",
                 "consumes": [
                     "application/json"
                 ],
@@ -468,27 +610,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
                 ],
-                "summary": "Get news by ID",
+                "summary": "Get reported comments",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "News ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Maximum number of comments",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Number of comments to skip",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "News detail",
+                        "description": "List of reported comments",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "404": {
-                        "description": "News not found",
+                    "400": {
+                        "description": "Invalid limit or offset",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -499,15 +657,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Update an existing news article (requires authentication)",
+                ]
+            }
+        },
+        "/comments/{id}": {
+            "put": {
+                "description": "Edit the text of a comment. Authors send the edit token returned when the comment was created in the\nX-Edit-Token header and may edit only within the edit window; their edits are filtered and moderated\nagain. Authenticated moderators may edit any comment without a token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -515,36 +675,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
                 ],
-                "summary": "Update a news article",
+                "summary": "Update a comment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "News ID",
+                        "description": "Comment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated news information",
+                        "type": "string",
+                        "description": "Edit token of the comment author",
+                        "name": "X-Edit-Token",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Comment text",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateNews"
+                            "$ref": "#/definitions/request.UpdateComment"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "News updated successfully",
+                        "description": "Comment updated successfully",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid request payload or comment rejected by content filter",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -555,8 +721,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Invalid edit token or comment can no longer be edited",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "News not found",
+                        "description": "Comment not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -567,15 +739,15 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Delete a news article by ID (requires authentication)",
+                ]
+            },
+            "delete": {
+                "description": "Delete a comment together with its replies. Authors send the edit token returned when the comment\nwas created in the X-Edit-Token header and may delete only within the edit window. Authenticated\nmoderators may delete any comment without a token.",
                 "consumes": [
                     "application/json"
                 ],
@@ -583,21 +755,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "News"
+                    "Comments"
                 ],
-                "summary": "Delete a news article",
+                "summary": "Delete a comment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "News ID",
+                        "description": "Comment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Edit token of the comment author",
+                        "name": "X-Edit-Token",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "News deleted successfully",
+                        "description": "Comment deleted successfully",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -608,8 +786,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Invalid edit token or comment can no longer be edited",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "News not found",
+                        "description": "Comment not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -620,12 +804,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/news/{id}/comments": {
+        "/comments/{id}/reports": {
             "post": {
-                "description": "Create a new comment on a specific news article",
+                "description": "Flag an approved comment as abusive. Each reader, identified by user or visitor fingerprint, counts\nonce per comment. Once enough readers report it, the comment is hidden and sent back to the\nmoderation queue.",
                 "consumes": [
                     "application/json"
                 ],
@@ -635,34 +824,40 @@ const docTemplate = `{
                 "tags": [
                     "Comments"
                 ],
-                "summary": "Create a comment on a news article",
+                "summary": "Report a comment",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "News ID",
+                        "description": "Comment ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Comment information",
+                        "description": "Report",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.Comment"
+                            "$ref": "#/definitions/request.ReportComment"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Comment created successfully",
+                    "200": {
+                        "description": "Comment reported",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "400": {
-                        "description": "Invalid request payload",
+                        "description": "Invalid request payload or report reason",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -676,9 +871,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/pages": {
-            "get": {
-                "description": "Retrieve a list of all custom pages",
+        "/comments/{id}/votes": {
+            "post": {
+                "description": "Upvote (1) or downvote (-1) an approved comment, or withdraw the vote (0). Each reader, identified by\nuser or visitor fingerprint, has one vote per comment. Returns the updated vote counts.",
                 "consumes": [
                     "application/json"
                 ],
@@ -686,17 +881,1447 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "CustomPages"
+                    "Comments"
+                ],
+                "summary": "Vote on a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Vote",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CommentVote"
+                        }
+                    }
                 ],
-                "summary": "Get all custom pages",
                 "responses": {
                     "200": {
-                        "description": "List of custom pages",
+                        "description": "Vote counts",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "500": {
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/media": {
+            "get": {
+                "description": "Retrieve every file in the media library, newest first (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Get all media",
+                "responses": {
+                    "200": {
+                        "description": "List of media",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Upload a file to the media library (requires authentication). The file type is detected from its\ncontent and must be one of the allowed types. Uploading a file already in the library returns the\nexisting media with status 200.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Upload media",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "File to upload",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Alternative text",
+                        "name": "alt_text",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Media already in the library",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "201": {
+                        "description": "Media uploaded successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported media type",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/media/{id}": {
+            "get": {
+                "description": "Retrieve a media file's metadata and the news and custom pages embedding it (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Get media by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Media ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Media detail",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Media not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "Update the alternative text and focal point of a media file (requires authentication). Cropped\nsizes are derived around the focal point.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Update media",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Media ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Media metadata",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateMedia"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Media updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Media not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Delete a media file from the library and from storage (requires authentication). Files embedded in\nnews or custom pages cannot be deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Media"
+                ],
+                "summary": "Delete media",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Media ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Media deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Media not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Media is referenced by content",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/menus": {
+            "get": {
+                "description": "Retrieve the names of all menus, without their items (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "Get all menus",
+                "responses": {
+                    "200": {
+                        "description": "List of menus",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/menus/{name}": {
+            "get": {
+                "description": "Retrieve a menu with its nested items resolved into titles and hrefs.\nItems whose page, category or news article was deleted are returned with broken set and no href.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "Get menu by name",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "main",
+                        "description": "Menu name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Menu detail",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Menu not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create the named menu, or replace all of its items, with the submitted item tree (requires authentication).\nNames are lowercase letters, digits, hyphens and underscores. Items nest up to 3 levels.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "Create or replace a menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "main",
+                        "description": "Menu name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Menu items",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Menu"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Menu saved successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Delete a menu and all of its items by name (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Menus"
+                ],
+                "summary": "Delete a menu",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "main",
+                        "description": "Menu name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Menu deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Menu not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/news": {
+            "get": {
+                "description": "Retrieve a list of all news articles, one translation of each in the locale the reader prefers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get all news",
+                "parameters": [
+                    {
+                        "enum": [
+                            "html",
+                            "source",
+                            "text"
+                        ],
+                        "type": "string",
+                        "default": "html",
+                        "description": "Content as sanitized HTML, as written in its content_format, or as plain text",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,en",
+                        "description": "Comma separated locales to list translations in, best first, overriding Accept-Language; * lists every translation",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of news",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid render",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new news article (requires authentication). HTML in the content is sanitized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Create a new news article",
+                "parameters": [
+                    {
+                        "description": "News information",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.News"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "News created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload, content blocks, featured media, locale or translation",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Translation already exists in the locale",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/news/{id}": {
+            "get": {
+                "description": "Retrieve a single news article by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Get news by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html",
+                            "source",
+                            "text"
+                        ],
+                        "type": "string",
+                        "default": "html",
+                        "description": "Content as sanitized HTML, as written in its content_format, or as plain text",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,en",
+                        "description": "Comma separated locales, best first, to return the translation ranked best in instead",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News detail",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid render",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing news article (requires authentication). HTML in the content is sanitized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Update a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated news information",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateNews"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload, content blocks, featured media or locale",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Translation already exists in the locale",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Delete a news article by ID (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Delete a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "News deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/news/{id}/comments": {
+            "get": {
+                "description": "Retrieve the approved comments of a news article as a reply tree or as a flat list with depth and path.\nComments carry their vote counts; the top sort ranks replies to the same comment by score.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get comments of a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "tree",
+                            "flat"
+                        ],
+                        "type": "string",
+                        "default": "tree",
+                        "description": "Response shape",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "oldest",
+                            "top"
+                        ],
+                        "type": "string",
+                        "default": "oldest",
+                        "description": "Order of replies to the same comment",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of comments",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid format or sort",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new comment on a specific news article, optionally as a reply to another comment.\nA solved proof-of-work challenge from GET /news/{id}/comments/challenge is required unless disabled.\nContent filters may mask words, reject the comment, or hold it for moderation. Otherwise the\nmoderation policy decides whether the comment is published instantly or queued for approval.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Create a comment on a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment information",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Comment"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Comment created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload or comment rejected by content filter",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Comments are closed",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Challenge already used",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/comments/challenge": {
+            "get": {
+                "description": "Issue a signed challenge for commenting on a news article. Solve it by finding a nonce such that\nsha256(challenge + \":\" + nonce) starts with ` + "`" + `difficulty` + "`" + ` zero bits, then send both with the comment.\nThe challenge is null when proof of work is disabled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Get a proof-of-work challenge for commenting",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Proof-of-work challenge",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/reactions": {
+            "post": {
+                "description": "Leave a reaction on a news article. Each reader, identified by user or visitor fingerprint, counts\nonce per reaction. Returns the updated count of every configured reaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "React to a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reaction",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.Reaction"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reaction counts",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload or reaction",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/news/{id}/reactions/{reaction}": {
+            "delete": {
+                "description": "Remove the reader's reaction from a news article and return the updated reaction counts",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "News"
+                ],
+                "summary": "Withdraw a reaction from a news article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "News ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reaction",
+                        "name": "reaction",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reaction counts",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid reaction",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "News not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages": {
+            "get": {
+                "description": "Retrieve a list of all custom pages, one translation of each in the locale the reader prefers",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomPages"
+                ],
+                "summary": "Get all custom pages",
+                "parameters": [
+                    {
+                        "enum": [
+                            "html",
+                            "source",
+                            "text"
+                        ],
+                        "type": "string",
+                        "default": "html",
+                        "description": "Content as sanitized HTML, as written in its content_format, or as plain text",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,en",
+                        "description": "Comma separated locales to list translations in, best first, overriding Accept-Language; * lists every translation",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of custom pages",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid render",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new custom page (requires authentication). With a slug, the custom URL is the\nparent's URL followed by the slug, and follows the parent when it moves. HTML in the content is\nsanitized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomPages"
+                ],
+                "summary": "Create a new custom page",
+                "parameters": [
+                    {
+                        "description": "Page information",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.CustomPage"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Page created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload, custom URL, content blocks, locale or translation",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Custom URL already in use or translation already exists in the locale",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/pages/by-url": {
+            "get": {
+                "description": "Retrieve a single custom page by its custom URL, with breadcrumbs. The path is matched\ncase-insensitively and regardless of trailing slashes or percent-encoding.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomPages"
+                ],
+                "summary": "Get custom page by URL",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "/about-us",
+                        "description": "Custom URL",
+                        "name": "path",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html",
+                            "source",
+                            "text"
+                        ],
+                        "type": "string",
+                        "default": "html",
+                        "description": "Content as sanitized HTML, as written in its content_format, or as plain text",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,en",
+                        "description": "Comma separated locales, best first, to return the translation ranked best in instead",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page detail",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid custom URL",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/tree": {
+            "get": {
+                "description": "Retrieve every custom page nested under its parent, without content. Siblings are ordered by\nposition, then custom URL.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomPages"
+                ],
+                "summary": "Get the custom page tree",
+                "responses": {
+                    "200": {
+                        "description": "Page tree",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/pages/{id}": {
+            "get": {
+                "description": "Retrieve a single custom page by its ID, with breadcrumbs from the root page down to it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomPages"
+                ],
+                "summary": "Get custom page by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "html",
+                            "source",
+                            "text"
+                        ],
+                        "type": "string",
+                        "default": "html",
+                        "description": "Content as sanitized HTML, as written in its content_format, or as plain text",
+                        "name": "render",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "id,en",
+                        "description": "Comma separated locales, best first, to return the translation ranked best in instead",
+                        "name": "lang",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page detail",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid render",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
                         "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
@@ -704,13 +2329,82 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
+                "description": "Update an existing custom page (requires authentication). Moving a page redirects its old URL\nand re-paths the descendants whose URL is derived from it. HTML in the content is sanitized.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "CustomPages"
+                ],
+                "summary": "Update a custom page",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated page information",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateCustomPage"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request payload, custom URL, content blocks, locale or translation",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Custom URL already in use or translation already exists in the locale",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Create a new custom page (requires authentication)",
+                ]
+            },
+            "delete": {
+                "description": "Delete a custom page by ID (requires authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -720,21 +2414,114 @@ const docTemplate = `{
                 "tags": [
                     "CustomPages"
                 ],
-                "summary": "Create a new custom page",
+                "summary": "Delete a custom page",
                 "parameters": [
                     {
-                        "description": "Page information",
+                        "type": "string",
+                        "description": "Page ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Page deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Page not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/redirects": {
+            "get": {
+                "description": "Retrieve all managed redirects, ordered by source path (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Get all redirects",
+                "responses": {
+                    "200": {
+                        "description": "List of redirects",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Redirect a site path to another path or an absolute http(s) URL (requires authentication).\nThe status code defaults to 301. A target that is itself redirected is replaced by the end of\nits chain, and redirects that would loop are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Create a redirect",
+                "parameters": [
+                    {
+                        "description": "Redirect",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.CustomPage"
+                            "$ref": "#/definitions/request.Redirect"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Page created successfully",
+                        "description": "Redirect created successfully",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -742,7 +2529,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid request payload",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
                         }
                     },
                     "401": {
@@ -751,18 +2538,29 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Source path already redirected or redirect would loop",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/pages/{id}": {
+        "/redirects/{id}": {
             "get": {
-                "description": "Retrieve a single custom page by its ID",
+                "description": "Retrieve a single redirect by its ID (requires authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -770,13 +2568,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "CustomPages"
+                    "Redirects"
                 ],
-                "summary": "Get custom page by ID",
+                "summary": "Get redirect by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Page ID",
+                        "description": "Redirect ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -784,13 +2582,19 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Page detail",
+                        "description": "Redirect detail",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
                     "404": {
-                        "description": "Page not found",
+                        "description": "Redirect not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -801,15 +2605,15 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Update an existing custom page (requires authentication)",
+                ]
+            },
+            "put": {
+                "description": "Update an existing redirect (requires authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -817,30 +2621,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "CustomPages"
+                    "Redirects"
                 ],
-                "summary": "Update a custom page",
+                "summary": "Update a redirect",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Page ID",
+                        "description": "Redirect ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated page information",
+                        "description": "Redirect",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.UpdateCustomPage"
+                            "$ref": "#/definitions/request.UpdateRedirect"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Page updated successfully",
+                        "description": "Redirect updated successfully",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -848,7 +2652,66 @@ const docTemplate = `{
                     "400": {
                         "description": "Invalid request payload",
                         "schema": {
-                            "$ref": "#/definitions/response.ErrorResponse"
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Redirect not found",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Source path already redirected or redirect would loop",
+                        "schema": {
+                            "$ref": "#/definitions/response.ValidationErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/response.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Delete a redirect by ID (requires authentication)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Redirects"
+                ],
+                "summary": "Delete a redirect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Redirect ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Redirect deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
                         }
                     },
                     "401": {
@@ -858,7 +2721,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Page not found",
+                        "description": "Redirect not found",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -869,15 +2732,17 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
                     }
-                ],
-                "description": "Delete a custom page by ID (requires authentication)",
+                ]
+            }
+        },
+        "/translations/missing": {
+            "get": {
+                "description": "Retrieve news, categories and custom pages not yet translated into every supported locale, with the\ntranslations written so far and the locales still missing (requires authentication)",
                 "consumes": [
                     "application/json"
                 ],
@@ -885,33 +2750,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "CustomPages"
+                    "Translations"
                 ],
-                "summary": "Delete a custom page",
+                "summary": "Get missing translations",
                 "parameters": [
                     {
+                        "enum": [
+                            "news",
+                            "category",
+                            "page"
+                        ],
                         "type": "string",
-                        "description": "Page ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Type of content, every type when omitted",
+                        "name": "type",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Page deleted successfully",
+                        "description": "Content missing translations",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Invalid type",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Page not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
@@ -922,7 +2791,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/response.ErrorResponse"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         }
     },
@@ -950,9 +2824,18 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "example": "id"
+                },
                 "name": {
                     "type": "string",
                     "example": "Technology"
+                },
+                "translation_of": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
                 }
             }
         },
@@ -963,6 +2846,10 @@ const docTemplate = `{
                 "name"
             ],
             "properties": {
+                "challenge": {
+                    "type": "string",
+                    "example": "NTUwZTg0MDAtZTI5Yi00MWQ0.c2lnbmF0dXJl"
+                },
                 "comment": {
                     "type": "string",
                     "example": "This is a great article!"
@@ -970,23 +2857,149 @@ const docTemplate = `{
                 "name": {
                     "type": "string",
                     "example": "John Doe"
+                },
+                "nonce": {
+                    "type": "string",
+                    "example": "48213"
+                },
+                "parent_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440000"
+                }
+            }
+        },
+        "request.CommentVote": {
+            "type": "object",
+            "required": [
+                "value"
+            ],
+            "properties": {
+                "value": {
+                    "type": "integer",
+                    "enum": [
+                        -1,
+                        0,
+                        1
+                    ],
+                    "example": 1
                 }
             }
         },
         "request.CustomPage": {
             "type": "object",
             "required": [
-                "content",
-                "custom_url"
+                "content"
             ],
             "properties": {
                 "content": {
                     "type": "string",
                     "example": "\u003ch1\u003eAbout Us\u003c/h1\u003e\u003cp\u003eThis is our about page...\u003c/p\u003e"
                 },
+                "content_format": {
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown",
+                        "plaintext",
+                        "blocks"
+                    ],
+                    "example": "html"
+                },
                 "custom_url": {
                     "type": "string",
+                    "maxLength": 150,
                     "example": "/about-us"
+                },
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "example": "id"
+                },
+                "parent_id": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                },
+                "position": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "billing"
+                },
+                "translation_of": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                }
+            }
+        },
+        "request.Menu": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.MenuItem"
+                    }
+                }
+            }
+        },
+        "request.MenuItem": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/request.MenuItem"
+                    }
+                },
+                "target_id": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "About us"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "page",
+                        "category",
+                        "news",
+                        "url"
+                    ],
+                    "example": "page"
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com"
+                }
+            }
+        },
+        "request.ModerateComments": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
                 }
             }
         },
@@ -998,17 +3011,143 @@ const docTemplate = `{
                 "title"
             ],
             "properties": {
+                "canonical_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/news/technology-advances"
+                },
                 "category_id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
+                "comments_close_at": {
+                    "type": "string",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "comments_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "content": {
                     "type": "string",
                     "example": "This is the full content of the news article..."
                 },
+                "content_format": {
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown",
+                        "plaintext",
+                        "blocks"
+                    ],
+                    "example": "markdown"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "A short summary shown in listings"
+                },
+                "featured_media_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440030"
+                },
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "example": "id"
+                },
+                "meta_description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "What changed in technology this year"
+                },
+                "meta_title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Technology Advances in 2025"
+                },
+                "noindex": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "og_description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "What changed in technology this year"
+                },
+                "og_image": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/media/og.png"
+                },
+                "og_title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Technology Advances"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "technology",
+                        "ai"
+                    ]
+                },
                 "title": {
                     "type": "string",
                     "example": "Breaking News: Technology Advances"
+                },
+                "translation_of": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440010"
+                }
+            }
+        },
+        "request.Reaction": {
+            "type": "object",
+            "required": [
+                "reaction"
+            ],
+            "properties": {
+                "reaction": {
+                    "type": "string",
+                    "example": "like"
+                }
+            }
+        },
+        "request.Redirect": {
+            "type": "object",
+            "required": [
+                "source_path",
+                "target"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2027-01-01T00:00:00Z"
+                },
+                "source_path": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "/about-company"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "enum": [
+                        301,
+                        302,
+                        307,
+                        308
+                    ],
+                    "example": 301
+                },
+                "target": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "/about-us"
                 }
             }
         },
@@ -1023,32 +3162,147 @@ const docTemplate = `{
                 }
             }
         },
+        "request.RejectComments": {
+            "type": "object",
+            "required": [
+                "ids"
+            ],
+            "properties": {
+                "ids": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "550e8400-e29b-41d4-a716-446655440000"
+                    ]
+                },
+                "spam": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "request.ReportComment": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "Insults other readers"
+                },
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "spam",
+                        "abuse",
+                        "harassment",
+                        "off_topic",
+                        "other"
+                    ],
+                    "example": "abuse"
+                }
+            }
+        },
         "request.UpdateCategory": {
             "type": "object",
             "required": [
                 "name"
             ],
             "properties": {
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "example": "id"
+                },
                 "name": {
                     "type": "string",
                     "example": "Updated Technology"
                 }
             }
         },
+        "request.UpdateComment": {
+            "type": "object",
+            "required": [
+                "comment"
+            ],
+            "properties": {
+                "comment": {
+                    "type": "string",
+                    "example": "This is a great article! (edited)"
+                }
+            }
+        },
         "request.UpdateCustomPage": {
             "type": "object",
             "required": [
-                "content",
-                "custom_url"
+                "content"
             ],
             "properties": {
                 "content": {
                     "type": "string",
                     "example": "\u003ch1\u003eAbout Company\u003c/h1\u003e\u003cp\u003eUpdated content...\u003c/p\u003e"
                 },
+                "content_format": {
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown",
+                        "plaintext",
+                        "blocks"
+                    ],
+                    "example": "html"
+                },
                 "custom_url": {
                     "type": "string",
+                    "maxLength": 150,
                     "example": "/about-company"
+                },
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "example": "id"
+                },
+                "parent_id": {
+                    "type": "string",
+                    "example": "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+                },
+                "position": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "billing"
+                }
+            }
+        },
+        "request.UpdateMedia": {
+            "type": "object",
+            "properties": {
+                "alt_text": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "A sunset over the harbour"
+                },
+                "focal_x": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0,
+                    "example": 0.5
+                },
+                "focal_y": {
+                    "type": "number",
+                    "maximum": 1,
+                    "minimum": 0,
+                    "example": 0.3
                 }
             }
         },
@@ -1060,20 +3314,132 @@ const docTemplate = `{
                 "title"
             ],
             "properties": {
+                "canonical_url": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/news/technology-advances"
+                },
                 "category_id": {
                     "type": "string",
                     "example": "550e8400-e29b-41d4-a716-446655440000"
                 },
+                "comments_close_at": {
+                    "description": "Null removes the closing time, leaving it out keeps the current one",
+                    "type": "string",
+                    "format": "date-time",
+                    "example": "2025-12-31T23:59:59Z"
+                },
+                "comments_enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "content": {
                     "type": "string",
                     "example": "This is the updated content..."
                 },
+                "content_format": {
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown",
+                        "plaintext",
+                        "blocks"
+                    ],
+                    "example": "markdown"
+                },
+                "excerpt": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "A short summary shown in listings"
+                },
+                "featured_media_id": {
+                    "type": "string",
+                    "example": "550e8400-e29b-41d4-a716-446655440030"
+                },
+                "locale": {
+                    "type": "string",
+                    "maxLength": 10,
+                    "example": "id"
+                },
+                "meta_description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "What changed in technology this year"
+                },
+                "meta_title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Technology Advances in 2025"
+                },
+                "noindex": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "og_description": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "example": "What changed in technology this year"
+                },
+                "og_image": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/media/og.png"
+                },
+                "og_title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "example": "Technology Advances"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "technology",
+                        "ai"
+                    ]
+                },
                 "title": {
                     "type": "string",
                     "example": "Updated News Title"
                 }
             }
         },
+        "request.UpdateRedirect": {
+            "type": "object",
+            "required": [
+                "source_path",
+                "target"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "string",
+                    "example": "2027-01-01T00:00:00Z"
+                },
+                "source_path": {
+                    "type": "string",
+                    "maxLength": 150,
+                    "example": "/about-company"
+                },
+                "status_code": {
+                    "type": "integer",
+                    "enum": [
+                        301,
+                        302,
+                        307,
+                        308
+                    ],
+                    "example": 308
+                },
+                "target": {
+                    "type": "string",
+                    "maxLength": 2048,
+                    "example": "https://example.com/about"
+                }
+            }
+        },
         "response.ErrorResponse": {
             "type": "object",
             "properties": {
@@ -1082,6 +3448,23 @@ const docTemplate = `{
                 }
             }
         },
+        "response.FieldError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "required"
+                },
+                "field": {
+                    "type": "string",
+                    "example": "custom_url"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "is required"
+                }
+            }
+        },
         "response.LoginSuccessData": {
             "type": "object",
             "properties": {
@@ -1108,6 +3491,10 @@ const docTemplate = `{
                 "code": {
                     "type": "integer"
                 },
+                "error_code": {
+                    "type": "string",
+                    "example": "invalid_payload"
+                },
                 "message": {
                     "type": "string"
                 }
@@ -1121,6 +3508,20 @@ const docTemplate = `{
                     "$ref": "#/definitions/response.Meta"
                 }
             }
+        },
+        "response.ValidationErrorResponse": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.FieldError"
+                    }
+                },
+                "meta": {
+                    "$ref": "#/definitions/response.Meta"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -1,22 +1,36 @@
 package app
 
 import (
+	"context"
+	"crypto/rand"
 	"fmt"
+	"html/template"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/RizqiSugiarto/coding-test/config"
 	v1 "github.com/RizqiSugiarto/coding-test/internal/controller/http/v1"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
 	repoPg "github.com/RizqiSugiarto/coding-test/internal/repository/postgres"
+	"github.com/RizqiSugiarto/coding-test/internal/repository/storage"
 	"github.com/RizqiSugiarto/coding-test/internal/usecase"
 	"github.com/RizqiSugiarto/coding-test/pkg/httpserver"
 	"github.com/RizqiSugiarto/coding-test/pkg/jwt"
 	"github.com/RizqiSugiarto/coding-test/pkg/logger"
 	pkgPg "github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/RizqiSugiarto/coding-test/pkg/pow"
+	"github.com/RizqiSugiarto/coding-test/pkg/ratelimit"
+	"github.com/RizqiSugiarto/coding-test/pkg/render"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	_randomPowSecretSize     = 32
+	_rateLimitPurgeBatchSize = 1000
+)
+
 // Run creates objects via constructors.
 func Run(cfg *config.Config) {
 	log := logger.New(cfg.Log.Level)
@@ -41,25 +55,86 @@ func Run(cfg *config.Config) {
 	userRepo := repoPg.NewPostgresUserRepo(pg)
 	categoryRepo := repoPg.NewPostgresCategoryRepo(pg)
 	newsRepo := repoPg.NewPostgresNewsRepo(pg)
+	newsReactionRepo := repoPg.NewPostgresNewsReactionRepo(pg)
 	customPageRepo := repoPg.NewPostgresCustomPageRepo(pg)
+	redirectRepo := repoPg.NewPostgresRedirectRepo(pg)
+	menuRepo := repoPg.NewPostgresMenuRepo(pg)
 	commentRepo := repoPg.NewPostgresCommentRepo(pg)
+	commentVoteRepo := repoPg.NewPostgresCommentVoteRepo(pg)
+	commentReportRepo := repoPg.NewPostgresCommentReportRepo(pg)
+	spamTokenRepo := repoPg.NewPostgresSpamTokenRepo(pg)
+	challengeRepo := repoPg.NewPostgresChallengeRepo(pg)
+	mediaRepo := repoPg.NewPostgresMediaRepo(pg)
+	sitemapRepo := repoPg.NewPostgresSitemapRepo(pg)
+	translationRepo := repoPg.NewPostgresTranslationRepo(pg)
+	contentRepo := repoPg.NewPostgresContentRepo(pg)
+
+	commentFilters, err := usecase.NewCommentFilters(cfg.Comment, commentRepo, spamTokenRepo)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - usecase.NewCommentFilters: %w", err))
+	}
+
+	mediaUc, err := usecase.NewMediaUseCase(mediaRepo, newStorage(cfg.Media, log), cfg.Media)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - usecase.NewMediaUseCase: %w", err))
+	}
 
 	// Usecase
+	contentSanitizer := usecase.NewContentSanitizer(cfg.HTML)
+	contentUc := usecase.NewContentUseCase(contentRepo, contentSanitizer)
+	localizer := usecase.NewLocalizer(translationRepo, cfg.Locale)
 	authUc := usecase.NewAuthUseCase(userRepo, jwtManager)
-	categoryUc := usecase.NewCategoryUseCase(categoryRepo)
-	newsUc := usecase.NewNewsUseCase(newsRepo)
-	customPageUc := usecase.NewCustomPageUseCase(customPageRepo)
-	commentUc := usecase.NewCommentUseCase(commentRepo)
+	categoryUc := usecase.NewCategoryUseCase(categoryRepo, localizer)
+	newsUc := usecase.NewNewsUseCase(newsRepo, newsReactionRepo, mediaRepo, contentSanitizer, localizer, cfg.News, cfg.Media)
+	customPageUc := usecase.NewCustomPageUseCase(customPageRepo, redirectRepo, mediaRepo, contentSanitizer, localizer)
+	redirectUc := usecase.NewRedirectUseCase(redirectRepo)
+	menuUc := usecase.NewMenuUseCase(menuRepo, cfg.Menu)
+	commentUc := usecase.NewCommentUseCase(
+		commentRepo,
+		newsRepo,
+		commentVoteRepo,
+		commentReportRepo,
+		challengeRepo,
+		newChallengeIssuer(cfg.Comment, log),
+		cfg.Comment,
+		commentFilters...,
+	)
+	feedUc := usecase.NewFeedUseCase(newsRepo, categoryRepo, cfg.Feed, cfg.Site, cfg.News)
+	sitemapUc := usecase.NewSitemapUseCase(sitemapRepo, cfg.Sitemap, cfg.Site)
+	translationUc := usecase.NewTranslationUseCase(localizer)
 
 	initMigration(pgURL)
 
+	// Stored content must be rendered with the current sanitizer settings
+	// before any of it is served
+	if err = contentUc.RenderStored(context.Background()); err != nil {
+		log.Fatal(fmt.Errorf("app - Run - contentUc.RenderStored: %w", err))
+	}
+
 	if err = seedUsers(userRepo); err != nil {
 		log.Error(fmt.Errorf("app - Run - seedUsers: %w", err))
 	}
 
+	rateLimitStore := newRateLimitStore(cfg.RateLimit, pg, log)
+
+	// Buckets the requests do not clean up are purged in the background
+	if purger, ok := rateLimitStore.(ratelimit.Purger); ok && cfg.RateLimit.PurgeInterval > 0 {
+		purgeCtx, stopPurge := context.WithCancel(context.Background())
+		defer stopPurge()
+
+		go purgeRateLimitBuckets(purgeCtx, purger, cfg.RateLimit.PurgeInterval, log)
+	}
+	renderer := newRenderer(cfg.Render, cfg.HTML, log)
+
 	// HTTP Server
 	handler := gin.New()
-	v1.NewRouter(handler, log, authUc, categoryUc, newsUc, customPageUc, commentUc, jwtManager)
+
+	// Only trust forwarding headers from the configured proxies
+	if err = handler.SetTrustedProxies(cfg.HTTP.TrustedProxies); err != nil {
+		log.Fatal(fmt.Errorf("app - Run - handler.SetTrustedProxies: %w", err))
+	}
+
+	v1.NewRouter(handler, log, authUc, categoryUc, newsUc, customPageUc, redirectUc, menuUc, mediaUc, commentUc, feedUc, sitemapUc, translationUc, jwtManager, rateLimitStore, cfg.RateLimit, cfg.Page, cfg.Media, renderer, cfg.Render)
 	httpServer := httpserver.New(handler, httpserver.Port(cfg.HTTP.Port))
 
 	// Waiting signal
@@ -79,3 +154,111 @@ func Run(cfg *config.Config) {
 		}
 	}
 }
+
+func newRateLimitStore(cfg config.RateLimit, pg *pkgPg.Postgres, log logger.Interface) ratelimit.Store {
+	switch cfg.Backend {
+	case "memory":
+		return ratelimit.NewMemoryStore()
+	case "postgres":
+		return repoPg.NewPostgresRateLimitRepo(pg)
+	default:
+		log.Fatal("app - newRateLimitStore - unknown rate limit backend %q", cfg.Backend)
+
+		return nil
+	}
+}
+
+// purgeRateLimitBuckets deletes refilled rate limit buckets every interval
+// until ctx is done. Each purge runs in batches, outside any request, so it
+// never holds many rows at once.
+func purgeRateLimitBuckets(ctx context.Context, purger ratelimit.Purger, interval time.Duration, log logger.Interface) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		for {
+			purged, err := purger.Purge(ctx, _rateLimitPurgeBatchSize)
+			if err != nil {
+				log.Error(fmt.Errorf("app - purgeRateLimitBuckets - purger.Purge: %w", err))
+
+				break
+			}
+
+			if purged < _rateLimitPurgeBatchSize {
+				break
+			}
+		}
+	}
+}
+
+func newStorage(cfg config.Media, log logger.Interface) repository.Storage {
+	switch cfg.Storage {
+	case "local":
+		store, err := storage.NewLocalStorage(cfg.LocalDir)
+		if err != nil {
+			log.Fatal(fmt.Errorf("app - newStorage - storage.NewLocalStorage: %w", err))
+		}
+
+		return store
+	case "s3":
+		store, err := storage.NewS3Storage(cfg.S3)
+		if err != nil {
+			log.Fatal(fmt.Errorf("app - newStorage - storage.NewS3Storage: %w", err))
+		}
+
+		return store
+	default:
+		log.Fatal("app - newStorage - unknown media storage %q", cfg.Storage)
+
+		return nil
+	}
+}
+
+// newChallengeIssuer returns nil when proof of work is disabled. Without a
+// configured secret a random one is used, so challenges only verify on the
+// replica that issued them until the next restart.
+func newChallengeIssuer(cfg config.Comment, log logger.Interface) *pow.Issuer {
+	if cfg.PowDifficulty <= 0 {
+		return nil
+	}
+
+	secret := []byte(cfg.PowSecret)
+	if len(secret) == 0 {
+		log.Warn("app - newChallengeIssuer - COMMENT_POW_SECRET is not set, using a random key")
+
+		secret = make([]byte, _randomPowSecretSize)
+		if _, err := rand.Read(secret); err != nil {
+			log.Fatal(fmt.Errorf("app - newChallengeIssuer - rand.Read: %w", err))
+		}
+	}
+
+	return pow.NewIssuer(secret, cfg.PowDifficulty, cfg.PowTTL)
+}
+
+// newRenderer returns nil when HTML rendering is disabled. Stored content is
+// embedded as markup when it is sanitized, and as text otherwise. Content
+// stored before sanitizing was enabled is sanitized at startup, before the
+// renderer serves any of it.
+func newRenderer(cfg config.Render, htmlCfg config.HTML, log logger.Interface) *render.Renderer {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	opts := []render.Option{render.HotReload(cfg.HotReload)}
+	if htmlCfg.Sanitize {
+		opts = append(opts, render.Funcs(template.FuncMap{"content": render.TrustedHTML}))
+	}
+
+	renderer, err := render.New(os.DirFS(cfg.ThemeDir), opts...)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - newRenderer - render.New: %w", err))
+	}
+
+	return renderer
+}
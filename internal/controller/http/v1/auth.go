@@ -18,12 +18,12 @@ type authRoutes struct {
 	log  logger.Interface
 }
 
-func newAuthRoutes(handler *gin.RouterGroup, auth usecase.Auth, log logger.Interface) {
+func newAuthRoutes(handler *gin.RouterGroup, auth usecase.Auth, log logger.Interface, loginRateLimit gin.HandlerFunc) {
 	authRouter := authRoutes{auth, log}
 
 	h := handler.Group("auth")
 	{
-		h.POST("/login", authRouter.Login)
+		h.POST("/login", loginRateLimit, authRouter.Login)
 		h.POST("/refresh", authRouter.Refresh)
 	}
 }
@@ -38,6 +38,7 @@ func newAuthRoutes(handler *gin.RouterGroup, auth usecase.Auth, log logger.Inter
 // @Failure 400 {object} response.ErrorResponse "Invalid request payload"
 // @Failure 401 {object} response.ErrorResponse "Invalid username or password"
 // @Failure 404 {object} response.ErrorResponse "User not found"
+// @Failure 429 {object} response.ErrorResponse "Too many requests"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /auth/login [post]
 func (a *authRoutes) Login(ctx *gin.Context) {
@@ -46,7 +47,7 @@ func (a *authRoutes) Login(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		a.log.Error(err, "AuthController - Login - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
 
 		return
 	}
@@ -59,12 +60,12 @@ func (a *authRoutes) Login(ctx *gin.Context) {
 	if err != nil {
 		switch {
 		case errors.Is(err, apperror.ErrInvalidCredentials):
-			response.SendError(ctx, http.StatusUnauthorized, "Invalid username or password")
+			response.SendError(ctx, http.StatusUnauthorized, response.CodeInvalidCredentials)
 		case errors.Is(err, apperror.ErrNotFound):
-			response.SendError(ctx, http.StatusNotFound, "User not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodeUserNotFound)
 		default:
 			a.log.Error(err, "AuthController - Login - a.auth.Login")
-			response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+			response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 		}
 
 		return
@@ -93,7 +94,7 @@ func (a *authRoutes) Refresh(ctx *gin.Context) {
 	// Bind JSON
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		a.log.Error(err, "AuthController - Refresh - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
 
 		return
 	}
@@ -102,12 +103,12 @@ func (a *authRoutes) Refresh(ctx *gin.Context) {
 	if err != nil {
 		switch {
 		case errors.Is(err, apperror.ErrInvalidTokenType):
-			response.SendError(ctx, http.StatusUnauthorized, "Invalid token type")
+			response.SendError(ctx, http.StatusUnauthorized, response.CodeInvalidTokenType)
 		case errors.Is(err, apperror.ErrInvalidToken):
-			response.SendError(ctx, http.StatusUnauthorized, "Invalid token")
+			response.SendError(ctx, http.StatusUnauthorized, response.CodeInvalidToken)
 		default:
 			a.log.Error(err, "AuthController - Refresh - a.auth.Refresh")
-			response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+			response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 		}
 
 		return
@@ -7,9 +7,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/middleware"
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
 	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/ratelimit"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -185,12 +188,58 @@ func TestAuthRoutes_Login(t *testing.T) {
 		meta, ok := response["meta"].(map[string]interface{})
 		assert.True(t, ok, "meta should be a map")
 		assert.Equal(t, float64(400), meta["code"])
+		assert.Equal(t, "invalid_payload", meta["error_code"])
 		assert.Equal(t, "Invalid request payload", meta["message"])
 
 		mockAuthUseCase.AssertNotCalled(t, "Login")
 		mockLogger.AssertExpectations(t)
 	})
 
+	t.Run("error - invalid request payload in the locale of the reader", func(t *testing.T) {
+		// Arrange
+		mockAuthUseCase := new(MockAuthUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		authRouter := &authRoutes{
+			auth: mockAuthUseCase,
+			log:  mockLogger,
+		}
+
+		router.POST("/auth/login", authRouter.Login)
+
+		bodyBytes := []byte(`{"username": "testuser", "password":}`)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "id-ID,id;q=0.9,en;q=0.8")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta, ok := response["meta"].(map[string]interface{})
+		assert.True(t, ok, "meta should be a map")
+		assert.Equal(t, "invalid_payload", meta["error_code"])
+		assert.Equal(t, "Isi permintaan tidak valid", meta["message"])
+
+		mockAuthUseCase.AssertNotCalled(t, "Login")
+		mockLogger.AssertExpectations(t)
+	})
+
 	t.Run("error - invalid request payload (missing required fields)", func(t *testing.T) {
 		// Arrange
 		mockAuthUseCase := new(MockAuthUseCase)
@@ -901,7 +950,7 @@ func TestNewAuthRoutes(t *testing.T) {
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return().Maybe()
 
 		// Act
-		newAuthRoutes(handler, mockAuthUseCase, mockLogger)
+		newAuthRoutes(handler, mockAuthUseCase, mockLogger, func(ctx *gin.Context) { ctx.Next() })
 
 		// Assert - verify route is registered
 		req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", http.NoBody)
@@ -914,4 +963,38 @@ func TestNewAuthRoutes(t *testing.T) {
 		assert.NotEqual(t, http.StatusNotFound, w.Code)
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
+	t.Run("error - login is rate limited", func(t *testing.T) {
+		// Arrange
+		mockAuthUseCase := new(MockAuthUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		handler := router.Group("/api/v1")
+
+		loginRateLimit := middleware.RateLimitMiddleware(ratelimit.NewMemoryStore(), ratelimit.Policy{
+			Name:   "login",
+			Limit:  1,
+			Period: time.Minute,
+		}, middleware.ByClientIP, mockLogger)
+
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return().Maybe()
+
+		newAuthRoutes(handler, mockAuthUseCase, mockLogger, loginRateLimit)
+
+		// Act
+		first := httptest.NewRecorder()
+		router.ServeHTTP(first, httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", http.NoBody))
+
+		second := httptest.NewRecorder()
+		router.ServeHTTP(second, httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", http.NoBody))
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, first.Code)
+		assert.Equal(t, "1", first.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "0", first.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "60", first.Header().Get("RateLimit-Reset"))
+
+		assert.Equal(t, http.StatusTooManyRequests, second.Code)
+		assert.Equal(t, "60", second.Header().Get("Retry-After"))
+	})
 }
@@ -35,18 +35,19 @@ func newCategoryRoutes(handler *gin.RouterGroup, category usecase.Category, log
 }
 
 // @Summary Get all categories
-// @Description Retrieve a list of all categories
+// @Description Retrieve a list of all categories, one translation of each in the locale the reader prefers
 // @Tags Categories
 // @Accept json
 // @Produce json
+// @Param lang query string false "Comma separated locales to list translations in, best first, overriding Accept-Language; * lists every translation" example(id,en)
 // @Success 200 {object} response.Response "List of categories"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /categories [get]
 func (c *categoryRoutes) GetAll(ctx *gin.Context) {
-	categories, err := c.category.GetAll(ctx)
+	categories, err := c.category.GetAll(ctx, readerLocales(ctx, true))
 	if err != nil {
 		c.log.Error(err, "CategoryController - GetAll - c.category.GetAll")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -62,6 +63,7 @@ func (c *categoryRoutes) GetAll(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "Category ID"
+// @Param lang query string false "Comma separated locales, best first, to return the translation ranked best in instead" example(id,en)
 // @Success 200 {object} response.Response "Category detail"
 // @Failure 404 {object} response.ErrorResponse "Category not found"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
@@ -69,16 +71,16 @@ func (c *categoryRoutes) GetAll(ctx *gin.Context) {
 func (c *categoryRoutes) GetByID(ctx *gin.Context) {
 	id := ctx.Param("id")
 
-	category, err := c.category.GetByID(ctx, id)
+	category, err := c.category.GetByID(ctx, id, readerLocales(ctx, false))
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "Category not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodeCategoryNotFound)
 
 			return
 		}
 
 		c.log.Error(err, "CategoryController - GetByID - c.category.GetByID")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -96,8 +98,9 @@ func (c *categoryRoutes) GetByID(ctx *gin.Context) {
 // @Security BearerAuth
 // @Param request body request.Category true "Category information"
 // @Success 201 {object} response.Response "Category created successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload, locale or translation"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 409 {object} response.ValidationErrorResponse "Translation already exists in the locale"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /categories [post]
 func (c *categoryRoutes) Create(ctx *gin.Context) {
@@ -106,18 +109,24 @@ func (c *categoryRoutes) Create(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		c.log.Error(err, "CategoryController - Create - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
 
 		return
 	}
 
 	// Create category
 	category, err := c.category.Create(ctx, &dto.CreateCategoryRequestDTO{
-		Name: req.Name,
+		Name:          req.Name,
+		Locale:        req.Locale,
+		TranslationOf: req.TranslationOf,
 	})
 	if err != nil {
+		if sendTranslationError(ctx, err) {
+			return
+		}
+
 		c.log.Error(err, "CategoryController - Create - c.category.Create")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -137,9 +146,10 @@ func (c *categoryRoutes) Create(ctx *gin.Context) {
 // @Param id path string true "Category ID"
 // @Param request body request.UpdateCategory true "Updated category information"
 // @Success 200 {object} response.Response "Category updated successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload or locale"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 404 {object} response.ErrorResponse "Category not found"
+// @Failure 409 {object} response.ValidationErrorResponse "Translation already exists in the locale"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /categories/{id} [put]
 func (c *categoryRoutes) Update(ctx *gin.Context) {
@@ -150,24 +160,29 @@ func (c *categoryRoutes) Update(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		c.log.Error(err, "CategoryController - Update - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
 
 		return
 	}
 
 	// Update category
 	err := c.category.Update(ctx, id, &dto.UpdateCategoryRequestDTO{
-		Name: req.Name,
+		Name:   req.Name,
+		Locale: req.Locale,
 	})
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "Category not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodeCategoryNotFound)
+
+			return
+		}
 
+		if sendTranslationError(ctx, err) {
 			return
 		}
 
 		c.log.Error(err, "CategoryController - Update - c.category.Update")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -197,13 +212,13 @@ func (c *categoryRoutes) Delete(ctx *gin.Context) {
 	err := c.category.Delete(ctx, id)
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "Category not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodeCategoryNotFound)
 
 			return
 		}
 
 		c.log.Error(err, "CategoryController - Delete - c.category.Delete")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -36,8 +36,8 @@ func (m *MockCategoryUseCase) Create(ctx context.Context, req *dto.CreateCategor
 	return result, args.Error(1)
 }
 
-func (m *MockCategoryUseCase) GetByID(ctx context.Context, id string) (*dto.CategoryResponseDTO, error) {
-	args := m.Called(ctx, id)
+func (m *MockCategoryUseCase) GetByID(ctx context.Context, id string, locales []string) (*dto.CategoryResponseDTO, error) {
+	args := m.Called(ctx, id, locales)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -50,8 +50,8 @@ func (m *MockCategoryUseCase) GetByID(ctx context.Context, id string) (*dto.Cate
 	return result, args.Error(1)
 }
 
-func (m *MockCategoryUseCase) GetAll(ctx context.Context) ([]dto.CategoryResponseDTO, error) {
-	args := m.Called(ctx)
+func (m *MockCategoryUseCase) GetAll(ctx context.Context, locales []string) ([]dto.CategoryResponseDTO, error) {
+	args := m.Called(ctx, locales)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -101,7 +101,7 @@ func TestCategoryRoutes_GetAll(t *testing.T) {
 		}
 
 		// Mock expectations
-		mockCategoryUseCase.On("GetAll", mock.Anything).Return(expectedCategories, nil)
+		mockCategoryUseCase.On("GetAll", mock.Anything, mock.Anything).Return(expectedCategories, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/categories", http.NoBody)
@@ -122,6 +122,37 @@ func TestCategoryRoutes_GetAll(t *testing.T) {
 		mockCategoryUseCase.AssertExpectations(t)
 	})
 
+	t.Run("success - list translations in the reader's locales", func(t *testing.T) {
+		// Arrange
+		mockCategoryUseCase := new(MockCategoryUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		categoryRouter := &categoryRoutes{
+			category: mockCategoryUseCase,
+			log:      mockLogger,
+		}
+
+		router.GET("/categories", categoryRouter.GetAll)
+
+		// Mock expectations
+		mockCategoryUseCase.On("GetAll", mock.Anything, []string{"id-id", "en"}).Return([]dto.CategoryResponseDTO{}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/categories", http.NoBody)
+		req.Header.Set("Accept-Language", "en;q=0.5, id-ID")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+
+		mockCategoryUseCase.AssertExpectations(t)
+	})
+
 	t.Run("error - internal server error", func(t *testing.T) {
 		// Arrange
 		mockCategoryUseCase := new(MockCategoryUseCase)
@@ -136,7 +167,7 @@ func TestCategoryRoutes_GetAll(t *testing.T) {
 		router.GET("/categories", categoryRouter.GetAll)
 
 		// Mock expectations
-		mockCategoryUseCase.On("GetAll", mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
+		mockCategoryUseCase.On("GetAll", mock.Anything, mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -147,6 +178,7 @@ func TestCategoryRoutes_GetAll(t *testing.T) {
 
 		// Assert
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, []string{"Accept-Language"}, w.Header().Values("Vary"))
 
 		var response map[string]interface{}
 
@@ -186,7 +218,7 @@ func TestCategoryRoutes_GetByID(t *testing.T) {
 		}
 
 		// Mock expectations
-		mockCategoryUseCase.On("GetByID", mock.Anything, testCategoryID).Return(expectedCategory, nil)
+		mockCategoryUseCase.On("GetByID", mock.Anything, testCategoryID, mock.Anything).Return(expectedCategory, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/categories/"+testCategoryID, http.NoBody)
@@ -221,7 +253,7 @@ func TestCategoryRoutes_GetByID(t *testing.T) {
 		router.GET("/categories/:id", categoryRouter.GetByID)
 
 		// Mock expectations
-		mockCategoryUseCase.On("GetByID", mock.Anything, "non-existent-id").Return(nil, apperror.ErrNotFound)
+		mockCategoryUseCase.On("GetByID", mock.Anything, "non-existent-id", mock.Anything).Return(nil, apperror.ErrNotFound)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/categories/non-existent-id", http.NoBody)
@@ -259,7 +291,7 @@ func TestCategoryRoutes_GetByID(t *testing.T) {
 		router.GET("/categories/:id", categoryRouter.GetByID)
 
 		// Mock expectations
-		mockCategoryUseCase.On("GetByID", mock.Anything, testCategoryID).Return(nil, apperror.ErrDatabaseConnection)
+		mockCategoryUseCase.On("GetByID", mock.Anything, testCategoryID, mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -334,6 +366,49 @@ func TestCategoryRoutes_Create(t *testing.T) {
 		mockCategoryUseCase.AssertExpectations(t)
 	})
 
+	t.Run("error - translation already exists in the locale", func(t *testing.T) {
+		// Arrange
+		mockCategoryUseCase := new(MockCategoryUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		categoryRouter := &categoryRoutes{
+			category: mockCategoryUseCase,
+			log:      mockLogger,
+		}
+
+		router.POST("/categories", categoryRouter.Create)
+
+		requestBody := map[string]string{
+			"name":           "Teknologi",
+			"locale":         "id",
+			"translation_of": testCategoryID,
+		}
+		bodyBytes, err := json.Marshal(requestBody)
+		assert.NoError(t, err)
+
+		// Mock expectations
+		mockCategoryUseCase.On("Create", mock.Anything, &dto.CreateCategoryRequestDTO{
+			Name:          "Teknologi",
+			Locale:        "id",
+			TranslationOf: testCategoryID,
+		}).Return(nil, apperror.ErrTranslationExists)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/categories", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"locale"`)
+
+		mockCategoryUseCase.AssertExpectations(t)
+	})
+
 	t.Run("error - invalid request payload", func(t *testing.T) {
 		// Arrange
 		mockCategoryUseCase := new(MockCategoryUseCase)
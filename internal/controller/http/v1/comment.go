@@ -1,40 +1,163 @@
 package v1
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/request"
 	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
 	"github.com/RizqiSugiarto/coding-test/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	commentFormatTree = "tree"
+	commentFormatFlat = "flat"
+	commentSortOldest = "oldest"
+	commentSortTop    = "top"
+	editTokenHeader   = "X-Edit-Token"
+)
+
 type commentRoutes struct {
 	comment usecase.Comment
 	log     logger.Interface
 }
 
-func newCommentRoutes(handler *gin.RouterGroup, comment usecase.Comment, log logger.Interface) {
+func newCommentRoutes(
+	handler *gin.RouterGroup,
+	comment usecase.Comment,
+	log logger.Interface,
+	authMiddleware gin.HandlerFunc,
+	optionalAuthMiddleware gin.HandlerFunc,
+	commentRateLimit gin.HandlerFunc,
+) {
 	commentRouter := commentRoutes{comment, log}
 
 	h := handler.Group("news")
 	{
-		// Public endpoint - anyone can post comments
-		h.POST("/:id/comments", commentRouter.Create)
+		// Public endpoints - anyone can read and post comments, signed in
+		// users are rate limited by account rather than by IP
+		h.GET("/:id/comments", commentRouter.GetByNewsID)
+		h.GET("/:id/comments/challenge", commentRouter.IssueChallenge)
+		h.POST("/:id/comments", optionalAuthMiddleware, commentRateLimit, commentRouter.Create)
+	}
+
+	e := handler.Group("comments", optionalAuthMiddleware)
+	{
+		// Authors edit with their edit token, authenticated moderators without one
+		e.PUT("/:id", commentRouter.Update)
+		e.DELETE("/:id", commentRouter.Delete)
+
+		// Readers vote once per comment, identified by user or visitor fingerprint
+		e.POST("/:id/votes", commentRouter.Vote)
+		e.POST("/:id/reports", commentRouter.Report)
+	}
+
+	m := handler.Group("comments/moderation", authMiddleware)
+	{
+		// Protected endpoints - only authenticated moderators
+		m.GET("", commentRouter.GetModerationQueue)
+		m.GET("/reports", commentRouter.GetReported)
+		m.POST("/approve", commentRouter.Approve)
+		m.POST("/reject", commentRouter.Reject)
+	}
+}
+
+// @Summary Get comments of a news article
+// @Description Retrieve the approved comments of a news article as a reply tree or as a flat list with depth and path.
+// @Description Comments carry their vote counts; the top sort ranks replies to the same comment by score.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "News ID"
+// @Param format query string false "Response shape" Enums(tree, flat) default(tree)
+// @Param sort query string false "Order of replies to the same comment" Enums(oldest, top) default(oldest)
+// @Success 200 {object} response.Response "List of comments"
+// @Failure 400 {object} response.ErrorResponse "Invalid format or sort"
+// @Failure 404 {object} response.ErrorResponse "News not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /news/{id}/comments [get]
+func (co *commentRoutes) GetByNewsID(ctx *gin.Context) {
+	newsID := ctx.Param("id")
+
+	format := ctx.DefaultQuery("format", commentFormatTree)
+	if format != commentFormatTree && format != commentFormatFlat {
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidFormat)
+
+		return
+	}
+
+	sort := ctx.DefaultQuery("sort", commentSortOldest)
+	if sort != commentSortOldest && sort != commentSortTop {
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidSort)
+
+		return
+	}
+
+	comments, err := co.comment.GetByNewsID(ctx, newsID, format == commentFormatTree, sort == commentSortTop)
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeNewsNotFound)
+
+			return
+		}
+
+		co.log.Error(err, "CommentController - GetByNewsID - co.comment.GetByNewsID")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
 	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"comments": comments,
+	})
+}
+
+// @Summary Get a proof-of-work challenge for commenting
+// @Description Issue a signed challenge for commenting on a news article. Solve it by finding a nonce such that
+// @Description sha256(challenge + ":" + nonce) starts with `difficulty` zero bits, then send both with the comment.
+// @Description The challenge is null when proof of work is disabled.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "News ID"
+// @Success 200 {object} response.Response "Proof-of-work challenge"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /news/{id}/comments/challenge [get]
+func (co *commentRoutes) IssueChallenge(ctx *gin.Context) {
+	challenge, err := co.comment.IssueChallenge(ctx, ctx.Param("id"))
+	if err != nil {
+		co.log.Error(err, "CommentController - IssueChallenge - co.comment.IssueChallenge")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"challenge": challenge,
+	})
 }
 
 // @Summary Create a comment on a news article
-// @Description Create a new comment on a specific news article
+// @Description Create a new comment on a specific news article, optionally as a reply to another comment.
+// @Description A solved proof-of-work challenge from GET /news/{id}/comments/challenge is required unless disabled.
+// @Description Content filters may mask words, reject the comment, or hold it for moderation. Otherwise the
+// @Description moderation policy decides whether the comment is published instantly or queued for approval.
 // @Tags Comments
 // @Accept json
 // @Produce json
 // @Param id path string true "News ID"
 // @Param request body request.Comment true "Comment information"
 // @Success 201 {object} response.Response "Comment created successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} response.ErrorResponse "Invalid request payload or comment rejected by content filter"
+// @Failure 403 {object} response.ErrorResponse "Comments are closed"
+// @Failure 404 {object} response.ErrorResponse "News not found"
+// @Failure 409 {object} response.ErrorResponse "Challenge already used"
+// @Failure 429 {object} response.ErrorResponse "Too many requests"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /news/{id}/comments [post]
 func (co *commentRoutes) Create(ctx *gin.Context) {
@@ -45,26 +168,425 @@ func (co *commentRoutes) Create(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		co.log.Error(err, "CommentController - Create - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
 
 		return
 	}
 
 	// Create comment
-	err := co.comment.Create(ctx, &dto.CreateCommentRequestDTO{
-		Name:    req.Name,
-		Comment: req.Comment,
-		NewsID:  newsID,
+	comment, err := co.comment.Create(ctx, &dto.CreateCommentRequestDTO{
+		Name:        req.Name,
+		Comment:     req.Comment,
+		NewsID:      newsID,
+		ParentID:    req.ParentID,
+		Fingerprint: visitorFingerprint(ctx),
+		Challenge:   req.Challenge,
+		Nonce:       req.Nonce,
 	})
 	if err != nil {
+		if status, code, ok := commentError(err); ok {
+			response.SendError(ctx, status, code)
+
+			return
+		}
+
 		co.log.Error(err, "CommentController - Create - co.comment.Create")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
 
+	message := "Comment created successfully"
+	if comment.Status != entity.CommentStatusApproved {
+		message = "Comment is awaiting moderation"
+	}
+
 	// Success response
 	response.SendSuccess(ctx, http.StatusCreated, gin.H{
-		"message": "Comment created successfully",
+		"message": message,
+		"comment": comment,
+	})
+}
+
+// @Summary Update a comment
+// @Description Edit the text of a comment. Authors send the edit token returned when the comment was created in the
+// @Description X-Edit-Token header and may edit only within the edit window; their edits are filtered and moderated
+// @Description again. Authenticated moderators may edit any comment without a token.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Param X-Edit-Token header string false "Edit token of the comment author"
+// @Param request body request.UpdateComment true "Comment text"
+// @Success 200 {object} response.Response "Comment updated successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request payload or comment rejected by content filter"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Invalid edit token or comment can no longer be edited"
+// @Failure 404 {object} response.ErrorResponse "Comment not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/{id} [put]
+func (co *commentRoutes) Update(ctx *gin.Context) {
+	var req request.UpdateComment
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		co.log.Error(err, "CommentController - Update - ctx.ShouldBindJSON")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	comment, err := co.comment.Update(ctx, ctx.Param("id"), &dto.UpdateCommentRequestDTO{
+		Comment:   req.Comment,
+		EditToken: ctx.GetHeader(editTokenHeader),
+		Moderator: ctx.GetString("user_id") != "",
+	})
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeCommentNotFound)
+
+			return
+		}
+
+		if status, code, ok := commentError(err); ok {
+			response.SendError(ctx, status, code)
+
+			return
+		}
+
+		co.log.Error(err, "CommentController - Update - co.comment.Update")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	// Success response
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Comment updated successfully",
+		"comment": comment,
+	})
+}
+
+// @Summary Delete a comment
+// @Description Delete a comment together with its replies. Authors send the edit token returned when the comment
+// @Description was created in the X-Edit-Token header and may delete only within the edit window. Authenticated
+// @Description moderators may delete any comment without a token.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Param X-Edit-Token header string false "Edit token of the comment author"
+// @Success 200 {object} response.Response "Comment deleted successfully"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 403 {object} response.ErrorResponse "Invalid edit token or comment can no longer be edited"
+// @Failure 404 {object} response.ErrorResponse "Comment not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/{id} [delete]
+func (co *commentRoutes) Delete(ctx *gin.Context) {
+	err := co.comment.Delete(ctx, ctx.Param("id"), &dto.DeleteCommentRequestDTO{
+		EditToken: ctx.GetHeader(editTokenHeader),
+		Moderator: ctx.GetString("user_id") != "",
+	})
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeCommentNotFound)
+
+			return
+		}
+
+		if status, code, ok := commentError(err); ok {
+			response.SendError(ctx, status, code)
+
+			return
+		}
+
+		co.log.Error(err, "CommentController - Delete - co.comment.Delete")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	// Success response
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Comment deleted successfully",
+	})
+}
+
+// @Summary Vote on a comment
+// @Description Upvote (1) or downvote (-1) an approved comment, or withdraw the vote (0). Each reader, identified by
+// @Description user or visitor fingerprint, has one vote per comment. Returns the updated vote counts.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Param request body request.CommentVote true "Vote"
+// @Success 200 {object} response.Response "Vote counts"
+// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 404 {object} response.ErrorResponse "Comment not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/{id}/votes [post]
+func (co *commentRoutes) Vote(ctx *gin.Context) {
+	var req request.CommentVote
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		co.log.Error(err, "CommentController - Vote - ctx.ShouldBindJSON")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	votes, err := co.comment.Vote(ctx, ctx.Param("id"), voterID(ctx), *req.Value)
+	if err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrNotFound):
+			response.SendError(ctx, http.StatusNotFound, response.CodeCommentNotFound)
+		case errors.Is(err, apperror.ErrInvalidVote):
+			response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidVote)
+		default:
+			co.log.Error(err, "CommentController - Vote - co.comment.Vote")
+			response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+		}
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"votes": votes,
+	})
+}
+
+// @Summary Report a comment
+// @Description Flag an approved comment as abusive. Each reader, identified by user or visitor fingerprint, counts
+// @Description once per comment. Once enough readers report it, the comment is hidden and sent back to the
+// @Description moderation queue.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Param request body request.ReportComment true "Report"
+// @Success 200 {object} response.Response "Comment reported"
+// @Failure 400 {object} response.ErrorResponse "Invalid request payload or report reason"
+// @Failure 404 {object} response.ErrorResponse "Comment not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/{id}/reports [post]
+func (co *commentRoutes) Report(ctx *gin.Context) {
+	var req request.ReportComment
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		co.log.Error(err, "CommentController - Report - ctx.ShouldBindJSON")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	err := co.comment.Report(ctx, ctx.Param("id"), voterID(ctx), &dto.ReportCommentRequestDTO{
+		Reason: req.Reason,
+		Note:   req.Note,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrNotFound):
+			response.SendError(ctx, http.StatusNotFound, response.CodeCommentNotFound)
+		case errors.Is(err, apperror.ErrInvalidReportReason):
+			response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidReportReason)
+		default:
+			co.log.Error(err, "CommentController - Report - co.comment.Report")
+			response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+		}
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Comment reported",
+	})
+}
+
+// @Summary Get reported comments
+// @Description Retrieve comments with open reports, most reported first (requires authentication).
+// @Description Approving or rejecting a comment closes its reports.
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "Maximum number of comments" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of comments to skip" minimum(0) default(0)
+// @Success 200 {object} response.Response "List of reported comments"
+// @Failure 400 {object} response.ErrorResponse "Invalid limit or offset"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/moderation/reports [get]
+func (co *commentRoutes) GetReported(ctx *gin.Context) {
+	var page request.ModerationPage
+
+	// Bind query parameters
+	if err := ctx.ShouldBindQuery(&page); err != nil {
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	reported, err := co.comment.GetReported(ctx, page.Limit, page.Offset)
+	if err != nil {
+		co.log.Error(err, "CommentController - GetReported - co.comment.GetReported")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"comments": reported,
+	})
+}
+
+// @Summary Get the comment moderation queue
+// @Description Retrieve comments waiting for moderation, or comments in another moderation status (requires authentication)
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Moderation status" Enums(pending, approved, rejected, spam) default(pending)
+// @Param limit query int false "Maximum number of comments" minimum(1) maximum(100) default(50)
+// @Param offset query int false "Number of comments to skip" minimum(0) default(0)
+// @Success 200 {object} response.Response "List of comments"
+// @Failure 400 {object} response.ErrorResponse "Invalid status, limit or offset"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/moderation [get]
+func (co *commentRoutes) GetModerationQueue(ctx *gin.Context) {
+	var page request.ModerationPage
+
+	// Bind query parameters
+	if err := ctx.ShouldBindQuery(&page); err != nil {
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	comments, err := co.comment.GetModerationQueue(ctx, ctx.Query("status"), page.Limit, page.Offset)
+	if err != nil {
+		if errors.Is(err, apperror.ErrInvalidCommentStatus) {
+			response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidStatus)
+
+			return
+		}
+
+		co.log.Error(err, "CommentController - GetModerationQueue - co.comment.GetModerationQueue")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"comments": comments,
+	})
+}
+
+// @Summary Approve comments
+// @Description Publish one or more comments (requires authentication)
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.ModerateComments true "Comment IDs"
+// @Success 200 {object} response.Response "Comments approved successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/moderation/approve [post]
+func (co *commentRoutes) Approve(ctx *gin.Context) {
+	var req request.ModerateComments
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		co.log.Error(err, "CommentController - Approve - ctx.ShouldBindJSON")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	updated, err := co.comment.Approve(ctx, req.IDs)
+	if err != nil {
+		co.log.Error(err, "CommentController - Approve - co.comment.Approve")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	// Success response
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Comments approved successfully",
+		"updated": updated,
 	})
 }
+
+// @Summary Reject comments
+// @Description Hide one or more comments, optionally marking them as spam (requires authentication)
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.RejectComments true "Comment IDs"
+// @Success 200 {object} response.Response "Comments rejected successfully"
+// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /comments/moderation/reject [post]
+func (co *commentRoutes) Reject(ctx *gin.Context) {
+	var req request.RejectComments
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		co.log.Error(err, "CommentController - Reject - ctx.ShouldBindJSON")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	updated, err := co.comment.Reject(ctx, req.IDs, req.Spam)
+	if err != nil {
+		co.log.Error(err, "CommentController - Reject - co.comment.Reject")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	// Success response
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Comments rejected successfully",
+		"updated": updated,
+	})
+}
+
+// commentError maps an expected use case error of a comment write to a
+// response status and error code. It reports false for unexpected errors.
+func commentError(err error) (int, string, bool) {
+	switch {
+	case errors.Is(err, apperror.ErrNotFound):
+		return http.StatusNotFound, response.CodeNewsNotFound, true
+	case errors.Is(err, apperror.ErrInvalidParentComment):
+		return http.StatusBadRequest, response.CodeInvalidParentComment, true
+	case errors.Is(err, apperror.ErrMaxCommentDepth):
+		return http.StatusBadRequest, response.CodeMaxReplyDepth, true
+	case errors.Is(err, apperror.ErrCommentRejected):
+		return http.StatusBadRequest, response.CodeCommentRejected, true
+	case errors.Is(err, apperror.ErrInvalidChallenge):
+		return http.StatusBadRequest, response.CodeInvalidChallenge, true
+	case errors.Is(err, apperror.ErrInvalidProofOfWork):
+		return http.StatusBadRequest, response.CodeInvalidProofOfWork, true
+	case errors.Is(err, apperror.ErrChallengeUsed):
+		return http.StatusConflict, response.CodeChallengeUsed, true
+	case errors.Is(err, apperror.ErrInvalidEditToken):
+		return http.StatusForbidden, response.CodeInvalidEditToken, true
+	case errors.Is(err, apperror.ErrCommentNotEditable):
+		return http.StatusForbidden, response.CodeCommentNotEditable, true
+	case errors.Is(err, apperror.ErrCommentsClosed):
+		return http.StatusForbidden, response.CodeCommentsClosed, true
+	default:
+		return 0, "", false
+	}
+}
@@ -7,9 +7,13 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -23,12 +27,152 @@ type MockCommentUseCase struct {
 	mock.Mock
 }
 
-func (m *MockCommentUseCase) Create(ctx context.Context, req *dto.CreateCommentRequestDTO) error {
+func (m *MockCommentUseCase) Create(ctx context.Context, req *dto.CreateCommentRequestDTO) (*dto.CommentResponseDTO, error) {
 	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.CommentResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentUseCase) GetByNewsID(ctx context.Context, newsID string, tree, top bool) ([]dto.CommentResponseDTO, error) {
+	args := m.Called(ctx, newsID, tree, top)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.CommentResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentUseCase) GetModerationQueue(ctx context.Context, status string, limit, offset int) ([]dto.CommentResponseDTO, error) {
+	args := m.Called(ctx, status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.CommentResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentUseCase) Approve(ctx context.Context, ids []string) (int64, error) {
+	args := m.Called(ctx, ids)
+
+	updated, ok := args.Get(0).(int64)
+	if !ok {
+		return 0, args.Error(1)
+	}
+
+	return updated, args.Error(1)
+}
+
+func (m *MockCommentUseCase) Reject(ctx context.Context, ids []string, spam bool) (int64, error) {
+	args := m.Called(ctx, ids, spam)
+
+	updated, ok := args.Get(0).(int64)
+	if !ok {
+		return 0, args.Error(1)
+	}
+
+	return updated, args.Error(1)
+}
+
+func (m *MockCommentUseCase) Update(
+	ctx context.Context,
+	id string,
+	req *dto.UpdateCommentRequestDTO,
+) (*dto.CommentResponseDTO, error) {
+	args := m.Called(ctx, id, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.CommentResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentUseCase) Delete(ctx context.Context, id string, req *dto.DeleteCommentRequestDTO) error {
+	args := m.Called(ctx, id, req)
+
+	return args.Error(0)
+}
+
+func (m *MockCommentUseCase) Vote(ctx context.Context, id, voter string, value int) (*dto.CommentVotesDTO, error) {
+	args := m.Called(ctx, id, voter, value)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.CommentVotesDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentUseCase) Report(ctx context.Context, id, reporter string, req *dto.ReportCommentRequestDTO) error {
+	args := m.Called(ctx, id, reporter, req)
 
 	return args.Error(0)
 }
 
+func (m *MockCommentUseCase) GetReported(ctx context.Context, limit, offset int) ([]dto.ReportedCommentDTO, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.ReportedCommentDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentUseCase) IssueChallenge(ctx context.Context, newsID string) (*dto.CommentChallengeDTO, error) {
+	args := m.Called(ctx, newsID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.CommentChallengeDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+// matchCommentRequest matches a create request on everything but the
+// visitor fingerprint, which depends on the test client.
+func matchCommentRequest(expected dto.CreateCommentRequestDTO) interface{} {
+	return mock.MatchedBy(func(req *dto.CreateCommentRequestDTO) bool {
+		expected.Fingerprint = req.Fingerprint
+
+		return req.Fingerprint != "" && *req == expected
+	})
+}
+
 func TestCommentRoutes_Create(t *testing.T) {
 	t.Run("success - create comment", func(t *testing.T) {
 		// Arrange
@@ -51,11 +195,11 @@ func TestCommentRoutes_Create(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockCommentUseCase.On("Create", mock.Anything, &dto.CreateCommentRequestDTO{
+		mockCommentUseCase.On("Create", mock.Anything, matchCommentRequest(dto.CreateCommentRequestDTO{
 			Name:    "John Doe",
 			Comment: "This is a great article!",
 			NewsID:  testCommentNewsIDRoute,
-		}).Return(nil)
+		})).Return(&dto.CommentResponseDTO{Status: entity.CommentStatusApproved}, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
@@ -185,7 +329,7 @@ func TestCommentRoutes_Create(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockCommentUseCase.On("Create", mock.Anything, mock.Anything).Return(errCommentDatabase)
+		mockCommentUseCase.On("Create", mock.Anything, mock.Anything).Return(nil, errCommentDatabase)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -234,11 +378,11 @@ func TestCommentRoutes_Create(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockCommentUseCase.On("Create", mock.Anything, &dto.CreateCommentRequestDTO{
+		mockCommentUseCase.On("Create", mock.Anything, matchCommentRequest(dto.CreateCommentRequestDTO{
 			Name:    "Test User <script>",
 			Comment: "Comment with special chars: !@#$%^&*()",
 			NewsID:  testCommentNewsIDRoute,
-		}).Return(nil)
+		})).Return(&dto.CommentResponseDTO{Status: entity.CommentStatusApproved}, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
@@ -253,4 +397,1339 @@ func TestCommentRoutes_Create(t *testing.T) {
 
 		mockCommentUseCase.AssertExpectations(t)
 	})
+
+	t.Run("error - reply depth exceeded", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/news/:id/comments", commentRouter.Create)
+
+		bodyBytes := []byte(`{"name": "John Doe", "comment": "deep reply", "parent_id": "550e8400-e29b-41d4-a716-446655440001"}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Create", mock.Anything, matchCommentRequest(dto.CreateCommentRequestDTO{
+			Name:     "John Doe",
+			Comment:  "deep reply",
+			NewsID:   testCommentNewsIDRoute,
+			ParentID: "550e8400-e29b-41d4-a716-446655440001",
+		})).Return(nil, apperror.ErrMaxCommentDepth)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - news not found", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/news/:id/comments", commentRouter.Create)
+
+		bodyBytes := []byte(`{"name": "John Doe", "comment": "hello"}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Create", mock.Anything, mock.Anything).Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - rejected by content filter", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/news/:id/comments", commentRouter.Create)
+
+		bodyBytes := []byte(`{"name": "John Doe", "comment": "bad words"}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Create", mock.Anything, mock.Anything).Return(nil, apperror.ErrCommentRejected)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta, ok := response["meta"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Comment rejected by content filter", meta["message"])
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - challenge already used", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/news/:id/comments", commentRouter.Create)
+
+		bodyBytes := []byte(`{"name": "John Doe", "comment": "hello", "challenge": "abc.def", "nonce": "42"}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Create", mock.Anything, mock.MatchedBy(func(req *dto.CreateCommentRequestDTO) bool {
+			return req.Challenge == "abc.def" && req.Nonce == "42"
+		})).Return(nil, apperror.ErrChallengeUsed)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - comments closed", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/news/:id/comments", commentRouter.Create)
+
+		bodyBytes := []byte(`{"name": "John Doe", "comment": "hello"}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Create", mock.Anything, mock.Anything).Return(nil, apperror.ErrCommentsClosed)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid proof of work", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/news/:id/comments", commentRouter.Create)
+
+		bodyBytes := []byte(`{"name": "John Doe", "comment": "hello", "challenge": "abc.def", "nonce": "1"}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Create", mock.Anything, mock.Anything).Return(nil, apperror.ErrInvalidProofOfWork)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta, ok := response["meta"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Invalid proof of work", meta["message"])
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - comment awaiting moderation", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/news/:id/comments", commentRouter.Create)
+
+		bodyBytes := []byte(`{"name": "John Doe", "comment": "hello"}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Create", mock.Anything, mock.Anything).
+			Return(&dto.CommentResponseDTO{Status: entity.CommentStatusPending}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testCommentNewsIDRoute+"/comments", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Comment is awaiting moderation", data["message"])
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+}
+
+func TestCommentRoutes_GetByNewsID(t *testing.T) {
+	t.Run("success - get comments as tree", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments", commentRouter.GetByNewsID)
+
+		expected := []dto.CommentResponseDTO{
+			{
+				ID:      "550e8400-e29b-41d4-a716-446655440001",
+				NewsID:  testCommentNewsIDRoute,
+				Name:    "John Doe",
+				Comment: "Root",
+				Replies: []dto.CommentResponseDTO{
+					{ID: "550e8400-e29b-41d4-a716-446655440002", Depth: 1, Comment: "Reply"},
+				},
+			},
+		}
+
+		// Mock expectations
+		mockCommentUseCase.On("GetByNewsID", mock.Anything, testCommentNewsIDRoute, true, false).Return(expected, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+
+		comments, ok := data["comments"].([]interface{})
+		assert.True(t, ok)
+		assert.Len(t, comments, 1)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - get comments as flat list", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments", commentRouter.GetByNewsID)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetByNewsID", mock.Anything, testCommentNewsIDRoute, false, false).Return([]dto.CommentResponseDTO{}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments?format=flat", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - top sort", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments", commentRouter.GetByNewsID)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetByNewsID", mock.Anything, testCommentNewsIDRoute, true, true).Return([]dto.CommentResponseDTO{}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments?sort=top", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid sort", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments", commentRouter.GetByNewsID)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments?sort=newest", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCommentUseCase.AssertNotCalled(t, "GetByNewsID")
+	})
+
+	t.Run("error - invalid format", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments", commentRouter.GetByNewsID)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments?format=xml", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCommentUseCase.AssertNotCalled(t, "GetByNewsID")
+	})
+
+	t.Run("error - news not found", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments", commentRouter.GetByNewsID)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetByNewsID", mock.Anything, testCommentNewsIDRoute, true, false).Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+}
+
+func TestCommentRoutes_Moderation(t *testing.T) {
+	const (
+		firstID  = "550e8400-e29b-41d4-a716-446655440001"
+		secondID = "550e8400-e29b-41d4-a716-446655440002"
+	)
+
+	t.Run("success - get pending queue", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/comments/moderation", commentRouter.GetModerationQueue)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetModerationQueue", mock.Anything, "", 0, 0).Return([]dto.CommentResponseDTO{
+			{ID: firstID, Status: entity.CommentStatusPending},
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/comments/moderation", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - page of the queue", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/comments/moderation", commentRouter.GetModerationQueue)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetModerationQueue", mock.Anything, entity.CommentStatusSpam, 20, 40).Return([]dto.CommentResponseDTO{}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/comments/moderation?status=spam&limit=20&offset=40", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid limit or offset", func(t *testing.T) {
+		for _, query := range []string{"limit=101", "limit=ten", "offset=-1"} {
+			// Arrange
+			mockCommentUseCase := new(MockCommentUseCase)
+			mockLogger := new(MockLogger)
+
+			router := setupTestRouter()
+			commentRouter := &commentRoutes{
+				comment: mockCommentUseCase,
+				log:     mockLogger,
+			}
+
+			router.GET("/comments/moderation", commentRouter.GetModerationQueue)
+			router.GET("/comments/moderation/reports", commentRouter.GetReported)
+
+			// Act
+			queue := httptest.NewRecorder()
+			router.ServeHTTP(queue, httptest.NewRequest(http.MethodGet, "/comments/moderation?"+query, http.NoBody))
+
+			reports := httptest.NewRecorder()
+			router.ServeHTTP(reports, httptest.NewRequest(http.MethodGet, "/comments/moderation/reports?"+query, http.NoBody))
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, queue.Code, query)
+			assert.Equal(t, http.StatusBadRequest, reports.Code, query)
+
+			mockCommentUseCase.AssertNotCalled(t, "GetModerationQueue", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			mockCommentUseCase.AssertNotCalled(t, "GetReported", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("error - invalid status", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/comments/moderation", commentRouter.GetModerationQueue)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetModerationQueue", mock.Anything, "deleted", 0, 0).Return(nil, apperror.ErrInvalidCommentStatus)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/comments/moderation?status=deleted", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - approve comments", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/moderation/approve", commentRouter.Approve)
+
+		bodyBytes := []byte(`{"ids": ["` + firstID + `", "` + secondID + `"]}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Approve", mock.Anything, []string{firstID, secondID}).Return(int64(2), nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/moderation/approve", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, float64(2), data["updated"])
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - approve with invalid ids", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/moderation/approve", commentRouter.Approve)
+
+		bodyBytes := []byte(`{"ids": ["not-a-uuid"]}`)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/moderation/approve", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCommentUseCase.AssertNotCalled(t, "Approve")
+	})
+
+	t.Run("success - reject comments as spam", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/moderation/reject", commentRouter.Reject)
+
+		bodyBytes := []byte(`{"ids": ["` + firstID + `"], "spam": true}`)
+
+		// Mock expectations
+		mockCommentUseCase.On("Reject", mock.Anything, []string{firstID}, true).Return(int64(1), nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/moderation/reject", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+}
+
+func TestCommentRoutes_IssueChallenge(t *testing.T) {
+	t.Run("success - issue challenge", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments/challenge", commentRouter.IssueChallenge)
+
+		// Mock expectations
+		mockCommentUseCase.On("IssueChallenge", mock.Anything, testCommentNewsIDRoute).Return(&dto.CommentChallengeDTO{
+			Challenge:  "abc.def",
+			Algorithm:  "sha256",
+			Difficulty: 18,
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments/challenge", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+
+		challenge, ok := data["challenge"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "abc.def", challenge["challenge"])
+		assert.Equal(t, float64(18), challenge["difficulty"])
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - internal server error", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/news/:id/comments/challenge", commentRouter.IssueChallenge)
+
+		// Mock expectations
+		mockCommentUseCase.On("IssueChallenge", mock.Anything, testCommentNewsIDRoute).Return(nil, errCommentDatabase)
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testCommentNewsIDRoute+"/comments/challenge", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestCommentRoutes_Update(t *testing.T) {
+	t.Run("success - author edits with edit token", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.PUT("/comments/:id", commentRouter.Update)
+
+		bodyBytes, err := json.Marshal(map[string]string{"comment": "Edited comment"})
+		assert.NoError(t, err)
+
+		// Mock expectations
+		mockCommentUseCase.On("Update", mock.Anything, testCommentNewsIDRoute, &dto.UpdateCommentRequestDTO{
+			Comment:   "Edited comment",
+			EditToken: "secret-token",
+		}).Return(&dto.CommentResponseDTO{
+			ID:      testCommentNewsIDRoute,
+			Comment: "Edited comment",
+			Status:  entity.CommentStatusApproved,
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPut, "/comments/"+testCommentNewsIDRoute, bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Edit-Token", "secret-token")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Comment updated successfully", data["message"])
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - moderator edits without edit token", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.PUT("/comments/:id", func(ctx *gin.Context) {
+			ctx.Set("user_id", "moderator-id")
+			ctx.Next()
+		}, commentRouter.Update)
+
+		bodyBytes, err := json.Marshal(map[string]string{"comment": "Edited comment"})
+		assert.NoError(t, err)
+
+		// Mock expectations
+		mockCommentUseCase.On("Update", mock.Anything, testCommentNewsIDRoute, &dto.UpdateCommentRequestDTO{
+			Comment:   "Edited comment",
+			Moderator: true,
+		}).Return(&dto.CommentResponseDTO{ID: testCommentNewsIDRoute, Comment: "Edited comment"}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPut, "/comments/"+testCommentNewsIDRoute, bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - missing comment text", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.PUT("/comments/:id", commentRouter.Update)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodPut, "/comments/"+testCommentNewsIDRoute, bytes.NewBufferString("{}"))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCommentUseCase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - use case errors", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			err      error
+			status   int
+			expected string
+		}{
+			{"comment not found", apperror.ErrNotFound, http.StatusNotFound, "Comment not found"},
+			{"invalid edit token", apperror.ErrInvalidEditToken, http.StatusForbidden, "Invalid edit token"},
+			{"edit window passed", apperror.ErrCommentNotEditable, http.StatusForbidden, "Comment can no longer be edited"},
+			{"rejected by filter", apperror.ErrCommentRejected, http.StatusBadRequest, "Comment rejected by content filter"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// Arrange
+				mockCommentUseCase := new(MockCommentUseCase)
+				mockLogger := new(MockLogger)
+
+				router := setupTestRouter()
+				commentRouter := &commentRoutes{
+					comment: mockCommentUseCase,
+					log:     mockLogger,
+				}
+
+				router.PUT("/comments/:id", commentRouter.Update)
+
+				bodyBytes, err := json.Marshal(map[string]string{"comment": "Edited comment"})
+				assert.NoError(t, err)
+
+				// Mock expectations
+				mockCommentUseCase.On("Update", mock.Anything, testCommentNewsIDRoute, mock.Anything).Return(nil, tc.err)
+
+				// Act
+				req := httptest.NewRequest(http.MethodPut, "/comments/"+testCommentNewsIDRoute, bytes.NewBuffer(bodyBytes))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				// Assert
+				assert.Equal(t, tc.status, w.Code)
+
+				var response map[string]interface{}
+
+				err = json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+
+				meta, ok := response["meta"].(map[string]interface{})
+				assert.True(t, ok)
+				assert.Equal(t, tc.expected, meta["message"])
+
+				mockCommentUseCase.AssertExpectations(t)
+			})
+		}
+	})
+}
+
+func TestCommentRoutes_Delete(t *testing.T) {
+	t.Run("success - author deletes with edit token", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.DELETE("/comments/:id", commentRouter.Delete)
+
+		// Mock expectations
+		mockCommentUseCase.On("Delete", mock.Anything, testCommentNewsIDRoute, &dto.DeleteCommentRequestDTO{
+			EditToken: "secret-token",
+		}).Return(nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodDelete, "/comments/"+testCommentNewsIDRoute, http.NoBody)
+		req.Header.Set("X-Edit-Token", "secret-token")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid edit token", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.DELETE("/comments/:id", commentRouter.Delete)
+
+		// Mock expectations
+		mockCommentUseCase.On("Delete", mock.Anything, testCommentNewsIDRoute, mock.Anything).
+			Return(apperror.ErrInvalidEditToken)
+
+		// Act
+		req := httptest.NewRequest(http.MethodDelete, "/comments/"+testCommentNewsIDRoute, http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusForbidden, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - internal server error", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.DELETE("/comments/:id", commentRouter.Delete)
+
+		// Mock expectations
+		mockCommentUseCase.On("Delete", mock.Anything, testCommentNewsIDRoute, mock.Anything).Return(errCommentDatabase)
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodDelete, "/comments/"+testCommentNewsIDRoute, http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestCommentRoutes_Vote(t *testing.T) {
+	t.Run("success - visitor upvotes", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/:id/votes", commentRouter.Vote)
+
+		// Mock expectations
+		mockCommentUseCase.On("Vote", mock.Anything, testCommentNewsIDRoute, mock.MatchedBy(func(voter string) bool {
+			return strings.HasPrefix(voter, "visitor:")
+		}), 1).Return(&dto.CommentVotesDTO{Upvotes: 1, Score: 1}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/"+testCommentNewsIDRoute+"/votes", bytes.NewBufferString(`{"value": 1}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - signed in user withdraws vote", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/:id/votes", func(ctx *gin.Context) {
+			ctx.Set("user_id", "user-id")
+			ctx.Next()
+		}, commentRouter.Vote)
+
+		// Mock expectations
+		mockCommentUseCase.On("Vote", mock.Anything, testCommentNewsIDRoute, "user:user-id", 0).
+			Return(&dto.CommentVotesDTO{}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/"+testCommentNewsIDRoute+"/votes", bytes.NewBufferString(`{"value": 0}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid vote value", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			body string
+		}{
+			{"out of range", `{"value": 2}`},
+			{"missing value", `{}`},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// Arrange
+				mockCommentUseCase := new(MockCommentUseCase)
+				mockLogger := new(MockLogger)
+
+				router := setupTestRouter()
+				commentRouter := &commentRoutes{
+					comment: mockCommentUseCase,
+					log:     mockLogger,
+				}
+
+				router.POST("/comments/:id/votes", commentRouter.Vote)
+
+				// Mock expectations
+				mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+				// Act
+				req := httptest.NewRequest(http.MethodPost, "/comments/"+testCommentNewsIDRoute+"/votes", bytes.NewBufferString(tc.body))
+				req.Header.Set("Content-Type", "application/json")
+
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				// Assert
+				assert.Equal(t, http.StatusBadRequest, w.Code)
+
+				mockCommentUseCase.AssertNotCalled(t, "Vote", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("error - comment not found", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/:id/votes", commentRouter.Vote)
+
+		// Mock expectations
+		mockCommentUseCase.On("Vote", mock.Anything, testCommentNewsIDRoute, mock.Anything, -1).
+			Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/"+testCommentNewsIDRoute+"/votes", bytes.NewBufferString(`{"value": -1}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+}
+
+func TestCommentRoutes_Report(t *testing.T) {
+	t.Run("success - report comment", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/:id/reports", commentRouter.Report)
+
+		// Mock expectations
+		mockCommentUseCase.On("Report", mock.Anything, testCommentNewsIDRoute, mock.Anything, &dto.ReportCommentRequestDTO{
+			Reason: "abuse",
+			Note:   "Insults other readers",
+		}).Return(nil)
+
+		// Act
+		body := `{"reason": "abuse", "note": "Insults other readers"}`
+		req := httptest.NewRequest(http.MethodPost, "/comments/"+testCommentNewsIDRoute+"/reports", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid reason", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/:id/reports", commentRouter.Report)
+
+		// Mock expectations
+		mockCommentUseCase.On("Report", mock.Anything, testCommentNewsIDRoute, mock.Anything, mock.Anything).
+			Return(apperror.ErrInvalidReportReason)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/"+testCommentNewsIDRoute+"/reports", bytes.NewBufferString(`{"reason": "boring"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - comment not found", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.POST("/comments/:id/reports", commentRouter.Report)
+
+		// Mock expectations
+		mockCommentUseCase.On("Report", mock.Anything, testCommentNewsIDRoute, mock.Anything, mock.Anything).
+			Return(apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/comments/"+testCommentNewsIDRoute+"/reports", bytes.NewBufferString(`{"reason": "spam"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+}
+
+func TestCommentRoutes_GetReported(t *testing.T) {
+	t.Run("success - list reported comments", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/comments/moderation/reports", commentRouter.GetReported)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetReported", mock.Anything, 0, 0).Return([]dto.ReportedCommentDTO{
+			{Comment: dto.CommentResponseDTO{ID: testCommentNewsIDRoute}, Reports: 3, Reasons: []string{"spam"}},
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/comments/moderation/reports", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+
+		comments, ok := data["comments"].([]interface{})
+		assert.True(t, ok)
+		assert.Len(t, comments, 1)
+
+		mockCommentUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - internal server error", func(t *testing.T) {
+		// Arrange
+		mockCommentUseCase := new(MockCommentUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		commentRouter := &commentRoutes{
+			comment: mockCommentUseCase,
+			log:     mockLogger,
+		}
+
+		router.GET("/comments/moderation/reports", commentRouter.GetReported)
+
+		// Mock expectations
+		mockCommentUseCase.On("GetReported", mock.Anything, 0, 0).Return(nil, errCommentDatabase)
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/comments/moderation/reports", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		mockLogger.AssertExpectations(t)
+	})
 }
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/blocks"
+	"github.com/gin-gonic/gin"
+)
+
+// Values of the render query parameter on reads of news and custom pages.
+const (
+	contentRenderHTML   = "html"
+	contentRenderSource = "source"
+	contentRenderText   = "text"
+)
+
+// contentRender reads the render query parameter: content is returned as
+// sanitized HTML, as written in its content_format, or as plain text. Any
+// other value gets a 400 response and ok is false.
+func contentRender(ctx *gin.Context) (render string, ok bool) {
+	render = ctx.DefaultQuery("render", contentRenderHTML)
+
+	switch render {
+	case contentRenderHTML, contentRenderSource, contentRenderText:
+		return render, true
+	default:
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidRender)
+
+		return "", false
+	}
+}
+
+func renderNews(news *dto.NewsResponseDTO, render string) {
+	news.Content = renderedContent(render, news.Content, news.ContentHTML, news.ContentText)
+}
+
+func renderPage(page *dto.CustomPageResponseDTO, render string) {
+	page.Content = renderedContent(render, page.Content, page.ContentHTML, page.ContentText)
+}
+
+func renderedContent(render, source, html, text string) string {
+	switch render {
+	case contentRenderSource:
+		return source
+	case contentRenderText:
+		return text
+	default:
+		return html
+	}
+}
+
+// sendContentError responds to an invalid block document and reports
+// whether err was one.
+func sendContentError(ctx *gin.Context, err error) bool {
+	var blockErr *blocks.Error
+	if !errors.As(err, &blockErr) {
+		return false
+	}
+
+	fieldErr := response.FieldError{Field: "content." + blockErr.Path()}
+
+	switch blockErr.Reason {
+	case blocks.ReasonDocument:
+		fieldErr.Code = response.FieldBlockDocument
+	case blocks.ReasonRequired:
+		fieldErr.Code = response.FieldRequired
+	case blocks.ReasonType:
+		fieldErr.Code = response.FieldOneOf
+		fieldErr.Args = []interface{}{"paragraph, heading, image, quote, embed, code, list, html"}
+	case blocks.ReasonNotAllowed:
+		fieldErr.Code = response.FieldBlockFieldNotAllowed
+		fieldErr.Args = []interface{}{blockErr.Type}
+	case blocks.ReasonLevel:
+		fieldErr.Code = response.FieldHeadingLevel
+	case blocks.ReasonURLOrPath:
+		fieldErr.Code = response.FieldURLOrPath
+	case blocks.ReasonAbsoluteURL:
+		fieldErr.Code = response.FieldAbsoluteURL
+	case blocks.ReasonStyle:
+		fieldErr.Code = response.FieldListStyle
+	case blocks.ReasonNoItems:
+		fieldErr.Code = response.FieldListItems
+	default:
+		fieldErr.Code = response.FieldInvalid
+	}
+
+	response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidContent, []response.FieldError{fieldErr})
+
+	return true
+}
@@ -25,6 +25,8 @@ func newCustomPageRoutes(handler *gin.RouterGroup, customPage usecase.CustomPage
 	{
 		// Public endpoints - anyone can read custom pages
 		h.GET("", customPageRouter.GetAll)
+		h.GET("/by-url", customPageRouter.GetByURL)
+		h.GET("/tree", customPageRouter.GetTree)
 		h.GET("/:id", customPageRouter.GetByID)
 
 		// Protected endpoints - only authenticated users
@@ -34,70 +36,203 @@ func newCustomPageRoutes(handler *gin.RouterGroup, customPage usecase.CustomPage
 	}
 }
 
+// newCustomPageServer returns the handler serving custom pages at their
+// custom URL, for requests that match no other route.
+func newCustomPageServer(customPage usecase.CustomPage, log logger.Interface) gin.HandlerFunc {
+	customPageRouter := customPageRoutes{customPage, log}
+
+	return customPageRouter.Serve
+}
+
 // @Summary Get all custom pages
-// @Description Retrieve a list of all custom pages
+// @Description Retrieve a list of all custom pages, one translation of each in the locale the reader prefers
 // @Tags CustomPages
 // @Accept json
 // @Produce json
+// @Param render query string false "Content as sanitized HTML, as written in its content_format, or as plain text" Enums(html, source, text) default(html)
+// @Param lang query string false "Comma separated locales to list translations in, best first, overriding Accept-Language; * lists every translation" example(id,en)
 // @Success 200 {object} response.Response "List of custom pages"
+// @Failure 400 {object} response.ErrorResponse "Invalid render"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /pages [get]
 func (cp *customPageRoutes) GetAll(ctx *gin.Context) {
-	pageList, err := cp.customPage.GetAll(ctx)
+	render, ok := contentRender(ctx)
+	if !ok {
+		return
+	}
+
+	pageList, err := cp.customPage.GetAll(ctx, readerLocales(ctx, true))
 	if err != nil {
 		cp.log.Error(err, "CustomPageController - GetAll - cp.customPage.GetAll")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
 
+	for i := range pageList {
+		renderPage(&pageList[i], render)
+	}
+
 	response.SendSuccess(ctx, http.StatusOK, gin.H{
 		"pages": pageList,
 	})
 }
 
+// @Summary Get the custom page tree
+// @Description Retrieve every custom page nested under its parent, without content. Siblings are ordered by
+// @Description position, then custom URL.
+// @Tags CustomPages
+// @Accept json
+// @Produce json
+// @Success 200 {object} response.Response "Page tree"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /pages/tree [get]
+func (cp *customPageRoutes) GetTree(ctx *gin.Context) {
+	tree, err := cp.customPage.GetTree(ctx)
+	if err != nil {
+		cp.log.Error(err, "CustomPageController - GetTree - cp.customPage.GetTree")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"pages": tree,
+	})
+}
+
 // @Summary Get custom page by ID
-// @Description Retrieve a single custom page by its ID
+// @Description Retrieve a single custom page by its ID, with breadcrumbs from the root page down to it
 // @Tags CustomPages
 // @Accept json
 // @Produce json
 // @Param id path string true "Page ID"
+// @Param render query string false "Content as sanitized HTML, as written in its content_format, or as plain text" Enums(html, source, text) default(html)
+// @Param lang query string false "Comma separated locales, best first, to return the translation ranked best in instead" example(id,en)
 // @Success 200 {object} response.Response "Page detail"
+// @Failure 400 {object} response.ErrorResponse "Invalid render"
 // @Failure 404 {object} response.ErrorResponse "Page not found"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /pages/{id} [get]
 func (cp *customPageRoutes) GetByID(ctx *gin.Context) {
+	render, ok := contentRender(ctx)
+	if !ok {
+		return
+	}
+
 	id := ctx.Param("id")
 
-	page, err := cp.customPage.GetByID(ctx, id)
+	page, err := cp.customPage.GetByID(ctx, id, readerLocales(ctx, false))
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "Page not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodePageNotFound)
 
 			return
 		}
 
 		cp.log.Error(err, "CustomPageController - GetByID - cp.customPage.GetByID")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	renderPage(page, render)
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"page": page,
+	})
+}
+
+// @Summary Get custom page by URL
+// @Description Retrieve a single custom page by its custom URL, with breadcrumbs. The path is matched
+// @Description case-insensitively and regardless of trailing slashes or percent-encoding.
+// @Tags CustomPages
+// @Accept json
+// @Produce json
+// @Param path query string true "Custom URL" example(/about-us)
+// @Param render query string false "Content as sanitized HTML, as written in its content_format, or as plain text" Enums(html, source, text) default(html)
+// @Param lang query string false "Comma separated locales, best first, to return the translation ranked best in instead" example(id,en)
+// @Success 200 {object} response.Response "Page detail"
+// @Failure 400 {object} response.ErrorResponse "Invalid custom URL"
+// @Failure 404 {object} response.ErrorResponse "Page not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /pages/by-url [get]
+func (cp *customPageRoutes) GetByURL(ctx *gin.Context) {
+	render, ok := contentRender(ctx)
+	if !ok {
+		return
+	}
+
+	page, err := cp.customPage.GetByURL(ctx, ctx.Query("path"), readerLocales(ctx, false))
+	if err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrInvalidPath):
+			response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidCustomURL)
+		case errors.Is(err, apperror.ErrNotFound):
+			response.SendError(ctx, http.StatusNotFound, response.CodePageNotFound)
+		default:
+			cp.log.Error(err, "CustomPageController - GetByURL - cp.customPage.GetByURL")
+			response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+		}
 
 		return
 	}
 
+	renderPage(page, render)
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"page": page,
+	})
+}
+
+// Serve resolves the request path against the custom pages' URLs. It backs
+// the catch-all route, so anything that is not a readable page is a 404.
+func (cp *customPageRoutes) Serve(ctx *gin.Context) {
+	if ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead {
+		response.SendError(ctx, http.StatusNotFound, response.CodeNotFound)
+
+		return
+	}
+
+	render, ok := contentRender(ctx)
+	if !ok {
+		return
+	}
+
+	page, err := cp.customPage.GetByURL(ctx, ctx.Request.URL.EscapedPath(), readerLocales(ctx, false))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) || errors.Is(err, apperror.ErrInvalidPath) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeNotFound)
+
+			return
+		}
+
+		cp.log.Error(err, "CustomPageController - Serve - cp.customPage.GetByURL")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	renderPage(page, render)
+
 	response.SendSuccess(ctx, http.StatusOK, gin.H{
 		"page": page,
 	})
 }
 
 // @Summary Create a new custom page
-// @Description Create a new custom page (requires authentication)
+// @Description Create a new custom page (requires authentication). With a slug, the custom URL is the
+// @Description parent's URL followed by the slug, and follows the parent when it moves. HTML in the content is
+// @Description sanitized.
 // @Tags CustomPages
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param request body request.CustomPage true "Page information"
 // @Success 201 {object} response.Response "Page created successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload, custom URL, content blocks, locale or translation"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 409 {object} response.ValidationErrorResponse "Custom URL already in use or translation already exists in the locale"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /pages [post]
 func (cp *customPageRoutes) Create(ctx *gin.Context) {
@@ -106,7 +241,7 @@ func (cp *customPageRoutes) Create(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		cp.log.Error(err, "CustomPageController - Create - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		sendBindError(ctx, &req, err)
 
 		return
 	}
@@ -114,30 +249,42 @@ func (cp *customPageRoutes) Create(ctx *gin.Context) {
 	// Get user ID from context (set by auth middleware)
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		response.SendError(ctx, http.StatusUnauthorized, "User not authenticated")
+		response.SendError(ctx, http.StatusUnauthorized, response.CodeUnauthenticated)
 
 		return
 	}
 
 	authorID, ok := userID.(string)
 	if !ok {
-		response.SendError(ctx, http.StatusInternalServerError, "Invalid user ID format")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInvalidUserID)
 
 		return
 	}
 
 	// Create custom page
 	page, err := cp.customPage.Create(ctx, authorID, &dto.CreateCustomPageRequestDTO{
-		CustomURL: req.CustomURL,
-		Content:   req.Content,
+		ParentID:      req.ParentID,
+		Position:      req.Position,
+		Slug:          req.Slug,
+		CustomURL:     req.CustomURL,
+		Content:       req.Content,
+		ContentFormat: req.ContentFormat,
+		Locale:        req.Locale,
+		TranslationOf: req.TranslationOf,
 	})
 	if err != nil {
+		if sendCustomURLError(ctx, err) || sendContentError(ctx, err) || sendTranslationError(ctx, err) {
+			return
+		}
+
 		cp.log.Error(err, "CustomPageController - Create - cp.customPage.Create")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
 
+	renderPage(page, contentRenderHTML)
+
 	// Success response
 	response.SendSuccess(ctx, http.StatusCreated, gin.H{
 		"page": page,
@@ -145,7 +292,8 @@ func (cp *customPageRoutes) Create(ctx *gin.Context) {
 }
 
 // @Summary Update a custom page
-// @Description Update an existing custom page (requires authentication)
+// @Description Update an existing custom page (requires authentication). Moving a page redirects its old URL
+// @Description and re-paths the descendants whose URL is derived from it. HTML in the content is sanitized.
 // @Tags CustomPages
 // @Accept json
 // @Produce json
@@ -153,9 +301,10 @@ func (cp *customPageRoutes) Create(ctx *gin.Context) {
 // @Param id path string true "Page ID"
 // @Param request body request.UpdateCustomPage true "Updated page information"
 // @Success 200 {object} response.Response "Page updated successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload, custom URL, content blocks, locale or translation"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 404 {object} response.ErrorResponse "Page not found"
+// @Failure 409 {object} response.ValidationErrorResponse "Custom URL already in use or translation already exists in the locale"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /pages/{id} [put]
 func (cp *customPageRoutes) Update(ctx *gin.Context) {
@@ -166,25 +315,38 @@ func (cp *customPageRoutes) Update(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		cp.log.Error(err, "CustomPageController - Update - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		sendBindError(ctx, &req, err)
 
 		return
 	}
 
+	// The route requires authentication, and an unknown editor is never trusted
+	// with raw HTML
+	editorID := ctx.GetString("user_id")
+
 	// Update custom page
-	err := cp.customPage.Update(ctx, id, &dto.UpdateCustomPageRequestDTO{
-		CustomURL: req.CustomURL,
-		Content:   req.Content,
+	err := cp.customPage.Update(ctx, editorID, id, &dto.UpdateCustomPageRequestDTO{
+		ParentID:      req.ParentID,
+		Position:      req.Position,
+		Slug:          req.Slug,
+		CustomURL:     req.CustomURL,
+		Content:       req.Content,
+		ContentFormat: req.ContentFormat,
+		Locale:        req.Locale,
 	})
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "Page not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodePageNotFound)
+
+			return
+		}
 
+		if sendCustomURLError(ctx, err) || sendContentError(ctx, err) || sendTranslationError(ctx, err) {
 			return
 		}
 
 		cp.log.Error(err, "CustomPageController - Update - cp.customPage.Update")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -214,13 +376,13 @@ func (cp *customPageRoutes) Delete(ctx *gin.Context) {
 	err := cp.customPage.Delete(ctx, id)
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "Page not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodePageNotFound)
 
 			return
 		}
 
 		cp.log.Error(err, "CustomPageController - Delete - cp.customPage.Delete")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -230,3 +392,40 @@ func (cp *customPageRoutes) Delete(ctx *gin.Context) {
 		"message": "Page deleted successfully",
 	})
 }
+
+// sendCustomURLError responds to errors caused by where the submitted page
+// would live, its custom_url, slug or parent, and reports whether err was one
+// of them.
+func sendCustomURLError(ctx *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, apperror.ErrDuplicateKey):
+		response.SendValidationError(ctx, http.StatusConflict, response.CodeCustomURLExists, []response.FieldError{
+			{Field: "custom_url", Code: response.FieldPageURLTaken},
+		})
+
+		return true
+	case errors.Is(err, apperror.ErrInvalidParentPage):
+		response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidParentPage, []response.FieldError{
+			{Field: "parent_id", Code: response.FieldParentPage},
+		})
+
+		return true
+	case errors.Is(err, apperror.ErrInvalidSlug):
+		response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidSlug, []response.FieldError{
+			{Field: "slug", Code: response.FieldPathSegment},
+		})
+
+		return true
+	}
+
+	code, ok := sitePathCode(err)
+	if !ok {
+		return false
+	}
+
+	response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidCustomURL, []response.FieldError{
+		{Field: "custom_url", Code: code},
+	})
+
+	return true
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -41,8 +42,8 @@ func (m *MockCustomPageUseCase) Create(ctx context.Context, authorID string, req
 	return result, args.Error(1)
 }
 
-func (m *MockCustomPageUseCase) GetByID(ctx context.Context, id string) (*dto.CustomPageResponseDTO, error) {
-	args := m.Called(ctx, id)
+func (m *MockCustomPageUseCase) GetByID(ctx context.Context, id string, locales []string) (*dto.CustomPageResponseDTO, error) {
+	args := m.Called(ctx, id, locales)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -55,8 +56,22 @@ func (m *MockCustomPageUseCase) GetByID(ctx context.Context, id string) (*dto.Cu
 	return result, args.Error(1)
 }
 
-func (m *MockCustomPageUseCase) GetAll(ctx context.Context) ([]dto.CustomPageResponseDTO, error) {
-	args := m.Called(ctx)
+func (m *MockCustomPageUseCase) GetByURL(ctx context.Context, path string, locales []string) (*dto.CustomPageResponseDTO, error) {
+	args := m.Called(ctx, path, locales)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.CustomPageResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCustomPageUseCase) GetAll(ctx context.Context, locales []string) ([]dto.CustomPageResponseDTO, error) {
+	args := m.Called(ctx, locales)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -69,8 +84,22 @@ func (m *MockCustomPageUseCase) GetAll(ctx context.Context) ([]dto.CustomPageRes
 	return result, args.Error(1)
 }
 
-func (m *MockCustomPageUseCase) Update(ctx context.Context, id string, req *dto.UpdateCustomPageRequestDTO) error {
-	args := m.Called(ctx, id, req)
+func (m *MockCustomPageUseCase) GetTree(ctx context.Context) ([]dto.CustomPageTreeDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.CustomPageTreeDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCustomPageUseCase) Update(ctx context.Context, editorID, id string, req *dto.UpdateCustomPageRequestDTO) error {
+	args := m.Called(ctx, editorID, id, req)
 
 	return args.Error(0)
 }
@@ -108,7 +137,7 @@ func TestCustomPageRoutes_GetAll(t *testing.T) {
 		}
 
 		// Mock expectations
-		mockCustomPageUseCase.On("GetAll", mock.Anything).Return(expectedPages, nil)
+		mockCustomPageUseCase.On("GetAll", mock.Anything, mock.Anything).Return(expectedPages, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/pages", http.NoBody)
@@ -143,7 +172,7 @@ func TestCustomPageRoutes_GetAll(t *testing.T) {
 		router.GET("/pages", customPageRouter.GetAll)
 
 		// Mock expectations
-		mockCustomPageUseCase.On("GetAll", mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
+		mockCustomPageUseCase.On("GetAll", mock.Anything, mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -170,6 +199,45 @@ func TestCustomPageRoutes_GetAll(t *testing.T) {
 	})
 }
 
+func TestCustomPageRoutes_GetTree(t *testing.T) {
+	t.Run("success - nested pages", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.GET("/pages/tree", customPageRouter.GetTree)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetTree", mock.Anything).Return([]dto.CustomPageTreeDTO{
+			{
+				ID:        testCustomPageID,
+				CustomURL: "/help",
+				Children: []dto.CustomPageTreeDTO{
+					{ID: "550e8400-e29b-41d4-a716-446655440009", CustomURL: "/help/billing", Children: []dto.CustomPageTreeDTO{}},
+				},
+			},
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/pages/tree", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"custom_url":"/help/billing"`)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+}
+
 func TestCustomPageRoutes_GetByID(t *testing.T) {
 	t.Run("success - get custom page by id", func(t *testing.T) {
 		// Arrange
@@ -195,7 +263,7 @@ func TestCustomPageRoutes_GetByID(t *testing.T) {
 		}
 
 		// Mock expectations
-		mockCustomPageUseCase.On("GetByID", mock.Anything, testCustomPageID).Return(expectedPage, nil)
+		mockCustomPageUseCase.On("GetByID", mock.Anything, testCustomPageID, mock.Anything).Return(expectedPage, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/pages/"+testCustomPageID, http.NoBody)
@@ -230,7 +298,7 @@ func TestCustomPageRoutes_GetByID(t *testing.T) {
 		router.GET("/pages/:id", customPageRouter.GetByID)
 
 		// Mock expectations
-		mockCustomPageUseCase.On("GetByID", mock.Anything, "non-existent-id").Return(nil, apperror.ErrNotFound)
+		mockCustomPageUseCase.On("GetByID", mock.Anything, "non-existent-id", mock.Anything).Return(nil, apperror.ErrNotFound)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/pages/non-existent-id", http.NoBody)
@@ -268,7 +336,7 @@ func TestCustomPageRoutes_GetByID(t *testing.T) {
 		router.GET("/pages/:id", customPageRouter.GetByID)
 
 		// Mock expectations
-		mockCustomPageUseCase.On("GetByID", mock.Anything, testCustomPageID).Return(nil, apperror.ErrDatabaseConnection)
+		mockCustomPageUseCase.On("GetByID", mock.Anything, testCustomPageID, mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -285,6 +353,167 @@ func TestCustomPageRoutes_GetByID(t *testing.T) {
 	})
 }
 
+func TestCustomPageRoutes_GetByURL(t *testing.T) {
+	t.Run("success - get custom page by url", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.GET("/pages/by-url", customPageRouter.GetByURL)
+		router.GET("/pages/:id", customPageRouter.GetByID)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, "/About-Us/", mock.Anything).Return(&dto.CustomPageResponseDTO{
+			ID:        testCustomPageID,
+			CustomURL: testCustomPageURL,
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/pages/by-url?path=/About-Us/", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid path", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.GET("/pages/by-url", customPageRouter.GetByURL)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, "", mock.Anything).Return(nil, apperror.ErrInvalidPath)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/pages/by-url", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - page not found", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.GET("/pages/by-url", customPageRouter.GetByURL)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, "/missing", mock.Anything).Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/pages/by-url?path=/missing", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+}
+
+func TestCustomPageRoutes_Serve(t *testing.T) {
+	t.Run("success - serve page at its custom url", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		router.NoRoute(newCustomPageServer(mockCustomPageUseCase, mockLogger))
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, "/about-us", mock.Anything).Return(&dto.CustomPageResponseDTO{
+			ID:        testCustomPageID,
+			CustomURL: testCustomPageURL,
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/about-us", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - unknown path", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		router.NoRoute(newCustomPageServer(mockCustomPageUseCase, mockLogger))
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, "/missing", mock.Anything).Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/missing", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - non-GET request", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		router.NoRoute(newCustomPageServer(mockCustomPageUseCase, mockLogger))
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/about-us", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockCustomPageUseCase.AssertNotCalled(t, "GetByURL", mock.Anything, mock.Anything)
+	})
+}
+
 func TestCustomPageRoutes_Create(t *testing.T) {
 	t.Run("success - create custom page", func(t *testing.T) {
 		// Arrange
@@ -394,10 +623,155 @@ func TestCustomPageRoutes_Create(t *testing.T) {
 		assert.Equal(t, float64(400), meta["code"])
 		assert.Equal(t, "Invalid request payload", meta["message"])
 
+		errs, ok := response["errors"].([]interface{})
+		assert.True(t, ok)
+		assert.ElementsMatch(t, []interface{}{
+			map[string]interface{}{"field": "custom_url", "code": "required_without", "message": "is required when slug is not set"},
+			map[string]interface{}{"field": "content", "code": "required", "message": "is required"},
+		}, errs)
+
 		mockCustomPageUseCase.AssertNotCalled(t, "Create")
 		mockLogger.AssertExpectations(t)
 	})
 
+	t.Run("error - invalid request payload in the locale of the reader", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.POST("/pages", func(c *gin.Context) {
+			c.Set("user_id", testCustomPageAuthorID)
+			customPageRouter.Create(c)
+		})
+
+		bodyBytes := []byte(`{}`)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/pages", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Accept-Language", "id")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta, ok := response["meta"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "invalid_payload", meta["error_code"])
+		assert.Equal(t, "Isi permintaan tidak valid", meta["message"])
+
+		errs, ok := response["errors"].([]interface{})
+		assert.True(t, ok)
+		assert.ElementsMatch(t, []interface{}{
+			map[string]interface{}{"field": "custom_url", "code": "required_without", "message": "wajib diisi jika slug tidak diisi"},
+			map[string]interface{}{"field": "content", "code": "required", "message": "wajib diisi"},
+		}, errs)
+
+		mockCustomPageUseCase.AssertNotCalled(t, "Create")
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("error - reserved custom url", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.POST("/pages", func(c *gin.Context) {
+			c.Set("user_id", testCustomPageAuthorID)
+			customPageRouter.Create(c)
+		})
+
+		// Mock expectations
+		mockCustomPageUseCase.On("Create", mock.Anything, testCustomPageAuthorID, mock.Anything).
+			Return(nil, apperror.ErrReservedPath)
+
+		// Act
+		bodyBytes := []byte(`{"custom_url": "/api/v1/news", "content": "About us content"}`)
+		req := httptest.NewRequest(http.MethodPost, "/pages", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		errs, ok := response["errors"].([]interface{})
+		assert.True(t, ok)
+		assert.Len(t, errs, 1)
+
+		fieldErr, ok := errs[0].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "custom_url", fieldErr["field"])
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - custom url already in use", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.POST("/pages", func(c *gin.Context) {
+			c.Set("user_id", testCustomPageAuthorID)
+			customPageRouter.Create(c)
+		})
+
+		// Mock expectations
+		mockCustomPageUseCase.On("Create", mock.Anything, testCustomPageAuthorID, mock.Anything).
+			Return(nil, apperror.ErrDuplicateKey)
+
+		// Act
+		bodyBytes := []byte(`{"custom_url": "/About-Us/", "content": "About us content"}`)
+		req := httptest.NewRequest(http.MethodPost, "/pages", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+		mockLogger.AssertNotCalled(t, "Error", mock.Anything, mock.Anything)
+	})
+
 	t.Run("error - user not authenticated", func(t *testing.T) {
 		// Arrange
 		mockCustomPageUseCase := new(MockCustomPageUseCase)
@@ -544,7 +918,11 @@ func TestCustomPageRoutes_Update(t *testing.T) {
 			log:        mockLogger,
 		}
 
-		router.PUT("/pages/:id", customPageRouter.Update)
+		// Simulate authenticated user middleware setting user_id
+		router.PUT("/pages/:id", func(c *gin.Context) {
+			c.Set("user_id", testCustomPageAuthorID)
+			customPageRouter.Update(c)
+		})
 
 		requestBody := map[string]string{
 			"custom_url": "/about-company",
@@ -554,7 +932,7 @@ func TestCustomPageRoutes_Update(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockCustomPageUseCase.On("Update", mock.Anything, testCustomPageID, &dto.UpdateCustomPageRequestDTO{
+		mockCustomPageUseCase.On("Update", mock.Anything, testCustomPageAuthorID, testCustomPageID, &dto.UpdateCustomPageRequestDTO{
 			CustomURL: "/about-company",
 			Content:   "Updated content",
 		}).Return(nil)
@@ -614,6 +992,81 @@ func TestCustomPageRoutes_Update(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 
+	t.Run("error - custom url too long", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.PUT("/pages/:id", customPageRouter.Update)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		bodyBytes, err := json.Marshal(map[string]string{
+			"custom_url": "/" + strings.Repeat("a", 150),
+			"content":    "About us content",
+		})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/pages/"+testCustomPageID, bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err = json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"field": "custom_url", "code": "max_length", "message": "must be at most 150 characters"},
+		}, response["errors"])
+
+		mockCustomPageUseCase.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("error - custom url already in use", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.PUT("/pages/:id", customPageRouter.Update)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("Update", mock.Anything, mock.Anything, testCustomPageID, mock.Anything).Return(apperror.ErrDuplicateKey)
+
+		// Act
+		bodyBytes := []byte(`{"custom_url": "/contact", "content": "About us content"}`)
+		req := httptest.NewRequest(http.MethodPut, "/pages/"+testCustomPageID, bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
 	t.Run("error - page not found", func(t *testing.T) {
 		// Arrange
 		mockCustomPageUseCase := new(MockCustomPageUseCase)
@@ -635,7 +1088,7 @@ func TestCustomPageRoutes_Update(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockCustomPageUseCase.On("Update", mock.Anything, "non-existent-id", mock.Anything).Return(apperror.ErrNotFound)
+		mockCustomPageUseCase.On("Update", mock.Anything, mock.Anything, "non-existent-id", mock.Anything).Return(apperror.ErrNotFound)
 
 		// Act
 		req := httptest.NewRequest(http.MethodPut, "/pages/non-existent-id", bytes.NewBuffer(bodyBytes))
@@ -682,7 +1135,7 @@ func TestCustomPageRoutes_Update(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockCustomPageUseCase.On("Update", mock.Anything, testCustomPageID, mock.Anything).Return(apperror.ErrDatabaseConnection)
+		mockCustomPageUseCase.On("Update", mock.Anything, mock.Anything, testCustomPageID, mock.Anything).Return(apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -701,6 +1154,115 @@ func TestCustomPageRoutes_Update(t *testing.T) {
 	})
 }
 
+func TestCustomPageRoutes_Hierarchy(t *testing.T) {
+	t.Run("success - slug instead of custom url", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.PUT("/pages/:id", customPageRouter.Update)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("Update", mock.Anything, mock.Anything, testCustomPageID, &dto.UpdateCustomPageRequestDTO{
+			ParentID: testCustomPageAuthorID,
+			Position: 2,
+			Slug:     "billing",
+			Content:  "Billing help",
+		}).Return(nil)
+
+		// Act
+		body := `{"parent_id": "` + testCustomPageAuthorID + `", "position": 2, "slug": "billing", "content": "Billing help"}`
+		req := httptest.NewRequest(http.MethodPut, "/pages/"+testCustomPageID, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - neither custom url nor slug", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.PUT("/pages/:id", customPageRouter.Update)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodPut, "/pages/"+testCustomPageID, bytes.NewBufferString(`{"content": "Billing help", "position": -1}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"field": "position", "code": "min", "message": "must be at least 0"},
+			map[string]interface{}{"field": "custom_url", "code": "required_without", "message": "is required when slug is not set"},
+		}, response["errors"])
+
+		mockCustomPageUseCase.AssertNotCalled(t, "Update")
+	})
+
+	t.Run("error - page moved below itself", func(t *testing.T) {
+		// Arrange
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		customPageRouter := &customPageRoutes{
+			customPage: mockCustomPageUseCase,
+			log:        mockLogger,
+		}
+
+		router.PUT("/pages/:id", customPageRouter.Update)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("Update", mock.Anything, mock.Anything, testCustomPageID, mock.Anything).Return(apperror.ErrInvalidParentPage)
+
+		// Act
+		body := `{"parent_id": "` + testCustomPageAuthorID + `", "slug": "billing", "content": "Billing help"}`
+		req := httptest.NewRequest(http.MethodPut, "/pages/"+testCustomPageID, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"parent_id"`)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+}
+
 func TestCustomPageRoutes_Delete(t *testing.T) {
 	t.Run("success - delete custom page", func(t *testing.T) {
 		// Arrange
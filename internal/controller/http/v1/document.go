@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// _documentETagLength is the number of hex digits of the body hash in the
+// ETag of a generated document.
+const _documentETagLength = 32
+
+// serveDocument writes a generated document such as a feed or sitemap.
+// Clients revalidate with the ETag, a hash of the body, or with modTime,
+// when the content last changed; a zero modTime is unknown.
+func serveDocument(ctx *gin.Context, contentType string, modTime time.Time, body []byte) {
+	sum := sha256.Sum256(body)
+
+	ctx.Header("Content-Type", contentType)
+	ctx.Header("ETag", `"`+hex.EncodeToString(sum[:])[:_documentETagLength]+`"`)
+	http.ServeContent(ctx.Writer, ctx.Request, "", modTime, bytes.NewReader(body))
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/feed"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// Feed formats, by the extension of the feed URL.
+const (
+	_feedExtRSS  = ".rss"
+	_feedExtAtom = ".atom"
+)
+
+type feedRoutes struct {
+	feed usecase.Feed
+	log  logger.Interface
+}
+
+// newFeedRoutes registers the public RSS and Atom feeds of the latest news,
+// e.g. /feeds/news.rss, /feeds/categories/1b4e…9f2c.atom and
+// /feeds/tags/technology.rss.
+func newFeedRoutes(handler *gin.Engine, feedUc usecase.Feed, log logger.Interface) {
+	feedRouter := feedRoutes{feed: feedUc, log: log}
+
+	handler.GET("/feeds/news.rss", feedRouter.News)
+	handler.HEAD("/feeds/news.rss", feedRouter.News)
+	handler.GET("/feeds/news.atom", feedRouter.News)
+	handler.HEAD("/feeds/news.atom", feedRouter.News)
+	handler.GET("/feeds/categories/:file", feedRouter.Category)
+	handler.HEAD("/feeds/categories/:file", feedRouter.Category)
+	handler.GET("/feeds/tags/:file", feedRouter.Tag)
+	handler.HEAD("/feeds/tags/:file", feedRouter.Tag)
+}
+
+// News serves the feed of the latest news.
+func (fr *feedRoutes) News(ctx *gin.Context) {
+	result, err := fr.feed.News(ctx, "")
+	fr.serve(ctx, result, err, path.Ext(ctx.Request.URL.Path), "FeedController - News - fr.feed.News")
+}
+
+// Category serves the feed of the latest news of a category, named by its
+// ID and the feed format.
+func (fr *feedRoutes) Category(ctx *gin.Context) {
+	categoryID, ext, ok := feedFile(ctx.Param("file"))
+	if !ok {
+		ctx.Status(http.StatusNotFound)
+
+		return
+	}
+
+	result, err := fr.feed.News(ctx, categoryID)
+	fr.serve(ctx, result, err, ext, "FeedController - Category - fr.feed.News")
+}
+
+// Tag serves the feed of the latest news with a tag, named by the tag and
+// the feed format.
+func (fr *feedRoutes) Tag(ctx *gin.Context) {
+	tag, ext, ok := feedFile(ctx.Param("file"))
+	if !ok || tag == "" {
+		ctx.Status(http.StatusNotFound)
+
+		return
+	}
+
+	result, err := fr.feed.Tag(ctx, tag)
+	fr.serve(ctx, result, err, ext, "FeedController - Tag - fr.feed.Tag")
+}
+
+// feedFile splits the file name of a feed into its name and format
+// extension, reporting whether the format is known.
+func feedFile(file string) (name, ext string, ok bool) {
+	ext = path.Ext(file)
+	if ext != _feedExtRSS && ext != _feedExtAtom {
+		return "", "", false
+	}
+
+	return strings.TrimSuffix(file, ext), ext, true
+}
+
+// serve writes the feed result, or the error building it, in the format of
+// ext, last modified when its latest article was updated.
+func (fr *feedRoutes) serve(ctx *gin.Context, result *dto.FeedDTO, err error, ext, msg string) {
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			ctx.Status(http.StatusNotFound)
+
+			return
+		}
+
+		fr.log.Error(err, msg)
+		ctx.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	write, contentType := feed.WriteRSS, feed.ContentTypeRSS
+	if ext == _feedExtAtom {
+		write, contentType = feed.WriteAtom, feed.ContentTypeAtom
+	}
+
+	var body bytes.Buffer
+	if err := write(&body, feedOf(result, ext)); err != nil {
+		fr.log.Error(err, "FeedController - serve - write")
+		ctx.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	serveDocument(ctx, contentType, result.Updated, body.Bytes())
+}
+
+// feedOf converts a feed to the form written at the URL ending in ext.
+func feedOf(result *dto.FeedDTO, ext string) *feed.Feed {
+	f := &feed.Feed{
+		Title:       result.Title,
+		Description: result.Description,
+		Link:        result.Link,
+		Self:        result.URL + ext,
+		Updated:     result.Updated,
+		Items:       make([]feed.Item, 0, len(result.Items)),
+	}
+
+	for i := range result.Items {
+		item := &result.Items[i]
+
+		f.Items = append(f.Items, feed.Item{
+			ID:        item.ID,
+			Title:     item.Title,
+			Link:      item.Link,
+			Summary:   item.Summary,
+			Content:   item.Content,
+			Published: item.Published,
+			Updated:   item.Updated,
+		})
+	}
+
+	return f
+}
@@ -0,0 +1,335 @@
+package v1
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testFeedCategoryID = "550e8400-e29b-41d4-a716-446655440050"
+
+// MockFeedUseCase is a mock implementation of usecase.Feed.
+type MockFeedUseCase struct {
+	mock.Mock
+}
+
+func (m *MockFeedUseCase) News(ctx context.Context, categoryID string) (*dto.FeedDTO, error) {
+	args := m.Called(ctx, categoryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.FeedDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockFeedUseCase) Tag(ctx context.Context, tag string) (*dto.FeedDTO, error) {
+	args := m.Called(ctx, tag)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.FeedDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func setupFeedRouter(feedUseCase *MockFeedUseCase, mockLogger *MockLogger) *gin.Engine {
+	router := setupTestRouter()
+	newFeedRoutes(router, feedUseCase, mockLogger)
+
+	return router
+}
+
+func testFeed() *dto.FeedDTO {
+	updated := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+	return &dto.FeedDTO{
+		Title:       "News",
+		Description: "The latest news",
+		Link:        "https://example.com/",
+		URL:         "https://example.com/feeds/news",
+		Updated:     updated,
+		Items: []dto.FeedItemDTO{
+			{
+				ID:        "urn:uuid:" + testSiteNewsID,
+				Title:     "Launch <day> & more",
+				Link:      "https://example.com/news/" + testSiteNewsID,
+				Summary:   "A summary",
+				Content:   "<p>Full</p>",
+				Published: updated,
+				Updated:   updated,
+			},
+		},
+	}
+}
+
+func TestFeedRoutes_News(t *testing.T) {
+	t.Run("success - RSS feed", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockFeedUseCase.On("News", mock.Anything, "").Return(testFeed(), nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/news.rss", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/rss+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.NotEmpty(t, w.Header().Get("ETag"))
+		assert.Equal(t, "Fri, 01 Mar 2024 12:00:00 GMT", w.Header().Get("Last-Modified"))
+		assert.Contains(t, w.Body.String(), "<title>Launch &lt;day&gt; &amp; more</title>")
+		assert.Contains(t, w.Body.String(), `href="https://example.com/feeds/news.rss"`)
+		assert.Contains(t, w.Body.String(), "<content:encoded>&lt;p&gt;Full&lt;/p&gt;</content:encoded>")
+		assert.NoError(t, xml.Unmarshal(w.Body.Bytes(), new(struct{})))
+		mockFeedUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - Atom feed", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockFeedUseCase.On("News", mock.Anything, "").Return(testFeed(), nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/news.atom", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/atom+xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `<feed xmlns="http://www.w3.org/2005/Atom">`)
+		assert.Contains(t, w.Body.String(), "<updated>2024-03-01T12:00:00Z</updated>")
+		assert.Contains(t, w.Body.String(), `href="https://example.com/feeds/news.atom"`)
+		mockFeedUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - not modified for a matching ETag", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockFeedUseCase.On("News", mock.Anything, "").Return(testFeed(), nil)
+
+		first := httptest.NewRecorder()
+		router.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/feeds/news.rss", nil))
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/news.rss", nil)
+		req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotModified, w.Code)
+		assert.Empty(t, w.Body.String())
+	})
+
+	t.Run("success - not modified since the last update", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockFeedUseCase.On("News", mock.Anything, "").Return(testFeed(), nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/news.atom", nil)
+		req.Header.Set("If-Modified-Since", "Fri, 01 Mar 2024 12:00:00 GMT")
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("error - internal server error", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		mockLogger := new(MockLogger)
+		router := setupFeedRouter(mockFeedUseCase, mockLogger)
+
+		// Mock expectations
+		mockFeedUseCase.On("News", mock.Anything, "").Return(nil, apperror.ErrDatabaseConnection)
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/news.rss", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestFeedRoutes_Category(t *testing.T) {
+	t.Run("success - category Atom feed", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		result := testFeed()
+		result.URL = "https://example.com/feeds/categories/" + testFeedCategoryID
+
+		// Mock expectations
+		mockFeedUseCase.On("News", mock.Anything, testFeedCategoryID).Return(result, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/categories/"+testFeedCategoryID+".atom", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(),
+			`href="https://example.com/feeds/categories/`+testFeedCategoryID+`.atom"`)
+		mockFeedUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - unknown format", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/categories/"+testFeedCategoryID+".json", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockFeedUseCase.AssertNotCalled(t, "News")
+	})
+
+	t.Run("error - category not found", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockFeedUseCase.On("News", mock.Anything, testFeedCategoryID).Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/categories/"+testFeedCategoryID+".rss", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockFeedUseCase.AssertExpectations(t)
+	})
+}
+
+func TestFeedRoutes_Tag(t *testing.T) {
+	t.Run("success - tag RSS feed", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		result := testFeed()
+		result.URL = "https://example.com/feeds/tags/machine%20learning"
+
+		// Mock expectations
+		mockFeedUseCase.On("Tag", mock.Anything, "machine learning").Return(result, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/tags/machine%20learning.rss", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "https://example.com/feeds/tags/machine%20learning.rss")
+		mockFeedUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - unknown format", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/tags/technology.json", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockFeedUseCase.AssertNotCalled(t, "Tag")
+	})
+
+	t.Run("error - missing tag", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		router := setupFeedRouter(mockFeedUseCase, new(MockLogger))
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/tags/.atom", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockFeedUseCase.AssertNotCalled(t, "Tag")
+	})
+
+	t.Run("error - internal server error", func(t *testing.T) {
+		// Arrange
+		mockFeedUseCase := new(MockFeedUseCase)
+		mockLogger := new(MockLogger)
+		router := setupFeedRouter(mockFeedUseCase, mockLogger)
+
+		// Mock expectations
+		mockFeedUseCase.On("Tag", mock.Anything, "technology").Return(nil, apperror.ErrDatabaseConnection)
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/feeds/tags/technology.atom", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockFeedUseCase.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,351 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/request"
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// _multipartOverhead is allowed on top of the maximum media size for the
+// multipart boundaries, headers and other form fields of an upload.
+const _multipartOverhead = 64 << 10
+
+// _mediaCacheControl lets clients and proxies keep served files for good:
+// a file's key changes with its content.
+const _mediaCacheControl = "public, max-age=31536000, immutable"
+
+// _defaultFocalPoint centers crops.
+const _defaultFocalPoint = 0.5
+
+type mediaRoutes struct {
+	media   usecase.Media
+	log     logger.Interface
+	maxSize int64
+}
+
+func newMediaRoutes(handler *gin.RouterGroup, media usecase.Media, log logger.Interface, maxSize int64, authMiddleware gin.HandlerFunc) {
+	mediaRouter := mediaRoutes{media, log, maxSize}
+
+	// Managing the media library is restricted to authenticated users
+	h := handler.Group("media", authMiddleware)
+	{
+		h.GET("", mediaRouter.GetAll)
+		h.GET("/:id", mediaRouter.GetByID)
+		h.POST("", mediaRouter.Upload)
+		h.PUT("/:id", mediaRouter.Update)
+		h.DELETE("/:id", mediaRouter.Delete)
+	}
+}
+
+// newMediaServer registers the public routes serving stored files and the
+// sizes derived from images, e.g. /media/9f86…0a08.png and
+// /media/9f86…0a08.png/thumbnail.
+func newMediaServer(handler *gin.Engine, media usecase.Media, log logger.Interface) {
+	mediaRouter := mediaRoutes{media: media, log: log}
+
+	handler.GET("/media/:key", mediaRouter.Serve)
+	handler.HEAD("/media/:key", mediaRouter.Serve)
+	handler.GET("/media/:key/:size", mediaRouter.Serve)
+	handler.HEAD("/media/:key/:size", mediaRouter.Serve)
+}
+
+// @Summary Get all media
+// @Description Retrieve every file in the media library, newest first (requires authentication)
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response "List of media"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /media [get]
+func (mr *mediaRoutes) GetAll(ctx *gin.Context) {
+	media, err := mr.media.GetAll(ctx)
+	if err != nil {
+		mr.log.Error(err, "MediaController - GetAll - mr.media.GetAll")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"media": media,
+	})
+}
+
+// @Summary Get media by ID
+// @Description Retrieve a media file's metadata and the news and custom pages embedding it (requires authentication)
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Media ID"
+// @Success 200 {object} response.Response "Media detail"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Media not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /media/{id} [get]
+func (mr *mediaRoutes) GetByID(ctx *gin.Context) {
+	media, err := mr.media.GetByID(ctx, ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeMediaNotFound)
+
+			return
+		}
+
+		mr.log.Error(err, "MediaController - GetByID - mr.media.GetByID")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"media": media,
+	})
+}
+
+// @Summary Upload media
+// @Description Upload a file to the media library (requires authentication). The file type is detected from its
+// @Description content and must be one of the allowed types. Uploading a file already in the library returns the
+// @Description existing media with status 200.
+// @Tags Media
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "File to upload"
+// @Param alt_text formData string false "Alternative text"
+// @Success 201 {object} response.Response "Media uploaded successfully"
+// @Success 200 {object} response.Response "Media already in the library"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 413 {object} response.ErrorResponse "File too large"
+// @Failure 415 {object} response.ErrorResponse "Unsupported media type"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /media [post]
+func (mr *mediaRoutes) Upload(ctx *gin.Context) {
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, mr.maxSize+_multipartOverhead)
+
+	var req request.UploadMedia
+
+	// Bind form fields
+	if err := ctx.ShouldBind(&req); err != nil {
+		mr.log.Error(err, "MediaController - Upload - ctx.ShouldBind")
+
+		if !sendUploadError(ctx, err) {
+			sendBindError(ctx, &req, err)
+		}
+
+		return
+	}
+
+	data, filename, err := readUpload(ctx, mr.maxSize)
+	if err != nil {
+		mr.log.Error(err, "MediaController - Upload - readUpload")
+
+		if !sendUploadError(ctx, err) {
+			response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidPayload, []response.FieldError{
+				{Field: "file", Code: response.FieldRequired},
+			})
+		}
+
+		return
+	}
+
+	// The route requires authentication
+	uploaderID := ctx.GetString("user_id")
+
+	media, created, err := mr.media.Upload(ctx, uploaderID, &dto.UploadMediaRequestDTO{
+		Filename: filename,
+		AltText:  req.AltText,
+		Data:     data,
+	})
+	if err != nil {
+		if sendUploadError(ctx, err) {
+			return
+		}
+
+		mr.log.Error(err, "MediaController - Upload - mr.media.Upload")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	response.SendSuccess(ctx, status, gin.H{
+		"media": media,
+	})
+}
+
+// @Summary Update media
+// @Description Update the alternative text and focal point of a media file (requires authentication). Cropped
+// @Description sizes are derived around the focal point.
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Media ID"
+// @Param request body request.UpdateMedia true "Media metadata"
+// @Success 200 {object} response.Response "Media updated successfully"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Media not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /media/{id} [put]
+func (mr *mediaRoutes) Update(ctx *gin.Context) {
+	var req request.UpdateMedia
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		mr.log.Error(err, "MediaController - Update - ctx.ShouldBindJSON")
+		sendBindError(ctx, &req, err)
+
+		return
+	}
+
+	err := mr.media.Update(ctx, ctx.Param("id"), &dto.UpdateMediaRequestDTO{
+		AltText: req.AltText,
+		FocalX:  focalPoint(req.FocalX),
+		FocalY:  focalPoint(req.FocalY),
+	})
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeMediaNotFound)
+
+			return
+		}
+
+		mr.log.Error(err, "MediaController - Update - mr.media.Update")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Media updated successfully",
+	})
+}
+
+// @Summary Delete media
+// @Description Delete a media file from the library and from storage (requires authentication). Files embedded in
+// @Description news or custom pages cannot be deleted.
+// @Tags Media
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Media ID"
+// @Success 200 {object} response.Response "Media deleted successfully"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Media not found"
+// @Failure 409 {object} response.ErrorResponse "Media is referenced by content"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /media/{id} [delete]
+func (mr *mediaRoutes) Delete(ctx *gin.Context) {
+	err := mr.media.Delete(ctx, ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeMediaNotFound)
+
+			return
+		}
+
+		if errors.Is(err, apperror.ErrMediaInUse) {
+			response.SendError(ctx, http.StatusConflict, response.CodeMediaInUse)
+
+			return
+		}
+
+		mr.log.Error(err, "MediaController - Delete - mr.media.Delete")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Media deleted successfully",
+	})
+}
+
+// Serve streams a stored file with the type it was detected as on upload,
+// or one of the sizes derived from it.
+func (mr *mediaRoutes) Serve(ctx *gin.Context) {
+	file, err := mr.media.Open(ctx, ctx.Param("key"), ctx.Param("size"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			ctx.Status(http.StatusNotFound)
+
+			return
+		}
+
+		mr.log.Error(err, "MediaController - Serve - mr.media.Open")
+		ctx.Status(http.StatusInternalServerError)
+
+		return
+	}
+	defer file.Content.Close()
+
+	ctx.DataFromReader(http.StatusOK, file.Size, file.MIMEType, file.Content, map[string]string{
+		"Cache-Control":          _mediaCacheControl,
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// focalPoint defaults an omitted focal point coordinate to the center.
+func focalPoint(value *float64) float64 {
+	if value == nil {
+		return _defaultFocalPoint
+	}
+
+	return *value
+}
+
+// readUpload reads the file sent in the file form field, at most one byte
+// more than maxSize so larger files are still recognized as too large.
+func readUpload(ctx *gin.Context, maxSize int64) (data []byte, filename string, err error) {
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		return nil, "", err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+
+	data, err = io.ReadAll(io.LimitReader(file, maxSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return data, header.Filename, nil
+}
+
+// sendUploadError responds to an upload that is too large or of a type not
+// allowed and reports whether err was one of those.
+func sendUploadError(ctx *gin.Context, err error) bool {
+	var maxBytesErr *http.MaxBytesError
+
+	switch {
+	case errors.As(err, &maxBytesErr), errors.Is(err, apperror.ErrMediaTooLarge):
+		response.SendError(ctx, http.StatusRequestEntityTooLarge, response.CodeFileTooLarge)
+	case errors.Is(err, apperror.ErrUnsupportedMedia):
+		response.SendError(ctx, http.StatusUnsupportedMediaType, response.CodeUnsupportedMediaType)
+	default:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,526 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMediaID         = "550e8400-e29b-41d4-a716-446655440030"
+	testMediaUploaderID = "550e8400-e29b-41d4-a716-446655440031"
+	testMediaKey        = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.png"
+	testMediaMaxSize    = 1024
+)
+
+// MockMediaUseCase is a mock implementation of usecase.Media.
+type MockMediaUseCase struct {
+	mock.Mock
+}
+
+func (m *MockMediaUseCase) Upload(ctx context.Context, uploaderID string, req *dto.UploadMediaRequestDTO) (*dto.MediaResponseDTO, bool, error) {
+	args := m.Called(ctx, uploaderID, req)
+	if args.Get(0) == nil {
+		return nil, false, args.Error(2)
+	}
+
+	result, ok := args.Get(0).(*dto.MediaResponseDTO)
+	if !ok {
+		return nil, false, args.Error(2)
+	}
+
+	return result, args.Bool(1), args.Error(2)
+}
+
+func (m *MockMediaUseCase) GetByID(ctx context.Context, id string) (*dto.MediaResponseDTO, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.MediaResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMediaUseCase) GetAll(ctx context.Context) ([]dto.MediaResponseDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.MediaResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMediaUseCase) Update(ctx context.Context, id string, req *dto.UpdateMediaRequestDTO) error {
+	args := m.Called(ctx, id, req)
+
+	return args.Error(0)
+}
+
+func (m *MockMediaUseCase) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockMediaUseCase) Open(ctx context.Context, key, size string) (*dto.MediaFileDTO, error) {
+	args := m.Called(ctx, key, size)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.MediaFileDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+// uploadRequest builds a multipart upload of data as the file field.
+func uploadRequest(t *testing.T, data []byte, altText string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	writer := multipart.NewWriter(&body)
+
+	if data != nil {
+		part, err := writer.CreateFormFile("file", "photo.png")
+		require.NoError(t, err)
+
+		_, err = part.Write(data)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, writer.WriteField("alt_text", altText))
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/media", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return req
+}
+
+func setupMediaUploadRouter(mediaUseCase *MockMediaUseCase, mockLogger *MockLogger) *gin.Engine {
+	router := setupTestRouter()
+	mediaRouter := &mediaRoutes{
+		media:   mediaUseCase,
+		log:     mockLogger,
+		maxSize: testMediaMaxSize,
+	}
+
+	// Simulate authenticated user middleware setting user_id
+	router.POST("/media", func(c *gin.Context) {
+		c.Set("user_id", testMediaUploaderID)
+		mediaRouter.Upload(c)
+	})
+
+	return router
+}
+
+func TestMediaRoutes_Upload(t *testing.T) {
+	t.Run("success - new file", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+		router := setupMediaUploadRouter(mockMediaUseCase, mockLogger)
+
+		// Mock expectations
+		mockMediaUseCase.On("Upload", mock.Anything, testMediaUploaderID, &dto.UploadMediaRequestDTO{
+			Filename: "photo.png",
+			AltText:  "A photo",
+			Data:     []byte("image"),
+		}).Return(&dto.MediaResponseDTO{ID: testMediaID, URL: "/media/" + testMediaKey}, true, nil)
+
+		// Act
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, uploadRequest(t, []byte("image"), "A photo"))
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+
+		media, ok := data["media"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "/media/"+testMediaKey, media["url"])
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - file already in the library", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+		router := setupMediaUploadRouter(mockMediaUseCase, mockLogger)
+
+		// Mock expectations
+		mockMediaUseCase.On("Upload", mock.Anything, testMediaUploaderID, mock.Anything).
+			Return(&dto.MediaResponseDTO{ID: testMediaID}, false, nil)
+
+		// Act
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, uploadRequest(t, []byte("image"), ""))
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - missing file", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+		router := setupMediaUploadRouter(mockMediaUseCase, mockLogger)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, uploadRequest(t, nil, ""))
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"field": "file", "code": "required", "message": "is required"},
+		}, response["errors"])
+
+		mockMediaUseCase.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - request larger than the limit", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+		router := setupMediaUploadRouter(mockMediaUseCase, mockLogger)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, uploadRequest(t, make([]byte, testMediaMaxSize+_multipartOverhead), ""))
+
+		// Assert
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		mockMediaUseCase.AssertNotCalled(t, "Upload", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - file too large", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+		router := setupMediaUploadRouter(mockMediaUseCase, mockLogger)
+
+		// Mock expectations
+		mockMediaUseCase.On("Upload", mock.Anything, testMediaUploaderID, mock.Anything).
+			Return(nil, false, apperror.ErrMediaTooLarge)
+
+		// Act
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, uploadRequest(t, make([]byte, testMediaMaxSize+1), ""))
+
+		// Assert
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - unsupported media type", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+		router := setupMediaUploadRouter(mockMediaUseCase, mockLogger)
+
+		// Mock expectations
+		mockMediaUseCase.On("Upload", mock.Anything, testMediaUploaderID, mock.Anything).
+			Return(nil, false, apperror.ErrUnsupportedMedia)
+
+		// Act
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, uploadRequest(t, []byte("<html></html>"), ""))
+
+		// Assert
+		assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+}
+
+func TestMediaRoutes_Update(t *testing.T) {
+	t.Run("success - omitted focal point defaults to the center", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		mediaRouter := &mediaRoutes{
+			media: mockMediaUseCase,
+			log:   mockLogger,
+		}
+
+		router.PUT("/media/:id", mediaRouter.Update)
+
+		// Mock expectations
+		mockMediaUseCase.On("Update", mock.Anything, testMediaID, &dto.UpdateMediaRequestDTO{
+			AltText: "A photo",
+			FocalX:  0.25,
+			FocalY:  0.5,
+		}).Return(nil)
+
+		// Act
+		body, err := json.Marshal(map[string]any{"alt_text": "A photo", "focal_x": 0.25})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/media/"+testMediaID, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - focal point outside the image", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		mediaRouter := &mediaRoutes{
+			media: mockMediaUseCase,
+			log:   mockLogger,
+		}
+
+		router.PUT("/media/:id", mediaRouter.Update)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		body, err := json.Marshal(map[string]any{"focal_x": 0.5, "focal_y": 1.5})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/media/"+testMediaID, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockMediaUseCase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - alt text too long", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		mediaRouter := &mediaRoutes{
+			media: mockMediaUseCase,
+			log:   mockLogger,
+		}
+
+		router.PUT("/media/:id", mediaRouter.Update)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		body, err := json.Marshal(map[string]string{"alt_text": strings.Repeat("a", 501)})
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPut, "/media/"+testMediaID, bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockMediaUseCase.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMediaRoutes_Delete(t *testing.T) {
+	t.Run("error - media in use", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		mediaRouter := &mediaRoutes{
+			media: mockMediaUseCase,
+			log:   mockLogger,
+		}
+
+		router.DELETE("/media/:id", mediaRouter.Delete)
+
+		// Mock expectations
+		mockMediaUseCase.On("Delete", mock.Anything, testMediaID).Return(apperror.ErrMediaInUse)
+
+		// Act
+		req := httptest.NewRequest(http.MethodDelete, "/media/"+testMediaID, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+}
+
+func TestMediaRoutes_Serve(t *testing.T) {
+	t.Run("success - streams the file", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		mediaRouter := &mediaRoutes{
+			media: mockMediaUseCase,
+			log:   mockLogger,
+		}
+
+		router.GET("/media/:key", mediaRouter.Serve)
+
+		// Mock expectations
+		mockMediaUseCase.On("Open", mock.Anything, testMediaKey, "").Return(&dto.MediaFileDTO{
+			MIMEType: "image/png",
+			Size:     5,
+			Content:  io.NopCloser(strings.NewReader("image")),
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/media/"+testMediaKey, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "image", w.Body.String())
+		assert.Equal(t, "image/png", w.Header().Get("Content-Type"))
+		assert.Equal(t, _mediaCacheControl, w.Header().Get("Cache-Control"))
+		assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - streams a derived size", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		mediaRouter := &mediaRoutes{
+			media: mockMediaUseCase,
+			log:   mockLogger,
+		}
+
+		router.GET("/media/:key/:size", mediaRouter.Serve)
+
+		// Mock expectations
+		mockMediaUseCase.On("Open", mock.Anything, testMediaKey, "thumbnail").Return(&dto.MediaFileDTO{
+			MIMEType: "image/png",
+			Size:     -1,
+			Content:  io.NopCloser(strings.NewReader("thumb")),
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/media/"+testMediaKey+"/thumbnail", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "thumb", w.Body.String())
+		assert.Empty(t, w.Header().Get("Content-Length"))
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - unknown key", func(t *testing.T) {
+		// Arrange
+		mockMediaUseCase := new(MockMediaUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		mediaRouter := &mediaRoutes{
+			media: mockMediaUseCase,
+			log:   mockLogger,
+		}
+
+		router.GET("/media/:key", mediaRouter.Serve)
+
+		// Mock expectations
+		mockMediaUseCase.On("Open", mock.Anything, "missing.png", "").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/media/missing.png", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockMediaUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,202 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/request"
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type menuRoutes struct {
+	menu usecase.Menu
+	log  logger.Interface
+}
+
+func newMenuRoutes(handler *gin.RouterGroup, menu usecase.Menu, log logger.Interface, authMiddleware gin.HandlerFunc) {
+	menuRouter := menuRoutes{menu, log}
+
+	h := handler.Group("menus")
+	{
+		// Public endpoint - frontends render menus by name
+		h.GET("/:name", menuRouter.GetByName)
+
+		// Protected endpoints - only authenticated users
+		h.GET("", authMiddleware, menuRouter.GetAll)
+		h.PUT("/:name", authMiddleware, menuRouter.Save)
+		h.DELETE("/:name", authMiddleware, menuRouter.Delete)
+	}
+}
+
+// @Summary Get all menus
+// @Description Retrieve the names of all menus, without their items (requires authentication)
+// @Tags Menus
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response "List of menus"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /menus [get]
+func (mr *menuRoutes) GetAll(ctx *gin.Context) {
+	menus, err := mr.menu.GetAll(ctx)
+	if err != nil {
+		mr.log.Error(err, "MenuController - GetAll - mr.menu.GetAll")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"menus": menus,
+	})
+}
+
+// @Summary Get menu by name
+// @Description Retrieve a menu with its nested items resolved into titles and hrefs.
+// @Description Items whose page, category or news article was deleted are returned with broken set and no href.
+// @Tags Menus
+// @Accept json
+// @Produce json
+// @Param name path string true "Menu name" example(main)
+// @Success 200 {object} response.Response "Menu detail"
+// @Failure 404 {object} response.ErrorResponse "Menu not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /menus/{name} [get]
+func (mr *menuRoutes) GetByName(ctx *gin.Context) {
+	menu, err := mr.menu.GetByName(ctx, ctx.Param("name"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeMenuNotFound)
+
+			return
+		}
+
+		mr.log.Error(err, "MenuController - GetByName - mr.menu.GetByName")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"menu": menu,
+	})
+}
+
+// @Summary Create or replace a menu
+// @Description Create the named menu, or replace all of its items, with the submitted item tree (requires authentication).
+// @Description Names are lowercase letters, digits, hyphens and underscores. Items nest up to 3 levels.
+// @Tags Menus
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Menu name" example(main)
+// @Param request body request.Menu true "Menu items"
+// @Success 200 {object} response.Response "Menu saved successfully"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /menus/{name} [put]
+func (mr *menuRoutes) Save(ctx *gin.Context) {
+	var req request.Menu
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		mr.log.Error(err, "MenuController - Save - ctx.ShouldBindJSON")
+		sendBindError(ctx, &req, err)
+
+		return
+	}
+
+	menu, err := mr.menu.Save(ctx, ctx.Param("name"), &dto.SaveMenuRequestDTO{
+		Items: menuItemsRequest(req.Items),
+	})
+	if err != nil {
+		if sendMenuError(ctx, err) {
+			return
+		}
+
+		mr.log.Error(err, "MenuController - Save - mr.menu.Save")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"menu": menu,
+	})
+}
+
+// @Summary Delete a menu
+// @Description Delete a menu and all of its items by name (requires authentication)
+// @Tags Menus
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param name path string true "Menu name" example(main)
+// @Success 200 {object} response.Response "Menu deleted successfully"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Menu not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /menus/{name} [delete]
+func (mr *menuRoutes) Delete(ctx *gin.Context) {
+	err := mr.menu.Delete(ctx, ctx.Param("name"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeMenuNotFound)
+
+			return
+		}
+
+		mr.log.Error(err, "MenuController - Delete - mr.menu.Delete")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Menu deleted successfully",
+	})
+}
+
+func menuItemsRequest(items []request.MenuItem) []dto.MenuItemRequestDTO {
+	result := make([]dto.MenuItemRequestDTO, 0, len(items))
+
+	for _, item := range items {
+		result = append(result, dto.MenuItemRequestDTO{
+			Type:     item.Type,
+			TargetID: item.TargetID,
+			URL:      item.URL,
+			Title:    item.Title,
+			Children: menuItemsRequest(item.Children),
+		})
+	}
+
+	return result
+}
+
+// sendMenuError responds to errors caused by the submitted menu and reports
+// whether err was one of them.
+func sendMenuError(ctx *gin.Context, err error) bool {
+	var fieldErr response.FieldError
+
+	switch {
+	case errors.Is(err, apperror.ErrInvalidMenuName):
+		fieldErr = response.FieldError{Field: "name", Code: response.FieldMenuName}
+	case errors.Is(err, apperror.ErrInvalidMenuItem):
+		fieldErr = response.FieldError{Field: "items", Code: response.FieldMenuItem}
+	case errors.Is(err, apperror.ErrMenuTooDeep):
+		fieldErr = response.FieldError{Field: "items", Code: response.FieldMenuDepth}
+	default:
+		return false
+	}
+
+	response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidMenu, []response.FieldError{fieldErr})
+
+	return true
+}
@@ -0,0 +1,282 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testMenuID       = "550e8400-e29b-41d4-a716-446655440030"
+	testMenuTargetID = "550e8400-e29b-41d4-a716-446655440031"
+)
+
+// MockMenuUseCase is a mock implementation of usecase.Menu.
+type MockMenuUseCase struct {
+	mock.Mock
+}
+
+func (m *MockMenuUseCase) GetAll(ctx context.Context) ([]dto.MenuSummaryDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.MenuSummaryDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMenuUseCase) GetByName(ctx context.Context, name string) (*dto.MenuResponseDTO, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.MenuResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMenuUseCase) Save(ctx context.Context, name string, req *dto.SaveMenuRequestDTO) (*dto.MenuResponseDTO, error) {
+	args := m.Called(ctx, name, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.MenuResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMenuUseCase) Delete(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+
+	return args.Error(0)
+}
+
+func TestMenuRoutes_GetByName(t *testing.T) {
+	t.Run("success - resolved menu", func(t *testing.T) {
+		// Arrange
+		mockMenuUseCase := new(MockMenuUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		menuRouter := &menuRoutes{
+			menu: mockMenuUseCase,
+			log:  mockLogger,
+		}
+
+		router.GET("/menus/:name", menuRouter.GetByName)
+
+		// Mock expectations
+		mockMenuUseCase.On("GetByName", mock.Anything, "main").Return(&dto.MenuResponseDTO{
+			ID:   testMenuID,
+			Name: "main",
+			Items: []dto.MenuItemResponseDTO{
+				{Type: "news", TargetID: testMenuTargetID, Title: "Launch", Broken: true, Children: []dto.MenuItemResponseDTO{}},
+			},
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/menus/main", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"broken":true`)
+
+		mockMenuUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - menu not found", func(t *testing.T) {
+		// Arrange
+		mockMenuUseCase := new(MockMenuUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		menuRouter := &menuRoutes{
+			menu: mockMenuUseCase,
+			log:  mockLogger,
+		}
+
+		router.GET("/menus/:name", menuRouter.GetByName)
+
+		// Mock expectations
+		mockMenuUseCase.On("GetByName", mock.Anything, "missing").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/menus/missing", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockMenuUseCase.AssertExpectations(t)
+	})
+}
+
+func TestMenuRoutes_Save(t *testing.T) {
+	t.Run("success - save nested items", func(t *testing.T) {
+		// Arrange
+		mockMenuUseCase := new(MockMenuUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		menuRouter := &menuRoutes{
+			menu: mockMenuUseCase,
+			log:  mockLogger,
+		}
+
+		router.PUT("/menus/:name", menuRouter.Save)
+
+		// Mock expectations
+		mockMenuUseCase.On("Save", mock.Anything, "main", &dto.SaveMenuRequestDTO{
+			Items: []dto.MenuItemRequestDTO{
+				{
+					Type:     "page",
+					TargetID: testMenuTargetID,
+					Children: []dto.MenuItemRequestDTO{
+						{Type: "url", URL: "https://example.com", Title: "Example", Children: []dto.MenuItemRequestDTO{}},
+					},
+				},
+			},
+		}).Return(&dto.MenuResponseDTO{ID: testMenuID, Name: "main"}, nil)
+
+		// Act
+		body := `{"items": [{"type": "page", "target_id": "` + testMenuTargetID + `", ` +
+			`"children": [{"type": "url", "url": "https://example.com", "title": "Example"}]}]}`
+		req := httptest.NewRequest(http.MethodPut, "/menus/main", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockMenuUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - nested item fields reported by path", func(t *testing.T) {
+		// Arrange
+		mockMenuUseCase := new(MockMenuUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		menuRouter := &menuRoutes{
+			menu: mockMenuUseCase,
+			log:  mockLogger,
+		}
+
+		router.PUT("/menus/:name", menuRouter.Save)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		body := `{"items": [{"type": "url", "url": "/a", "title": "A", "children": [{"type": "category"}]}]}`
+		req := httptest.NewRequest(http.MethodPut, "/menus/main", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"field": "items[0].children[0].target_id", "code": "required_for_type", "message": "is required for this type"},
+		}, response["errors"])
+
+		mockMenuUseCase.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - invalid menu name", func(t *testing.T) {
+		// Arrange
+		mockMenuUseCase := new(MockMenuUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		menuRouter := &menuRoutes{
+			menu: mockMenuUseCase,
+			log:  mockLogger,
+		}
+
+		router.PUT("/menus/:name", menuRouter.Save)
+
+		// Mock expectations
+		mockMenuUseCase.On("Save", mock.Anything, "Main", mock.Anything).Return(nil, apperror.ErrInvalidMenuName)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPut, "/menus/Main", bytes.NewBufferString(`{"items": []}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), `"field":"name"`)
+
+		mockMenuUseCase.AssertExpectations(t)
+	})
+}
+
+func TestMenuRoutes_Delete(t *testing.T) {
+	t.Run("success - delete menu", func(t *testing.T) {
+		// Arrange
+		mockMenuUseCase := new(MockMenuUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		menuRouter := &menuRoutes{
+			menu: mockMenuUseCase,
+			log:  mockLogger,
+		}
+
+		router.DELETE("/menus/:name", menuRouter.Delete)
+
+		// Mock expectations
+		mockMenuUseCase.On("Delete", mock.Anything, "main").Return(nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodDelete, "/menus/main", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockMenuUseCase.AssertExpectations(t)
+	})
+}
@@ -19,52 +19,83 @@ const (
 func AuthMiddleware(jwtManager jwt.Manager) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		// Get Authorization header
-		authHeader := ctx.GetHeader(authorizationHeader)
-		if authHeader == "" {
-			response.SendError(ctx, http.StatusUnauthorized, "Authorization header is required")
+		if ctx.GetHeader(authorizationHeader) == "" {
+			response.SendError(ctx, http.StatusUnauthorized, response.CodeAuthorizationRequired)
 			ctx.Abort()
 
 			return
 		}
 
-		// Check Bearer prefix
-		if !strings.HasPrefix(authHeader, bearerPrefix) {
-			response.SendError(ctx, http.StatusUnauthorized, "Invalid authorization header format")
-			ctx.Abort()
-
+		if !authenticate(ctx, jwtManager) {
 			return
 		}
 
-		// Extract token
-		token := strings.TrimPrefix(authHeader, bearerPrefix)
-		if token == "" {
-			response.SendError(ctx, http.StatusUnauthorized, "Token is required")
-			ctx.Abort()
+		ctx.Next()
+	}
+}
+
+// OptionalAuthMiddleware creates a middleware for endpoints open to anonymous
+// visitors. Requests without an Authorization header pass through
+// unauthenticated, while a header that is present must hold a valid token.
+func OptionalAuthMiddleware(jwtManager jwt.Manager) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if ctx.GetHeader(authorizationHeader) == "" {
+			ctx.Next()
 
 			return
 		}
 
-		// Validate token
-		claims, err := jwtManager.ParseAndValidateAccessToken(token)
-		if err != nil {
-			response.SendError(ctx, http.StatusUnauthorized, "Invalid or expired token")
-			ctx.Abort()
-
+		if !authenticate(ctx, jwtManager) {
 			return
 		}
 
-		// Extract user_id from claims
-		userID, ok := claims[userIDKey].(string)
-		if !ok {
-			response.SendError(ctx, http.StatusUnauthorized, "Invalid token claims")
-			ctx.Abort()
+		ctx.Next()
+	}
+}
 
-			return
-		}
+// authenticate validates the bearer token of the request and sets user_id in
+// the context. On failure it sends the error response, aborts the request and
+// reports false.
+func authenticate(ctx *gin.Context, jwtManager jwt.Manager) bool {
+	authHeader := ctx.GetHeader(authorizationHeader)
 
-		// Set user_id in context
-		ctx.Set(userIDKey, userID)
+	// Check Bearer prefix
+	if !strings.HasPrefix(authHeader, bearerPrefix) {
+		response.SendError(ctx, http.StatusUnauthorized, response.CodeInvalidAuthorizationHeader)
+		ctx.Abort()
 
-		ctx.Next()
+		return false
+	}
+
+	// Extract token
+	token := strings.TrimPrefix(authHeader, bearerPrefix)
+	if token == "" {
+		response.SendError(ctx, http.StatusUnauthorized, response.CodeTokenRequired)
+		ctx.Abort()
+
+		return false
+	}
+
+	// Validate token
+	claims, err := jwtManager.ParseAndValidateAccessToken(token)
+	if err != nil {
+		response.SendError(ctx, http.StatusUnauthorized, response.CodeExpiredToken)
+		ctx.Abort()
+
+		return false
 	}
+
+	// Extract user_id from claims
+	userID, ok := claims[userIDKey].(string)
+	if !ok {
+		response.SendError(ctx, http.StatusUnauthorized, response.CodeInvalidTokenClaims)
+		ctx.Abort()
+
+		return false
+	}
+
+	// Set user_id in context
+	ctx.Set(userIDKey, userID)
+
+	return true
 }
@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/RizqiSugiarto/coding-test/pkg/ratelimit"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	rateLimitLimitHeader     = "RateLimit-Limit"
+	rateLimitRemainingHeader = "RateLimit-Remaining"
+	rateLimitResetHeader     = "RateLimit-Reset"
+	retryAfterHeader         = "Retry-After"
+)
+
+// RateLimitKeyFunc returns the key a request is rate limited by.
+type RateLimitKeyFunc func(ctx *gin.Context) string
+
+// ByClientIP keys requests by client IP. Forwarding headers are only honored
+// for the proxies trusted by the engine.
+func ByClientIP(ctx *gin.Context) string {
+	return "ip:" + ctx.ClientIP()
+}
+
+// ByUserID keys requests by the authenticated user, falling back to the
+// client IP for anonymous requests. It must run after AuthMiddleware or
+// OptionalAuthMiddleware.
+func ByUserID(ctx *gin.Context) string {
+	if userID := ctx.GetString(userIDKey); userID != "" {
+		return "user:" + userID
+	}
+
+	return ByClientIP(ctx)
+}
+
+// RateLimitMiddleware creates a middleware that limits requests with the
+// given token bucket policy. A policy without a limit disables it. Requests
+// are let through when the store fails, so an outage of the store does not
+// take the endpoint down.
+func RateLimitMiddleware(
+	store ratelimit.Store,
+	policy ratelimit.Policy,
+	keyFunc RateLimitKeyFunc,
+	log logger.Interface,
+) gin.HandlerFunc {
+	if policy.Limit <= 0 || policy.Period <= 0 {
+		return func(ctx *gin.Context) {
+			ctx.Next()
+		}
+	}
+
+	return func(ctx *gin.Context) {
+		result, err := store.Take(ctx, policy.Name+":"+keyFunc(ctx), policy)
+		if err != nil {
+			log.Error(err, "RateLimitMiddleware - store.Take")
+			ctx.Next()
+
+			return
+		}
+
+		// Set rate limit headers
+		ctx.Header(rateLimitLimitHeader, strconv.Itoa(result.Limit))
+		ctx.Header(rateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+		ctx.Header(rateLimitResetHeader, headerSeconds(result.ResetAfter))
+
+		if !result.Allowed {
+			ctx.Header(retryAfterHeader, headerSeconds(result.RetryAfter))
+			response.SendError(ctx, http.StatusTooManyRequests, response.CodeTooManyRequests)
+			ctx.Abort()
+
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// headerSeconds rounds a duration up to whole seconds.
+func headerSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/ratelimit"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errStoreDown = errors.New("store down")
+
+type MockLogger struct {
+	mock.Mock
+}
+
+func (m *MockLogger) Debug(message interface{}, args ...interface{}) {
+	m.Called(message, args)
+}
+
+func (m *MockLogger) Info(message string, args ...interface{}) {
+	m.Called(message, args)
+}
+
+func (m *MockLogger) Warn(message string, args ...interface{}) {
+	m.Called(message, args)
+}
+
+func (m *MockLogger) Error(message interface{}, args ...interface{}) {
+	m.Called(message, args)
+}
+
+func (m *MockLogger) Fatal(message interface{}, args ...interface{}) {
+	m.Called(message, args)
+}
+
+type MockRateLimitStore struct {
+	mock.Mock
+}
+
+func (m *MockRateLimitStore) Take(ctx context.Context, key string, policy ratelimit.Policy) (ratelimit.Result, error) {
+	args := m.Called(ctx, key, policy)
+
+	result, _ := args.Get(0).(ratelimit.Result)
+
+	return result, args.Error(1)
+}
+
+func setupTestRouter() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+
+	return router
+}
+
+func TestByUserID(t *testing.T) {
+	t.Run("success - authenticated user", func(t *testing.T) {
+		// Arrange
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		ctx.Set(userIDKey, "42")
+
+		// Act
+		key := ByUserID(ctx)
+
+		// Assert
+		assert.Equal(t, "user:42", key)
+	})
+
+	t.Run("success - anonymous falls back to client IP", func(t *testing.T) {
+		// Arrange
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/", http.NoBody)
+		ctx.Request.RemoteAddr = "203.0.113.7:5000"
+
+		// Act
+		key := ByUserID(ctx)
+
+		// Assert
+		assert.Equal(t, "ip:203.0.113.7", key)
+	})
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	policy := ratelimit.Policy{Name: "comment", Limit: 2, Period: time.Minute}
+
+	t.Run("success - limits each user separately", func(t *testing.T) {
+		// Arrange
+		router := setupTestRouter()
+		router.POST("/comments/:user", func(ctx *gin.Context) {
+			ctx.Set(userIDKey, ctx.Param("user"))
+		}, RateLimitMiddleware(ratelimit.NewMemoryStore(), policy, ByUserID, new(MockLogger)), func(ctx *gin.Context) {
+			ctx.Status(http.StatusCreated)
+		})
+
+		post := func(user string) *httptest.ResponseRecorder {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/comments/"+user, http.NoBody))
+
+			return w
+		}
+
+		// Act
+		first, second, third := post("1"), post("1"), post("1")
+		other := post("2")
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, first.Code)
+		assert.Equal(t, "2", first.Header().Get(rateLimitLimitHeader))
+		assert.Equal(t, "1", first.Header().Get(rateLimitRemainingHeader))
+		assert.Equal(t, "30", first.Header().Get(rateLimitResetHeader))
+		assert.Equal(t, http.StatusCreated, second.Code)
+		assert.Equal(t, http.StatusTooManyRequests, third.Code)
+		assert.Equal(t, "0", third.Header().Get(rateLimitRemainingHeader))
+		assert.Equal(t, "30", third.Header().Get(retryAfterHeader))
+		assert.Equal(t, http.StatusCreated, other.Code)
+	})
+
+	t.Run("success - store failure lets the request through", func(t *testing.T) {
+		// Arrange
+		mockStore := new(MockRateLimitStore)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		router.POST("/comments", RateLimitMiddleware(mockStore, policy, ByClientIP, mockLogger), func(ctx *gin.Context) {
+			ctx.Status(http.StatusCreated)
+		})
+
+		// Mock expectations
+		mockStore.On("Take", mock.Anything, "comment:ip:192.0.2.1", policy).Return(ratelimit.Result{}, errStoreDown)
+		mockLogger.On("Error", errStoreDown, mock.Anything).Return()
+
+		// Act
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/comments", http.NoBody))
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Empty(t, w.Header().Get(rateLimitLimitHeader))
+
+		mockStore.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("success - policy without a limit is disabled", func(t *testing.T) {
+		// Arrange
+		mockStore := new(MockRateLimitStore)
+
+		router := setupTestRouter()
+		router.POST("/comments", RateLimitMiddleware(mockStore, ratelimit.Policy{Name: "comment"}, ByClientIP, new(MockLogger)),
+			func(ctx *gin.Context) {
+				ctx.Status(http.StatusCreated)
+			})
+
+		// Act
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/comments", http.NoBody))
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		mockStore.AssertNotCalled(t, "Take", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
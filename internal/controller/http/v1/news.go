@@ -18,7 +18,13 @@ type newsRoutes struct {
 	log  logger.Interface
 }
 
-func newNewsRoutes(handler *gin.RouterGroup, news usecase.News, log logger.Interface, authMiddleware gin.HandlerFunc) {
+func newNewsRoutes(
+	handler *gin.RouterGroup,
+	news usecase.News,
+	log logger.Interface,
+	authMiddleware gin.HandlerFunc,
+	optionalAuthMiddleware gin.HandlerFunc,
+) {
 	newsRouter := newsRoutes{news, log}
 
 	h := handler.Group("news")
@@ -27,6 +33,10 @@ func newNewsRoutes(handler *gin.RouterGroup, news usecase.News, log logger.Inter
 		h.GET("", newsRouter.GetAll)
 		h.GET("/:id", newsRouter.GetByID)
 
+		// Reactions - anonymous readers are told apart by their fingerprint
+		h.POST("/:id/reactions", optionalAuthMiddleware, newsRouter.React)
+		h.DELETE("/:id/reactions/:reaction", optionalAuthMiddleware, newsRouter.Unreact)
+
 		// Protected endpoints - only authenticated users
 		h.POST("", authMiddleware, newsRouter.Create)
 		h.PUT("/:id", authMiddleware, newsRouter.Update)
@@ -35,22 +45,34 @@ func newNewsRoutes(handler *gin.RouterGroup, news usecase.News, log logger.Inter
 }
 
 // @Summary Get all news
-// @Description Retrieve a list of all news articles
+// @Description Retrieve a list of all news articles, one translation of each in the locale the reader prefers
 // @Tags News
 // @Accept json
 // @Produce json
+// @Param render query string false "Content as sanitized HTML, as written in its content_format, or as plain text" Enums(html, source, text) default(html)
+// @Param lang query string false "Comma separated locales to list translations in, best first, overriding Accept-Language; * lists every translation" example(id,en)
 // @Success 200 {object} response.Response "List of news"
+// @Failure 400 {object} response.ErrorResponse "Invalid render"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /news [get]
 func (n *newsRoutes) GetAll(ctx *gin.Context) {
-	newsList, err := n.news.GetAll(ctx)
+	render, ok := contentRender(ctx)
+	if !ok {
+		return
+	}
+
+	newsList, err := n.news.GetAll(ctx, readerLocales(ctx, true))
 	if err != nil {
 		n.log.Error(err, "NewsController - GetAll - n.news.GetAll")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
 
+	for i := range newsList {
+		renderNews(&newsList[i], render)
+	}
+
 	response.SendSuccess(ctx, http.StatusOK, gin.H{
 		"news": newsList,
 	})
@@ -62,42 +84,53 @@ func (n *newsRoutes) GetAll(ctx *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path string true "News ID"
+// @Param render query string false "Content as sanitized HTML, as written in its content_format, or as plain text" Enums(html, source, text) default(html)
+// @Param lang query string false "Comma separated locales, best first, to return the translation ranked best in instead" example(id,en)
 // @Success 200 {object} response.Response "News detail"
+// @Failure 400 {object} response.ErrorResponse "Invalid render"
 // @Failure 404 {object} response.ErrorResponse "News not found"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /news/{id} [get]
 func (n *newsRoutes) GetByID(ctx *gin.Context) {
+	render, ok := contentRender(ctx)
+	if !ok {
+		return
+	}
+
 	id := ctx.Param("id")
 
-	news, err := n.news.GetByID(ctx, id)
+	news, err := n.news.GetByID(ctx, id, readerLocales(ctx, false))
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "News not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodeNewsNotFound)
 
 			return
 		}
 
 		n.log.Error(err, "NewsController - GetByID - n.news.GetByID")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
 
+	renderNews(news, render)
+
 	response.SendSuccess(ctx, http.StatusOK, gin.H{
 		"news": news,
 	})
 }
 
 // @Summary Create a new news article
-// @Description Create a new news article (requires authentication)
+// @Description Create a new news article (requires authentication). HTML in the content is sanitized.
 // @Tags News
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param request body request.News true "News information"
 // @Success 201 {object} response.Response "News created successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload, content blocks, featured media, locale or translation"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 409 {object} response.ValidationErrorResponse "Translation already exists in the locale"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /news [post]
 func (n *newsRoutes) Create(ctx *gin.Context) {
@@ -106,7 +139,7 @@ func (n *newsRoutes) Create(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		n.log.Error(err, "NewsController - Create - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
 
 		return
 	}
@@ -114,31 +147,46 @@ func (n *newsRoutes) Create(ctx *gin.Context) {
 	// Get user ID from context (set by auth middleware)
 	userID, exists := ctx.Get("user_id")
 	if !exists {
-		response.SendError(ctx, http.StatusUnauthorized, "User not authenticated")
+		response.SendError(ctx, http.StatusUnauthorized, response.CodeUnauthenticated)
 
 		return
 	}
 
 	authorID, ok := userID.(string)
 	if !ok {
-		response.SendError(ctx, http.StatusInternalServerError, "Invalid user ID format")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInvalidUserID)
 
 		return
 	}
 
 	// Create news
 	news, err := n.news.Create(ctx, authorID, &dto.CreateNewsRequestDTO{
-		CategoryID: req.CategoryID,
-		Title:      req.Title,
-		Content:    req.Content,
+		CategoryID:    req.CategoryID,
+		Title:         req.Title,
+		Content:       req.Content,
+		ContentFormat: req.ContentFormat,
+
+		CommentsEnabled: req.CommentsEnabled,
+		CommentsCloseAt: req.CommentsCloseAt,
+
+		Locale:        req.Locale,
+		TranslationOf: req.TranslationOf,
+
+		NewsMetadataDTO: newsMetadata(&req.NewsMetadata),
 	})
 	if err != nil {
+		if sendContentError(ctx, err) || sendFeaturedMediaError(ctx, err) || sendTranslationError(ctx, err) {
+			return
+		}
+
 		n.log.Error(err, "NewsController - Create - n.news.Create")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
 
+	renderNews(news, contentRenderHTML)
+
 	// Success response
 	response.SendSuccess(ctx, http.StatusCreated, gin.H{
 		"news": news,
@@ -146,7 +194,7 @@ func (n *newsRoutes) Create(ctx *gin.Context) {
 }
 
 // @Summary Update a news article
-// @Description Update an existing news article (requires authentication)
+// @Description Update an existing news article (requires authentication). HTML in the content is sanitized.
 // @Tags News
 // @Accept json
 // @Produce json
@@ -154,9 +202,10 @@ func (n *newsRoutes) Create(ctx *gin.Context) {
 // @Param id path string true "News ID"
 // @Param request body request.UpdateNews true "Updated news information"
 // @Success 200 {object} response.Response "News updated successfully"
-// @Failure 400 {object} response.ErrorResponse "Invalid request payload"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload, content blocks, featured media or locale"
 // @Failure 401 {object} response.ErrorResponse "Unauthorized"
 // @Failure 404 {object} response.ErrorResponse "News not found"
+// @Failure 409 {object} response.ValidationErrorResponse "Translation already exists in the locale"
 // @Failure 500 {object} response.ErrorResponse "Internal server error"
 // @Router /news/{id} [put]
 func (n *newsRoutes) Update(ctx *gin.Context) {
@@ -167,26 +216,43 @@ func (n *newsRoutes) Update(ctx *gin.Context) {
 	// Bind JSON request
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		n.log.Error(err, "NewsController - Update - ctx.ShouldBindJSON")
-		response.SendError(ctx, http.StatusBadRequest, "Invalid request payload")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
 
 		return
 	}
 
+	// The route requires authentication, and an unknown editor is never trusted
+	// with raw HTML
+	editorID := ctx.GetString("user_id")
+
 	// Update news
-	err := n.news.Update(ctx, id, &dto.UpdateNewsRequestDTO{
-		CategoryID: req.CategoryID,
-		Title:      req.Title,
-		Content:    req.Content,
+	err := n.news.Update(ctx, editorID, id, &dto.UpdateNewsRequestDTO{
+		CategoryID:    req.CategoryID,
+		Title:         req.Title,
+		Content:       req.Content,
+		ContentFormat: req.ContentFormat,
+
+		CommentsEnabled:      req.CommentsEnabled,
+		CommentsCloseAt:      req.CommentsCloseAt.Time,
+		ClearCommentsCloseAt: req.CommentsCloseAt.Set && req.CommentsCloseAt.Time == nil,
+
+		Locale: req.Locale,
+
+		NewsMetadataDTO: newsMetadata(&req.NewsMetadata),
 	})
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "News not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodeNewsNotFound)
+
+			return
+		}
 
+		if sendContentError(ctx, err) || sendFeaturedMediaError(ctx, err) || sendTranslationError(ctx, err) {
 			return
 		}
 
 		n.log.Error(err, "NewsController - Update - n.news.Update")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -216,13 +282,13 @@ func (n *newsRoutes) Delete(ctx *gin.Context) {
 	err := n.news.Delete(ctx, id)
 	if err != nil {
 		if errors.Is(err, apperror.ErrNotFound) {
-			response.SendError(ctx, http.StatusNotFound, "News not found")
+			response.SendError(ctx, http.StatusNotFound, response.CodeNewsNotFound)
 
 			return
 		}
 
 		n.log.Error(err, "NewsController - Delete - n.news.Delete")
-		response.SendError(ctx, http.StatusInternalServerError, "Internal server error")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
 
 		return
 	}
@@ -232,3 +298,105 @@ func (n *newsRoutes) Delete(ctx *gin.Context) {
 		"message": "News deleted successfully",
 	})
 }
+
+// @Summary React to a news article
+// @Description Leave a reaction on a news article. Each reader, identified by user or visitor fingerprint, counts
+// @Description once per reaction. Returns the updated count of every configured reaction.
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path string true "News ID"
+// @Param request body request.Reaction true "Reaction"
+// @Success 200 {object} response.Response "Reaction counts"
+// @Failure 400 {object} response.ErrorResponse "Invalid request payload or reaction"
+// @Failure 404 {object} response.ErrorResponse "News not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /news/{id}/reactions [post]
+func (n *newsRoutes) React(ctx *gin.Context) {
+	var req request.Reaction
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		n.log.Error(err, "NewsController - React - ctx.ShouldBindJSON")
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	reactions, err := n.news.React(ctx, ctx.Param("id"), voterID(ctx), req.Reaction)
+	if err != nil {
+		n.sendReactionError(ctx, err, "NewsController - React - n.news.React")
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"reactions": reactions,
+	})
+}
+
+// @Summary Withdraw a reaction from a news article
+// @Description Remove the reader's reaction from a news article and return the updated reaction counts
+// @Tags News
+// @Accept json
+// @Produce json
+// @Param id path string true "News ID"
+// @Param reaction path string true "Reaction"
+// @Success 200 {object} response.Response "Reaction counts"
+// @Failure 400 {object} response.ErrorResponse "Invalid reaction"
+// @Failure 404 {object} response.ErrorResponse "News not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /news/{id}/reactions/{reaction} [delete]
+func (n *newsRoutes) Unreact(ctx *gin.Context) {
+	reactions, err := n.news.Unreact(ctx, ctx.Param("id"), voterID(ctx), ctx.Param("reaction"))
+	if err != nil {
+		n.sendReactionError(ctx, err, "NewsController - Unreact - n.news.Unreact")
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"reactions": reactions,
+	})
+}
+
+func (n *newsRoutes) sendReactionError(ctx *gin.Context, err error, msg string) {
+	switch {
+	case errors.Is(err, apperror.ErrInvalidReaction):
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidReaction)
+	case errors.Is(err, apperror.ErrNotFound):
+		response.SendError(ctx, http.StatusNotFound, response.CodeNewsNotFound)
+	default:
+		n.log.Error(err, msg)
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+	}
+}
+
+func newsMetadata(req *request.NewsMetadata) dto.NewsMetadataDTO {
+	return dto.NewsMetadataDTO{
+		FeaturedMediaID: req.FeaturedMediaID,
+		Excerpt:         req.Excerpt,
+		MetaTitle:       req.MetaTitle,
+		MetaDescription: req.MetaDescription,
+		CanonicalURL:    req.CanonicalURL,
+		OGTitle:         req.OGTitle,
+		OGDescription:   req.OGDescription,
+		OGImage:         req.OGImage,
+		NoIndex:         req.NoIndex,
+		Tags:            req.Tags,
+	}
+}
+
+// sendFeaturedMediaError responds to a featured image that is not an image
+// in the media library and reports whether err was one.
+func sendFeaturedMediaError(ctx *gin.Context, err error) bool {
+	if !errors.Is(err, apperror.ErrInvalidFeaturedMedia) {
+		return false
+	}
+
+	response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidPayload, []response.FieldError{
+		{Field: "featured_media_id", Code: response.FieldImageMedia},
+	})
+
+	return true
+}
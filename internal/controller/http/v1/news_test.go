@@ -11,6 +11,7 @@ import (
 
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
 	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/blocks"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -41,8 +42,8 @@ func (m *MockNewsUseCase) Create(ctx context.Context, authorID string, req *dto.
 	return result, args.Error(1)
 }
 
-func (m *MockNewsUseCase) GetByID(ctx context.Context, id string) (*dto.NewsResponseDTO, error) {
-	args := m.Called(ctx, id)
+func (m *MockNewsUseCase) GetByID(ctx context.Context, id string, locales []string) (*dto.NewsResponseDTO, error) {
+	args := m.Called(ctx, id, locales)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -55,8 +56,8 @@ func (m *MockNewsUseCase) GetByID(ctx context.Context, id string) (*dto.NewsResp
 	return result, args.Error(1)
 }
 
-func (m *MockNewsUseCase) GetAll(ctx context.Context) ([]dto.NewsResponseDTO, error) {
-	args := m.Called(ctx)
+func (m *MockNewsUseCase) GetAll(ctx context.Context, locales []string) ([]dto.NewsResponseDTO, error) {
+	args := m.Called(ctx, locales)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -69,8 +70,22 @@ func (m *MockNewsUseCase) GetAll(ctx context.Context) ([]dto.NewsResponseDTO, er
 	return result, args.Error(1)
 }
 
-func (m *MockNewsUseCase) Update(ctx context.Context, id string, req *dto.UpdateNewsRequestDTO) error {
-	args := m.Called(ctx, id, req)
+func (m *MockNewsUseCase) GetByCategory(ctx context.Context, categoryID string, locales []string) ([]dto.NewsResponseDTO, error) {
+	args := m.Called(ctx, categoryID, locales)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.NewsResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockNewsUseCase) Update(ctx context.Context, editorID, id string, req *dto.UpdateNewsRequestDTO) error {
+	args := m.Called(ctx, editorID, id, req)
 
 	return args.Error(0)
 }
@@ -81,6 +96,34 @@ func (m *MockNewsUseCase) Delete(ctx context.Context, id string) error {
 	return args.Error(0)
 }
 
+func (m *MockNewsUseCase) React(ctx context.Context, newsID, voter, reaction string) (map[string]int, error) {
+	args := m.Called(ctx, newsID, voter, reaction)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(map[string]int)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockNewsUseCase) Unreact(ctx context.Context, newsID, voter, reaction string) (map[string]int, error) {
+	args := m.Called(ctx, newsID, voter, reaction)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(map[string]int)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
 func TestNewsRoutes_GetAll(t *testing.T) {
 	t.Run("success - get all news", func(t *testing.T) {
 		// Arrange
@@ -109,7 +152,7 @@ func TestNewsRoutes_GetAll(t *testing.T) {
 		}
 
 		// Mock expectations
-		mockNewsUseCase.On("GetAll", mock.Anything).Return(expectedNews, nil)
+		mockNewsUseCase.On("GetAll", mock.Anything, mock.Anything).Return(expectedNews, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/news", http.NoBody)
@@ -144,7 +187,7 @@ func TestNewsRoutes_GetAll(t *testing.T) {
 		router.GET("/news", newsRouter.GetAll)
 
 		// Mock expectations
-		mockNewsUseCase.On("GetAll", mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
+		mockNewsUseCase.On("GetAll", mock.Anything, mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -197,7 +240,7 @@ func TestNewsRoutes_GetByID(t *testing.T) {
 		}
 
 		// Mock expectations
-		mockNewsUseCase.On("GetByID", mock.Anything, testNewsID).Return(expectedNews, nil)
+		mockNewsUseCase.On("GetByID", mock.Anything, testNewsID, mock.Anything).Return(expectedNews, nil)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/news/"+testNewsID, http.NoBody)
@@ -218,6 +261,137 @@ func TestNewsRoutes_GetByID(t *testing.T) {
 		mockNewsUseCase.AssertExpectations(t)
 	})
 
+	t.Run("success - render html or source", func(t *testing.T) {
+		testCases := []struct {
+			name            string
+			query           string
+			expectedContent string
+		}{
+			{name: "html by default", query: "", expectedContent: "<h1 id=\"title\">Title</h1>\n"},
+			{name: "html", query: "?render=html", expectedContent: "<h1 id=\"title\">Title</h1>\n"},
+			{name: "source", query: "?render=source", expectedContent: "# Title"},
+			{name: "text", query: "?render=text", expectedContent: "Title"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// Arrange
+				mockNewsUseCase := new(MockNewsUseCase)
+
+				router := setupTestRouter()
+				newsRouter := &newsRoutes{
+					news: mockNewsUseCase,
+					log:  new(MockLogger),
+				}
+
+				router.GET("/news/:id", newsRouter.GetByID)
+
+				// Mock expectations
+				mockNewsUseCase.On("GetByID", mock.Anything, testNewsID, mock.Anything).Return(&dto.NewsResponseDTO{
+					ID:            testNewsID,
+					Content:       "# Title",
+					ContentFormat: "markdown",
+					ContentHTML:   "<h1 id=\"title\">Title</h1>\n",
+					ContentText:   "Title",
+				}, nil)
+
+				// Act
+				req := httptest.NewRequest(http.MethodGet, "/news/"+testNewsID+tc.query, http.NoBody)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				// Assert
+				assert.Equal(t, http.StatusOK, w.Code)
+
+				var response map[string]interface{}
+
+				err := json.Unmarshal(w.Body.Bytes(), &response)
+				assert.NoError(t, err)
+
+				data, ok := response["data"].(map[string]interface{})
+				assert.True(t, ok)
+
+				news, ok := data["news"].(map[string]interface{})
+				assert.True(t, ok)
+				assert.Equal(t, tc.expectedContent, news["content"])
+				assert.Equal(t, "markdown", news["content_format"])
+				assert.NotContains(t, news, "content_html")
+
+				mockNewsUseCase.AssertExpectations(t)
+			})
+		}
+	})
+
+	t.Run("success - translation in the locale asked for", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  mockLogger,
+		}
+
+		router.GET("/news/:id", newsRouter.GetByID)
+
+		// Mock expectations
+		mockNewsUseCase.On("GetByID", mock.Anything, testNewsID, []string{"id", "en"}).Return(&dto.NewsResponseDTO{
+			ID:     testNewsID,
+			Title:  "Berita",
+			Locale: "id",
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testNewsID+"?lang=id,%20en", http.NoBody)
+		req.Header.Set("Accept-Language", "fr")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"locale":"id"`)
+		assert.Empty(t, w.Header().Get("Vary"))
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid render", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  new(MockLogger),
+		}
+
+		router.GET("/news/:id", newsRouter.GetByID)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testNewsID+"?render=pdf", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta, ok := response["meta"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Invalid render", meta["message"])
+
+		mockNewsUseCase.AssertNotCalled(t, "GetByID")
+	})
+
 	t.Run("error - news not found", func(t *testing.T) {
 		// Arrange
 		mockNewsUseCase := new(MockNewsUseCase)
@@ -232,7 +406,7 @@ func TestNewsRoutes_GetByID(t *testing.T) {
 		router.GET("/news/:id", newsRouter.GetByID)
 
 		// Mock expectations
-		mockNewsUseCase.On("GetByID", mock.Anything, "non-existent-id").Return(nil, apperror.ErrNotFound)
+		mockNewsUseCase.On("GetByID", mock.Anything, "non-existent-id", mock.Anything).Return(nil, apperror.ErrNotFound)
 
 		// Act
 		req := httptest.NewRequest(http.MethodGet, "/news/non-existent-id", http.NoBody)
@@ -270,7 +444,7 @@ func TestNewsRoutes_GetByID(t *testing.T) {
 		router.GET("/news/:id", newsRouter.GetByID)
 
 		// Mock expectations
-		mockNewsUseCase.On("GetByID", mock.Anything, testNewsID).Return(nil, apperror.ErrDatabaseConnection)
+		mockNewsUseCase.On("GetByID", mock.Anything, testNewsID, mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -403,6 +577,141 @@ func TestNewsRoutes_Create(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 
+	t.Run("error - invalid content block", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  new(MockLogger),
+		}
+
+		router.POST("/news", func(c *gin.Context) {
+			c.Set("user_id", testNewsAuthorID)
+			newsRouter.Create(c)
+		})
+
+		bodyBytes := []byte(`{"category_id": "` + testNewsCategoryID + `", "title": "Breaking News", ` +
+			`"content": "{\"blocks\": [{\"type\": \"heading\", \"text\": \"x\"}]}", "content_format": "blocks"}`)
+
+		// Mock expectations
+		mockNewsUseCase.On("Create", mock.Anything, testNewsAuthorID, mock.Anything).
+			Return(nil, &blocks.Error{Index: 0, Field: "level", Type: blocks.TypeHeading, Reason: blocks.ReasonLevel, Message: "must be between 1 and 6"})
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		meta, ok := response["meta"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "Invalid content", meta["message"])
+		assert.Equal(t, []interface{}{map[string]interface{}{
+			"field":   "content.blocks[0].level",
+			"code":    "heading_level",
+			"message": "must be between 1 and 6",
+		}}, response["errors"])
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid SEO metadata", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  mockLogger,
+		}
+
+		router.POST("/news", func(c *gin.Context) {
+			c.Set("user_id", testNewsAuthorID)
+			newsRouter.Create(c)
+		})
+
+		bodyBytes := []byte(`{"category_id": "` + testNewsCategoryID + `", "title": "Breaking News", ` +
+			`"content": "Content", "canonical_url": "javascript:alert(1)"}`)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockNewsUseCase.AssertNotCalled(t, "Create")
+	})
+
+	t.Run("error - featured media is not an image", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  new(MockLogger),
+		}
+
+		router.POST("/news", func(c *gin.Context) {
+			c.Set("user_id", testNewsAuthorID)
+			newsRouter.Create(c)
+		})
+
+		mediaID := "550e8400-e29b-41d4-a716-446655440030"
+		bodyBytes := []byte(`{"category_id": "` + testNewsCategoryID + `", "title": "Breaking News", ` +
+			`"content": "Content", "featured_media_id": "` + mediaID + `", "noindex": true}`)
+
+		// Mock expectations
+		mockNewsUseCase.On("Create", mock.Anything, testNewsAuthorID, mock.MatchedBy(func(req *dto.CreateNewsRequestDTO) bool {
+			return req.FeaturedMediaID != nil && *req.FeaturedMediaID == mediaID && req.NoIndex
+		})).Return(nil, apperror.ErrInvalidFeaturedMedia)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news", bytes.NewBuffer(bodyBytes))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []interface{}{map[string]interface{}{
+			"field":   "featured_media_id",
+			"code":    "image_media",
+			"message": "must be an image in the media library",
+		}}, response["errors"])
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
 	t.Run("error - user not authenticated", func(t *testing.T) {
 		// Arrange
 		mockNewsUseCase := new(MockNewsUseCase)
@@ -552,7 +861,11 @@ func TestNewsRoutes_Update(t *testing.T) {
 			log:  mockLogger,
 		}
 
-		router.PUT("/news/:id", newsRouter.Update)
+		// Simulate authenticated user middleware setting user_id
+		router.PUT("/news/:id", func(c *gin.Context) {
+			c.Set("user_id", testNewsAuthorID)
+			newsRouter.Update(c)
+		})
 
 		requestBody := map[string]string{
 			"category_id": testNewsCategoryID,
@@ -563,7 +876,7 @@ func TestNewsRoutes_Update(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockNewsUseCase.On("Update", mock.Anything, testNewsID, &dto.UpdateNewsRequestDTO{
+		mockNewsUseCase.On("Update", mock.Anything, testNewsAuthorID, testNewsID, &dto.UpdateNewsRequestDTO{
 			CategoryID: testNewsCategoryID,
 			Title:      "Updated News",
 			Content:    "Updated content",
@@ -590,6 +903,86 @@ func TestNewsRoutes_Update(t *testing.T) {
 		mockNewsUseCase.AssertExpectations(t)
 	})
 
+	t.Run("success - null closing time clears it", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  mockLogger,
+		}
+
+		router.PUT("/news/:id", func(c *gin.Context) {
+			c.Set("user_id", testNewsAuthorID)
+			newsRouter.Update(c)
+		})
+
+		body := `{"category_id": "` + testNewsCategoryID + `", "title": "Updated News", "content": "Updated content", ` +
+			`"comments_close_at": null}`
+
+		// Mock expectations
+		mockNewsUseCase.On("Update", mock.Anything, testNewsAuthorID, testNewsID, &dto.UpdateNewsRequestDTO{
+			CategoryID:           testNewsCategoryID,
+			Title:                "Updated News",
+			Content:              "Updated content",
+			ClearCommentsCloseAt: true,
+		}).Return(nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPut, "/news/"+testNewsID, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - closing time is passed on", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  mockLogger,
+		}
+
+		router.PUT("/news/:id", func(c *gin.Context) {
+			c.Set("user_id", testNewsAuthorID)
+			newsRouter.Update(c)
+		})
+
+		closeAt := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
+		body := `{"category_id": "` + testNewsCategoryID + `", "title": "Updated News", "content": "Updated content", ` +
+			`"comments_close_at": "2025-12-31T23:59:59Z"}`
+
+		// Mock expectations
+		mockNewsUseCase.On("Update", mock.Anything, testNewsAuthorID, testNewsID, mock.MatchedBy(func(req *dto.UpdateNewsRequestDTO) bool {
+			return req.CommentsCloseAt != nil && req.CommentsCloseAt.Equal(closeAt) && !req.ClearCommentsCloseAt
+		})).Return(nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPut, "/news/"+testNewsID, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
 	t.Run("error - invalid request payload", func(t *testing.T) {
 		// Arrange
 		mockNewsUseCase := new(MockNewsUseCase)
@@ -646,7 +1039,7 @@ func TestNewsRoutes_Update(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockNewsUseCase.On("Update", mock.Anything, "non-existent-id", mock.Anything).Return(apperror.ErrNotFound)
+		mockNewsUseCase.On("Update", mock.Anything, mock.Anything, "non-existent-id", mock.Anything).Return(apperror.ErrNotFound)
 
 		// Act
 		req := httptest.NewRequest(http.MethodPut, "/news/non-existent-id", bytes.NewBuffer(bodyBytes))
@@ -694,7 +1087,7 @@ func TestNewsRoutes_Update(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Mock expectations
-		mockNewsUseCase.On("Update", mock.Anything, testNewsID, mock.Anything).Return(apperror.ErrDatabaseConnection)
+		mockNewsUseCase.On("Update", mock.Anything, mock.Anything, testNewsID, mock.Anything).Return(apperror.ErrDatabaseConnection)
 		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
 
 		// Act
@@ -817,3 +1210,105 @@ func TestNewsRoutes_Delete(t *testing.T) {
 		mockLogger.AssertExpectations(t)
 	})
 }
+
+func TestNewsRoutes_React(t *testing.T) {
+	t.Run("success - react to news", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  mockLogger,
+		}
+
+		router.POST("/news/:id/reactions", newsRouter.React)
+
+		// Mock expectations
+		mockNewsUseCase.On("React", mock.Anything, testNewsID, mock.Anything, "like").
+			Return(map[string]int{"like": 1, "love": 0}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testNewsID+"/reactions", bytes.NewBufferString(`{"reaction": "like"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+
+		data, ok := response["data"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, map[string]interface{}{"like": float64(1), "love": float64(0)}, data["reactions"])
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid reaction", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  mockLogger,
+		}
+
+		router.POST("/news/:id/reactions", newsRouter.React)
+
+		// Mock expectations
+		mockNewsUseCase.On("React", mock.Anything, testNewsID, mock.Anything, "angry").
+			Return(nil, apperror.ErrInvalidReaction)
+
+		// Act
+		req := httptest.NewRequest(http.MethodPost, "/news/"+testNewsID+"/reactions", bytes.NewBufferString(`{"reaction": "angry"}`))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - news not found", func(t *testing.T) {
+		// Arrange
+		mockNewsUseCase := new(MockNewsUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		newsRouter := &newsRoutes{
+			news: mockNewsUseCase,
+			log:  mockLogger,
+		}
+
+		router.DELETE("/news/:id/reactions/:reaction", newsRouter.Unreact)
+
+		// Mock expectations
+		mockNewsUseCase.On("Unreact", mock.Anything, testNewsID, mock.Anything, "like").
+			Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodDelete, "/news/"+testNewsID+"/reactions/like", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,294 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/request"
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type redirectRoutes struct {
+	redirect usecase.Redirect
+	log      logger.Interface
+}
+
+func newRedirectRoutes(handler *gin.RouterGroup, redirect usecase.Redirect, log logger.Interface, authMiddleware gin.HandlerFunc) {
+	redirectRouter := redirectRoutes{redirect, log}
+
+	// Managing redirects is restricted to authenticated users
+	h := handler.Group("redirects", authMiddleware)
+	{
+		h.GET("", redirectRouter.GetAll)
+		h.GET("/:id", redirectRouter.GetByID)
+		h.POST("", redirectRouter.Create)
+		h.PUT("/:id", redirectRouter.Update)
+		h.DELETE("/:id", redirectRouter.Delete)
+	}
+}
+
+// newRedirectResolver returns the handler answering requests that match no
+// other route with their redirect, if one is registered.
+func newRedirectResolver(redirect usecase.Redirect, log logger.Interface) gin.HandlerFunc {
+	redirectRouter := redirectRoutes{redirect, log}
+
+	return redirectRouter.Resolve
+}
+
+// @Summary Get all redirects
+// @Description Retrieve all managed redirects, ordered by source path (requires authentication)
+// @Tags Redirects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} response.Response "List of redirects"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /redirects [get]
+func (rr *redirectRoutes) GetAll(ctx *gin.Context) {
+	redirects, err := rr.redirect.GetAll(ctx)
+	if err != nil {
+		rr.log.Error(err, "RedirectController - GetAll - rr.redirect.GetAll")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"redirects": redirects,
+	})
+}
+
+// @Summary Get redirect by ID
+// @Description Retrieve a single redirect by its ID (requires authentication)
+// @Tags Redirects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Redirect ID"
+// @Success 200 {object} response.Response "Redirect detail"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Redirect not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /redirects/{id} [get]
+func (rr *redirectRoutes) GetByID(ctx *gin.Context) {
+	redirect, err := rr.redirect.GetByID(ctx, ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeRedirectNotFound)
+
+			return
+		}
+
+		rr.log.Error(err, "RedirectController - GetByID - rr.redirect.GetByID")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"redirect": redirect,
+	})
+}
+
+// @Summary Create a redirect
+// @Description Redirect a site path to another path or an absolute http(s) URL (requires authentication).
+// @Description The status code defaults to 301. A target that is itself redirected is replaced by the end of
+// @Description its chain, and redirects that would loop are rejected.
+// @Tags Redirects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body request.Redirect true "Redirect"
+// @Success 201 {object} response.Response "Redirect created successfully"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 409 {object} response.ValidationErrorResponse "Source path already redirected or redirect would loop"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /redirects [post]
+func (rr *redirectRoutes) Create(ctx *gin.Context) {
+	var req request.Redirect
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		rr.log.Error(err, "RedirectController - Create - ctx.ShouldBindJSON")
+		sendBindError(ctx, &req, err)
+
+		return
+	}
+
+	redirect, err := rr.redirect.Create(ctx, &dto.CreateRedirectRequestDTO{
+		SourcePath: req.SourcePath,
+		Target:     req.Target,
+		StatusCode: req.StatusCode,
+		ExpiresAt:  req.ExpiresAt,
+	})
+	if err != nil {
+		if sendRedirectError(ctx, err) {
+			return
+		}
+
+		rr.log.Error(err, "RedirectController - Create - rr.redirect.Create")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusCreated, gin.H{
+		"redirect": redirect,
+	})
+}
+
+// @Summary Update a redirect
+// @Description Update an existing redirect (requires authentication)
+// @Tags Redirects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Redirect ID"
+// @Param request body request.UpdateRedirect true "Redirect"
+// @Success 200 {object} response.Response "Redirect updated successfully"
+// @Failure 400 {object} response.ValidationErrorResponse "Invalid request payload"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Redirect not found"
+// @Failure 409 {object} response.ValidationErrorResponse "Source path already redirected or redirect would loop"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /redirects/{id} [put]
+func (rr *redirectRoutes) Update(ctx *gin.Context) {
+	var req request.UpdateRedirect
+
+	// Bind JSON request
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		rr.log.Error(err, "RedirectController - Update - ctx.ShouldBindJSON")
+		sendBindError(ctx, &req, err)
+
+		return
+	}
+
+	err := rr.redirect.Update(ctx, ctx.Param("id"), &dto.UpdateRedirectRequestDTO{
+		SourcePath: req.SourcePath,
+		Target:     req.Target,
+		StatusCode: req.StatusCode,
+		ExpiresAt:  req.ExpiresAt,
+	})
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeRedirectNotFound)
+
+			return
+		}
+
+		if sendRedirectError(ctx, err) {
+			return
+		}
+
+		rr.log.Error(err, "RedirectController - Update - rr.redirect.Update")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Redirect updated successfully",
+	})
+}
+
+// @Summary Delete a redirect
+// @Description Delete a redirect by ID (requires authentication)
+// @Tags Redirects
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Redirect ID"
+// @Success 200 {object} response.Response "Redirect deleted successfully"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 404 {object} response.ErrorResponse "Redirect not found"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /redirects/{id} [delete]
+func (rr *redirectRoutes) Delete(ctx *gin.Context) {
+	err := rr.redirect.Delete(ctx, ctx.Param("id"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			response.SendError(ctx, http.StatusNotFound, response.CodeRedirectNotFound)
+
+			return
+		}
+
+		rr.log.Error(err, "RedirectController - Delete - rr.redirect.Delete")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"message": "Redirect deleted successfully",
+	})
+}
+
+// Resolve redirects a request that matched no route when a redirect is
+// registered for its path, keeping the query string. Otherwise it leaves the
+// request to the next handler.
+func (rr *redirectRoutes) Resolve(ctx *gin.Context) {
+	if ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead {
+		return
+	}
+
+	redirect, err := rr.redirect.Resolve(ctx, ctx.Request.URL.EscapedPath())
+	if err != nil {
+		if !errors.Is(err, apperror.ErrNotFound) {
+			rr.log.Error(err, "RedirectController - Resolve - rr.redirect.Resolve")
+		}
+
+		return
+	}
+
+	target := redirect.Target
+	if query := ctx.Request.URL.RawQuery; query != "" && !strings.Contains(target, "?") {
+		target += "?" + query
+	}
+
+	ctx.Redirect(redirect.StatusCode, target)
+	ctx.Abort()
+}
+
+// sendRedirectError responds to errors caused by the submitted redirect and
+// reports whether err was one of them.
+func sendRedirectError(ctx *gin.Context, err error) bool {
+	var fieldErr response.FieldError
+
+	switch {
+	case errors.Is(err, apperror.ErrDuplicateKey):
+		response.SendValidationError(ctx, http.StatusConflict, response.CodeRedirectExists, []response.FieldError{
+			{Field: "source_path", Code: response.FieldAlreadyRedirected},
+		})
+
+		return true
+	case errors.Is(err, apperror.ErrRedirectLoop):
+		response.SendValidationError(ctx, http.StatusConflict, response.CodeRedirectLoop, []response.FieldError{
+			{Field: "target", Code: response.FieldRedirectLoop},
+		})
+
+		return true
+	case errors.Is(err, apperror.ErrInvalidRedirect):
+		fieldErr = response.FieldError{Field: "target", Code: response.FieldURLOrPath}
+	case errors.Is(err, apperror.ErrInvalidRedirectCode):
+		fieldErr = response.FieldError{Field: "status_code", Code: response.FieldOneOf, Args: []interface{}{"301, 302, 307, 308"}}
+	default:
+		code, ok := sitePathCode(err)
+		if !ok {
+			return false
+		}
+
+		fieldErr = response.FieldError{Field: "source_path", Code: code}
+	}
+
+	response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidRedirect, []response.FieldError{fieldErr})
+
+	return true
+}
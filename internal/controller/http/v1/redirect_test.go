@@ -0,0 +1,386 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testRedirectID     = "550e8400-e29b-41d4-a716-446655440020"
+	testRedirectSource = "/about-company"
+	testRedirectTarget = "/about-us"
+)
+
+// MockRedirectUseCase is a mock implementation of usecase.Redirect.
+type MockRedirectUseCase struct {
+	mock.Mock
+}
+
+func (m *MockRedirectUseCase) Create(ctx context.Context, req *dto.CreateRedirectRequestDTO) (*dto.RedirectResponseDTO, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.RedirectResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRedirectUseCase) GetByID(ctx context.Context, id string) (*dto.RedirectResponseDTO, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.RedirectResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRedirectUseCase) GetAll(ctx context.Context) ([]dto.RedirectResponseDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.RedirectResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRedirectUseCase) Update(ctx context.Context, id string, req *dto.UpdateRedirectRequestDTO) error {
+	args := m.Called(ctx, id, req)
+
+	return args.Error(0)
+}
+
+func (m *MockRedirectUseCase) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockRedirectUseCase) Resolve(ctx context.Context, path string) (*dto.RedirectResponseDTO, error) {
+	args := m.Called(ctx, path)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.RedirectResponseDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func TestRedirectRoutes_Create(t *testing.T) {
+	t.Run("success - create redirect", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		redirectRouter := &redirectRoutes{
+			redirect: mockRedirectUseCase,
+			log:      mockLogger,
+		}
+
+		router.POST("/redirects", redirectRouter.Create)
+
+		// Mock expectations
+		mockRedirectUseCase.On("Create", mock.Anything, &dto.CreateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: http.StatusPermanentRedirect,
+		}).Return(&dto.RedirectResponseDTO{
+			ID:         testRedirectID,
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: http.StatusPermanentRedirect,
+		}, nil)
+
+		// Act
+		body := `{"source_path": "/about-company", "target": "/about-us", "status_code": 308}`
+		req := httptest.NewRequest(http.MethodPost, "/redirects", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, w.Code)
+
+		mockRedirectUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - unsupported status code", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		redirectRouter := &redirectRoutes{
+			redirect: mockRedirectUseCase,
+			log:      mockLogger,
+		}
+
+		router.POST("/redirects", redirectRouter.Create)
+
+		// Mock expectations
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		body := `{"source_path": "/about-company", "target": "/about-us", "status_code": 303}`
+		req := httptest.NewRequest(http.MethodPost, "/redirects", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		var response map[string]interface{}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"field": "status_code", "code": "one_of", "message": "must be one of: 301, 302, 307, 308"},
+		}, response["errors"])
+
+		mockRedirectUseCase.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - redirect would loop", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		redirectRouter := &redirectRoutes{
+			redirect: mockRedirectUseCase,
+			log:      mockLogger,
+		}
+
+		router.POST("/redirects", redirectRouter.Create)
+
+		// Mock expectations
+		mockRedirectUseCase.On("Create", mock.Anything, mock.Anything).Return(nil, apperror.ErrRedirectLoop)
+
+		// Act
+		body := `{"source_path": "/about-company", "target": "/about-us"}`
+		req := httptest.NewRequest(http.MethodPost, "/redirects", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, w.Code)
+
+		mockRedirectUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid target", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		redirectRouter := &redirectRoutes{
+			redirect: mockRedirectUseCase,
+			log:      mockLogger,
+		}
+
+		router.POST("/redirects", redirectRouter.Create)
+
+		// Mock expectations
+		mockRedirectUseCase.On("Create", mock.Anything, mock.Anything).Return(nil, apperror.ErrInvalidRedirect)
+
+		// Act
+		body := `{"source_path": "/about-company", "target": "ftp://example.com"}`
+		req := httptest.NewRequest(http.MethodPost, "/redirects", bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockRedirectUseCase.AssertExpectations(t)
+	})
+}
+
+func TestRedirectRoutes_Update(t *testing.T) {
+	t.Run("error - redirect not found", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		redirectRouter := &redirectRoutes{
+			redirect: mockRedirectUseCase,
+			log:      mockLogger,
+		}
+
+		router.PUT("/redirects/:id", redirectRouter.Update)
+
+		// Mock expectations
+		mockRedirectUseCase.On("Update", mock.Anything, testRedirectID, mock.Anything).Return(apperror.ErrNotFound)
+
+		// Act
+		body := `{"source_path": "/about-company", "target": "/about-us"}`
+		req := httptest.NewRequest(http.MethodPut, "/redirects/"+testRedirectID, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockRedirectUseCase.AssertExpectations(t)
+	})
+}
+
+func TestRedirectRoutes_Delete(t *testing.T) {
+	t.Run("success - delete redirect", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		redirectRouter := &redirectRoutes{
+			redirect: mockRedirectUseCase,
+			log:      mockLogger,
+		}
+
+		router.DELETE("/redirects/:id", redirectRouter.Delete)
+
+		// Mock expectations
+		mockRedirectUseCase.On("Delete", mock.Anything, testRedirectID).Return(nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodDelete, "/redirects/"+testRedirectID, http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockRedirectUseCase.AssertExpectations(t)
+	})
+}
+
+func TestRedirectRoutes_Resolve(t *testing.T) {
+	t.Run("success - redirect keeps the query string", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		router.NoRoute(newRedirectResolver(mockRedirectUseCase, mockLogger))
+
+		// Mock expectations
+		mockRedirectUseCase.On("Resolve", mock.Anything, testRedirectSource).Return(&dto.RedirectResponseDTO{
+			ID:         testRedirectID,
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: http.StatusMovedPermanently,
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, testRedirectSource+"?ref=nav", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusMovedPermanently, w.Code)
+		assert.Equal(t, testRedirectTarget+"?ref=nav", w.Header().Get("Location"))
+
+		mockRedirectUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - falls through to custom pages", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockCustomPageUseCase := new(MockCustomPageUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		router.NoRoute(
+			newRedirectResolver(mockRedirectUseCase, mockLogger),
+			newCustomPageServer(mockCustomPageUseCase, mockLogger),
+		)
+
+		// Mock expectations
+		mockRedirectUseCase.On("Resolve", mock.Anything, testRedirectTarget).Return(nil, apperror.ErrNotFound)
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, testRedirectTarget, mock.Anything).Return(&dto.CustomPageResponseDTO{
+			CustomURL: testRedirectTarget,
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, testRedirectTarget, http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		mockRedirectUseCase.AssertExpectations(t)
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - no redirect registered", func(t *testing.T) {
+		// Arrange
+		mockRedirectUseCase := new(MockRedirectUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		router.NoRoute(newRedirectResolver(mockRedirectUseCase, mockLogger))
+
+		// Mock expectations
+		mockRedirectUseCase.On("Resolve", mock.Anything, "/missing").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/missing", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+
+		mockRedirectUseCase.AssertExpectations(t)
+		mockLogger.AssertNotCalled(t, "Error", mock.Anything, mock.Anything)
+	})
+}
@@ -3,9 +3,14 @@ package request
 // Category represents the request body for creating a category.
 type Category struct {
 	Name string `json:"name" binding:"required" example:"Technology"`
+
+	Locale        string `json:"locale" binding:"max=10" example:"id"`
+	TranslationOf string `json:"translation_of" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
 }
 
 // UpdateCategory represents the request body for updating a category.
 type UpdateCategory struct {
 	Name string `json:"name" binding:"required" example:"Updated Technology"`
+
+	Locale string `json:"locale" binding:"max=10" example:"id"`
 }
@@ -2,6 +2,44 @@ package request
 
 // Comment represents the request body for creating a comment.
 type Comment struct {
-	Name    string `json:"name" binding:"required" example:"John Doe"`
-	Comment string `json:"comment" binding:"required" example:"This is a great article!"`
+	Name      string `json:"name" binding:"required" example:"John Doe"`
+	Comment   string `json:"comment" binding:"required" example:"This is a great article!"`
+	ParentID  string `json:"parent_id" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Challenge string `json:"challenge" example:"NTUwZTg0MDAtZTI5Yi00MWQ0.c2lnbmF0dXJl"`
+	Nonce     string `json:"nonce" example:"48213"`
+}
+
+// UpdateComment represents the request body for editing a comment.
+type UpdateComment struct {
+	Comment string `json:"comment" binding:"required" example:"This is a great article! (edited)"`
+}
+
+// CommentVote represents the request body for voting on a comment: 1 for an
+// upvote, -1 for a downvote and 0 to withdraw the vote.
+type CommentVote struct {
+	Value *int `json:"value" binding:"required,oneof=-1 0 1" example:"1"`
+}
+
+// ReportComment represents the request body for reporting a comment.
+type ReportComment struct {
+	Reason string `json:"reason" binding:"required" enums:"spam,abuse,harassment,off_topic,other" example:"abuse"`
+	Note   string `json:"note" binding:"max=500" example:"Insults other readers"`
+}
+
+// ModerateComments represents the request body for approving comments in bulk.
+type ModerateComments struct {
+	IDs []string `json:"ids" binding:"required,min=1,max=100,dive,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+}
+
+// RejectComments represents the request body for rejecting comments in bulk.
+type RejectComments struct {
+	IDs  []string `json:"ids" binding:"required,min=1,max=100,dive,uuid" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Spam bool     `json:"spam" example:"false"`
+}
+
+// ModerationPage represents the query parameters for paging through the
+// moderation queue and reported comments.
+type ModerationPage struct {
+	Limit  int `form:"limit" binding:"omitempty,min=1,max=100" example:"50"`
+	Offset int `form:"offset" binding:"omitempty,min=0" example:"0"`
 }
@@ -1,13 +1,30 @@
 package request
 
-// CustomPage represents the request body for creating custom page.
+// CustomPage represents the request body for creating custom page. Give
+// either a custom_url, or a slug to place the page at its parent's URL plus
+// the slug.
 type CustomPage struct {
-	CustomURL string `json:"custom_url" binding:"required" example:"/about-us"`
+	ParentID  string `json:"parent_id" binding:"omitempty,uuid" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Position  int    `json:"position" binding:"min=0" example:"0"`
+	Slug      string `json:"slug" binding:"max=150" example:"billing"`
+	CustomURL string `json:"custom_url" binding:"required_without=Slug,max=150" example:"/about-us"`
 	Content   string `json:"content" binding:"required" example:"<h1>About Us</h1><p>This is our about page...</p>"`
+
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=html markdown plaintext blocks" example:"html"`
+
+	Locale        string `json:"locale" binding:"max=10" example:"id"`
+	TranslationOf string `json:"translation_of" binding:"omitempty,uuid" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
 }
 
 // UpdateCustomPage represents the request body for updating custom page.
 type UpdateCustomPage struct {
-	CustomURL string `json:"custom_url" binding:"required" example:"/about-company"`
+	ParentID  string `json:"parent_id" binding:"omitempty,uuid" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Position  int    `json:"position" binding:"min=0" example:"1"`
+	Slug      string `json:"slug" binding:"max=150" example:"billing"`
+	CustomURL string `json:"custom_url" binding:"required_without=Slug,max=150" example:"/about-company"`
 	Content   string `json:"content" binding:"required" example:"<h1>About Company</h1><p>Updated content...</p>"`
+
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=html markdown plaintext blocks" example:"html"`
+
+	Locale string `json:"locale" binding:"max=10" example:"id"`
 }
@@ -0,0 +1,14 @@
+package request
+
+// UploadMedia represents the form fields sent along with an uploaded file.
+type UploadMedia struct {
+	AltText string `form:"alt_text" binding:"max=500" example:"A sunset over the harbour"`
+}
+
+// UpdateMedia represents the request body for updating a media file. The
+// focal point, in fractions of the width and height, defaults to the center.
+type UpdateMedia struct {
+	AltText string   `json:"alt_text" binding:"max=500" example:"A sunset over the harbour"`
+	FocalX  *float64 `json:"focal_x" binding:"omitempty,min=0,max=1" example:"0.5"`
+	FocalY  *float64 `json:"focal_y" binding:"omitempty,min=0,max=1" example:"0.3"`
+}
@@ -0,0 +1,16 @@
+package request
+
+// Menu represents the request body for creating or replacing a menu.
+type Menu struct {
+	Items []MenuItem `json:"items" binding:"dive"`
+}
+
+// MenuItem represents a menu item. Page, category and news items reference
+// their target by ID; url items link to a site path or an absolute URL.
+type MenuItem struct {
+	Type     string     `json:"type" binding:"required,oneof=page category news url" example:"page"`
+	TargetID string     `json:"target_id" binding:"required_unless=Type url,omitempty,uuid" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	URL      string     `json:"url" binding:"required_if=Type url,max=2048" example:"https://example.com"`
+	Title    string     `json:"title" binding:"required_if=Type url,max=150" example:"About us"`
+	Children []MenuItem `json:"children" binding:"dive"`
+}
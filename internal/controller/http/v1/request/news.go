@@ -1,10 +1,38 @@
 package request
 
+import "time"
+
+// NewsMetadata represents the featured image, excerpt, tags and metadata for
+// search engines and social sharing of a news article.
+type NewsMetadata struct {
+	FeaturedMediaID *string `json:"featured_media_id" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440030"`
+	Excerpt         string  `json:"excerpt" binding:"max=500" example:"A short summary shown in listings"`
+	MetaTitle       string  `json:"meta_title" binding:"max=255" example:"Technology Advances in 2025"`
+	MetaDescription string  `json:"meta_description" binding:"max=500" example:"What changed in technology this year"`
+	CanonicalURL    string  `json:"canonical_url" binding:"omitempty,http_url,max=2048" example:"https://example.com/news/technology-advances"`
+	OGTitle         string  `json:"og_title" binding:"max=255" example:"Technology Advances"`
+	OGDescription   string  `json:"og_description" binding:"max=500" example:"What changed in technology this year"`
+	OGImage         string  `json:"og_image" binding:"omitempty,http_url,max=2048" example:"https://example.com/media/og.png"`
+	NoIndex         bool    `json:"noindex" example:"false"`
+
+	Tags []string `json:"tags" binding:"max=20,dive,max=50,excludes=/" example:"technology,ai"`
+}
+
 // News represents the request body for creating news.
 type News struct {
 	CategoryID string `json:"category_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
 	Title      string `json:"title" binding:"required" example:"Breaking News: Technology Advances"`
 	Content    string `json:"content" binding:"required" example:"This is the full content of the news article..."`
+
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=html markdown plaintext blocks" example:"markdown"`
+
+	CommentsEnabled *bool      `json:"comments_enabled" example:"true"`
+	CommentsCloseAt *time.Time `json:"comments_close_at" example:"2025-12-31T23:59:59Z"`
+
+	Locale        string `json:"locale" binding:"max=10" example:"id"`
+	TranslationOf string `json:"translation_of" binding:"omitempty,uuid" example:"550e8400-e29b-41d4-a716-446655440010"`
+
+	NewsMetadata
 }
 
 // UpdateNews represents the request body for updating news.
@@ -12,4 +40,19 @@ type UpdateNews struct {
 	CategoryID string `json:"category_id" binding:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
 	Title      string `json:"title" binding:"required" example:"Updated News Title"`
 	Content    string `json:"content" binding:"required" example:"This is the updated content..."`
+
+	ContentFormat string `json:"content_format" binding:"omitempty,oneof=html markdown plaintext blocks" example:"markdown"`
+
+	CommentsEnabled *bool `json:"comments_enabled" example:"true"`
+	// Null removes the closing time, leaving it out keeps the current one
+	CommentsCloseAt NullableTime `json:"comments_close_at" swaggertype:"string" format:"date-time" example:"2025-12-31T23:59:59Z"`
+
+	Locale string `json:"locale" binding:"max=10" example:"id"`
+
+	NewsMetadata
+}
+
+// Reaction represents the request body for reacting to news.
+type Reaction struct {
+	Reaction string `json:"reaction" binding:"required" example:"like"`
 }
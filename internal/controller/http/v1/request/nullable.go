@@ -0,0 +1,33 @@
+package request
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// NullableTime is a time in a request body that tells a field sent as null
+// from one left out: Set reports whether the field was sent at all, and Time
+// is nil when it was null.
+type NullableTime struct {
+	Set  bool
+	Time *time.Time
+}
+
+func (t *NullableTime) UnmarshalJSON(data []byte) error {
+	t.Set = true
+
+	if string(data) == "null" {
+		t.Time = nil
+
+		return nil
+	}
+
+	var value time.Time
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	t.Time = &value
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package request
+
+import "time"
+
+// Redirect represents the request body for creating a redirect.
+type Redirect struct {
+	SourcePath string     `json:"source_path" binding:"required,max=150" example:"/about-company"`
+	Target     string     `json:"target" binding:"required,max=2048" example:"/about-us"`
+	StatusCode int        `json:"status_code" binding:"omitempty,oneof=301 302 307 308" example:"301"`
+	ExpiresAt  *time.Time `json:"expires_at" example:"2027-01-01T00:00:00Z"`
+}
+
+// UpdateRedirect represents the request body for updating a redirect.
+type UpdateRedirect struct {
+	SourcePath string     `json:"source_path" binding:"required,max=150" example:"/about-company"`
+	Target     string     `json:"target" binding:"required,max=2048" example:"https://example.com/about"`
+	StatusCode int        `json:"status_code" binding:"omitempty,oneof=301 302 307 308" example:"308"`
+	ExpiresAt  *time.Time `json:"expires_at" example:"2027-01-01T00:00:00Z"`
+}
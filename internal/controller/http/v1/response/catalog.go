@@ -0,0 +1,252 @@
+package response
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/locale"
+	"github.com/gin-gonic/gin"
+)
+
+// _defaultLocale is the locale of messages for readers preferring none of
+// the catalog locales, and of messages missing from a catalog.
+const _defaultLocale = "en"
+
+// catalogs are the messages for error codes in each locale.
+var catalogs = map[string]map[string]string{
+	"en": {
+		CodeInternalError:              "Internal server error",
+		CodeInvalidPayload:             "Invalid request payload",
+		CodeNotFound:                   "Not found",
+		CodeTooManyRequests:            "Too many requests",
+		CodeUnsupportedMediaType:       "Unsupported media type",
+		CodeFileTooLarge:               "File too large",
+		CodeAuthorizationRequired:      "Authorization header is required",
+		CodeInvalidAuthorizationHeader: "Invalid authorization header format",
+		CodeUnauthenticated:            "User not authenticated",
+		CodeInvalidCredentials:         "Invalid username or password",
+		CodeTokenRequired:              "Token is required",
+		CodeInvalidToken:               "Invalid token",
+		CodeExpiredToken:               "Invalid or expired token",
+		CodeInvalidTokenType:           "Invalid token type",
+		CodeInvalidTokenClaims:         "Invalid token claims",
+		CodeInvalidUserID:              "Invalid user ID format",
+		CodeUserNotFound:               "User not found",
+		CodeNewsNotFound:               "News not found",
+		CodeCategoryNotFound:           "Category not found",
+		CodePageNotFound:               "Page not found",
+		CodeCommentNotFound:            "Comment not found",
+		CodeMediaNotFound:              "Media not found",
+		CodeMenuNotFound:               "Menu not found",
+		CodeRedirectNotFound:           "Redirect not found",
+		CodeInvalidType:                "Invalid type",
+		CodeInvalidStatus:              "Invalid status",
+		CodeInvalidSort:                "Invalid sort",
+		CodeInvalidSlug:                "Invalid slug",
+		CodeInvalidFormat:              "Invalid format",
+		CodeInvalidRender:              "Invalid render",
+		CodeInvalidContent:             "Invalid content",
+		CodeInvalidCustomURL:           "Invalid custom URL",
+		CodeCustomURLExists:            "Custom URL already in use",
+		CodeInvalidParentPage:          "Invalid parent page",
+		CodeInvalidMenu:                "Invalid menu",
+		CodeInvalidRedirect:            "Invalid redirect",
+		CodeRedirectExists:             "Source path already redirected",
+		CodeRedirectLoop:               "Redirect would loop",
+		CodeMediaInUse:                 "Media is referenced by content",
+		CodeInvalidReaction:            "Invalid reaction",
+		CodeInvalidVote:                "Invalid vote",
+		CodeInvalidReportReason:        "Invalid report reason",
+		CodeInvalidParentComment:       "Invalid parent comment",
+		CodeMaxReplyDepth:              "Maximum reply depth exceeded",
+		CodeCommentRejected:            "Comment rejected by content filter",
+		CodeInvalidChallenge:           "Invalid or expired challenge",
+		CodeInvalidProofOfWork:         "Invalid proof of work",
+		CodeChallengeUsed:              "Challenge already used",
+		CodeInvalidEditToken:           "Invalid edit token",
+		CodeCommentNotEditable:         "Comment can no longer be edited",
+		CodeCommentsClosed:             "Comments are closed for this news",
+		CodeUnsupportedLocale:          "Unsupported locale",
+		CodeInvalidTranslation:         "Invalid translation",
+		CodeTranslationExists:          "Translation already exists",
+	},
+	"id": {
+		CodeInternalError:              "Terjadi kesalahan pada server",
+		CodeInvalidPayload:             "Isi permintaan tidak valid",
+		CodeNotFound:                   "Tidak ditemukan",
+		CodeTooManyRequests:            "Terlalu banyak permintaan",
+		CodeUnsupportedMediaType:       "Jenis media tidak didukung",
+		CodeFileTooLarge:               "Berkas terlalu besar",
+		CodeAuthorizationRequired:      "Header Authorization wajib diisi",
+		CodeInvalidAuthorizationHeader: "Format header Authorization tidak valid",
+		CodeUnauthenticated:            "Pengguna belum terautentikasi",
+		CodeInvalidCredentials:         "Nama pengguna atau kata sandi salah",
+		CodeTokenRequired:              "Token wajib diisi",
+		CodeInvalidToken:               "Token tidak valid",
+		CodeExpiredToken:               "Token tidak valid atau sudah kedaluwarsa",
+		CodeInvalidTokenType:           "Jenis token tidak valid",
+		CodeInvalidTokenClaims:         "Klaim token tidak valid",
+		CodeInvalidUserID:              "Format ID pengguna tidak valid",
+		CodeUserNotFound:               "Pengguna tidak ditemukan",
+		CodeNewsNotFound:               "Berita tidak ditemukan",
+		CodeCategoryNotFound:           "Kategori tidak ditemukan",
+		CodePageNotFound:               "Halaman tidak ditemukan",
+		CodeCommentNotFound:            "Komentar tidak ditemukan",
+		CodeMediaNotFound:              "Media tidak ditemukan",
+		CodeMenuNotFound:               "Menu tidak ditemukan",
+		CodeRedirectNotFound:           "Pengalihan tidak ditemukan",
+		CodeInvalidType:                "Jenis tidak valid",
+		CodeInvalidStatus:              "Status tidak valid",
+		CodeInvalidSort:                "Urutan tidak valid",
+		CodeInvalidSlug:                "Slug tidak valid",
+		CodeInvalidFormat:              "Format tidak valid",
+		CodeInvalidRender:              "Render tidak valid",
+		CodeInvalidContent:             "Konten tidak valid",
+		CodeInvalidCustomURL:           "URL kustom tidak valid",
+		CodeCustomURLExists:            "URL kustom sudah digunakan",
+		CodeInvalidParentPage:          "Halaman induk tidak valid",
+		CodeInvalidMenu:                "Menu tidak valid",
+		CodeInvalidRedirect:            "Pengalihan tidak valid",
+		CodeRedirectExists:             "Path sumber sudah dialihkan",
+		CodeRedirectLoop:               "Pengalihan akan berputar",
+		CodeMediaInUse:                 "Media masih digunakan oleh konten",
+		CodeInvalidReaction:            "Reaksi tidak valid",
+		CodeInvalidVote:                "Suara tidak valid",
+		CodeInvalidReportReason:        "Alasan laporan tidak valid",
+		CodeInvalidParentComment:       "Komentar induk tidak valid",
+		CodeMaxReplyDepth:              "Batas kedalaman balasan terlampaui",
+		CodeCommentRejected:            "Komentar ditolak oleh filter konten",
+		CodeInvalidChallenge:           "Tantangan tidak valid atau sudah kedaluwarsa",
+		CodeInvalidProofOfWork:         "Proof of work tidak valid",
+		CodeChallengeUsed:              "Tantangan sudah digunakan",
+		CodeInvalidEditToken:           "Token edit tidak valid",
+		CodeCommentNotEditable:         "Komentar tidak dapat diubah lagi",
+		CodeCommentsClosed:             "Komentar untuk berita ini sudah ditutup",
+		CodeUnsupportedLocale:          "Locale tidak didukung",
+		CodeInvalidTranslation:         "Terjemahan tidak valid",
+		CodeTranslationExists:          "Terjemahan sudah ada",
+	},
+}
+
+// fieldCatalogs are the messages for field codes in each locale. They
+// follow the field name and may take the arguments of the FieldError.
+var fieldCatalogs = map[string]map[string]string{
+	"en": {
+		FieldRequired:             "is required",
+		FieldRequiredForType:      "is required for this type",
+		FieldRequiredWithout:      "is required when %s is not set",
+		FieldMax:                  "must be at most %s",
+		FieldMaxLength:            "must be at most %s characters",
+		FieldMin:                  "must be at least %s",
+		FieldMinLength:            "must be at least %s characters",
+		FieldOneOf:                "must be one of: %s",
+		FieldUUID:                 "must be a UUID",
+		FieldInvalid:              "is invalid",
+		FieldInvalidPath:          "must be a URL path without query, fragment or whitespace",
+		FieldPathTooLong:          "must be at most 150 characters once normalized",
+		FieldReservedPath:         "must not be a path the application serves itself, such as /api or /news",
+		FieldPathSegment:          "must be a single URL path segment",
+		FieldPageURLTaken:         "is already used by another page",
+		FieldParentPage:           "must be an existing page that is not this page or below it",
+		FieldURLOrPath:            "must be a site path or an absolute http(s) URL",
+		FieldAbsoluteURL:          "must be an absolute http(s) URL",
+		FieldAlreadyRedirected:    "already has a redirect",
+		FieldRedirectLoop:         "leads back to the source path",
+		FieldMenuName:             "must be lowercase letters, digits, hyphens or underscores, up to 64 characters",
+		FieldMenuItem:             "each item needs a target ID, or a title and a site path or absolute http(s) URL",
+		FieldMenuDepth:            "must not nest more than 3 levels",
+		FieldImageMedia:           "must be an image in the media library",
+		FieldUnsupportedLocale:    "is not a supported locale",
+		FieldTranslationSource:    "must be existing content of the same type",
+		FieldTranslationTaken:     "is already used by another translation",
+		FieldBlockDocument:        "must be a JSON object with a list of blocks",
+		FieldBlockFieldNotAllowed: "is not allowed in %s blocks",
+		FieldHeadingLevel:         "must be between 1 and 6",
+		FieldListStyle:            "must be ordered or unordered",
+		FieldListItems:            "must list at least one item",
+	},
+	"id": {
+		FieldRequired:             "wajib diisi",
+		FieldRequiredForType:      "wajib diisi untuk jenis ini",
+		FieldRequiredWithout:      "wajib diisi jika %s tidak diisi",
+		FieldMax:                  "maksimal %s",
+		FieldMaxLength:            "maksimal %s karakter",
+		FieldMin:                  "minimal %s",
+		FieldMinLength:            "minimal %s karakter",
+		FieldOneOf:                "harus salah satu dari: %s",
+		FieldUUID:                 "harus berupa UUID",
+		FieldInvalid:              "tidak valid",
+		FieldInvalidPath:          "harus berupa path URL tanpa query, fragmen, atau spasi",
+		FieldPathTooLong:          "maksimal 150 karakter setelah dinormalisasi",
+		FieldReservedPath:         "tidak boleh berupa path yang dilayani aplikasi sendiri, seperti /api atau /news",
+		FieldPathSegment:          "harus berupa satu segmen path URL",
+		FieldPageURLTaken:         "sudah digunakan oleh halaman lain",
+		FieldParentPage:           "harus berupa halaman yang ada dan bukan halaman ini atau turunannya",
+		FieldURLOrPath:            "harus berupa path situs atau URL http(s) absolut",
+		FieldAbsoluteURL:          "harus berupa URL http(s) absolut",
+		FieldAlreadyRedirected:    "sudah memiliki pengalihan",
+		FieldRedirectLoop:         "mengarah kembali ke path sumber",
+		FieldMenuName:             "harus berupa huruf kecil, angka, tanda hubung, atau garis bawah, maksimal 64 karakter",
+		FieldMenuItem:             "setiap item memerlukan ID target, atau judul dan path situs atau URL http(s) absolut",
+		FieldMenuDepth:            "tidak boleh bertingkat lebih dari 3 level",
+		FieldImageMedia:           "harus berupa gambar di pustaka media",
+		FieldUnsupportedLocale:    "bukan locale yang didukung",
+		FieldTranslationSource:    "harus berupa konten yang ada dengan jenis yang sama",
+		FieldTranslationTaken:     "sudah digunakan oleh terjemahan lain",
+		FieldBlockDocument:        "harus berupa objek JSON dengan daftar blok",
+		FieldBlockFieldNotAllowed: "tidak diperbolehkan pada blok %s",
+		FieldHeadingLevel:         "harus antara 1 dan 6",
+		FieldListStyle:            "harus ordered atau unordered",
+		FieldListItems:            "harus berisi minimal satu item",
+	},
+}
+
+// catalogLocales are the locales messages are written in, the default
+// first.
+var catalogLocales = []string{_defaultLocale, "id"}
+
+// Locale returns the catalog locale best matching the Accept-Language
+// header of the request, English when none match.
+func Locale(ctx *gin.Context) string {
+	preferred := locale.Parse(ctx.GetHeader("Accept-Language"))
+
+	return locale.Negotiate(preferred, catalogLocales)[0]
+}
+
+// Message returns the message for an error code in loc, falling back to
+// English and then to the code itself.
+func Message(loc, code string) string {
+	return lookup(catalogs, loc, code)
+}
+
+// FieldMessage returns the message for a field code in loc with args
+// filled in, falling back to English and then to the code itself.
+func FieldMessage(loc, code string, args ...interface{}) string {
+	message := lookup(fieldCatalogs, loc, code)
+	if len(args) == 0 {
+		return message
+	}
+
+	return fmt.Sprintf(message, args...)
+}
+
+func lookup(catalogs map[string]map[string]string, loc, code string) string {
+	if message, ok := catalogs[loc][code]; ok {
+		return message
+	}
+
+	if message, ok := catalogs[_defaultLocale][code]; ok {
+		return message
+	}
+
+	return code
+}
+
+// VaryLanguage marks a response as depending on the Accept-Language header
+// of the request, once.
+func VaryLanguage(ctx *gin.Context) {
+	header := ctx.Writer.Header()
+	if !slices.Contains(header.Values("Vary"), "Accept-Language") {
+		header.Add("Vary", "Accept-Language")
+	}
+}
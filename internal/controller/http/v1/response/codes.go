@@ -0,0 +1,97 @@
+package response
+
+// Error codes identify why a request failed. Unlike messages, which are
+// shown in the locale of the reader, they never change and are meant for
+// clients to act on.
+const (
+	CodeInternalError              = "internal_error"
+	CodeInvalidPayload             = "invalid_payload"
+	CodeNotFound                   = "not_found"
+	CodeTooManyRequests            = "too_many_requests"
+	CodeUnsupportedMediaType       = "unsupported_media_type"
+	CodeFileTooLarge               = "file_too_large"
+	CodeAuthorizationRequired      = "authorization_required"
+	CodeInvalidAuthorizationHeader = "invalid_authorization_header"
+	CodeUnauthenticated            = "unauthenticated"
+	CodeInvalidCredentials         = "invalid_credentials"
+	CodeTokenRequired              = "token_required"
+	CodeInvalidToken               = "invalid_token"
+	CodeExpiredToken               = "expired_token"
+	CodeInvalidTokenType           = "invalid_token_type"
+	CodeInvalidTokenClaims         = "invalid_token_claims"
+	CodeInvalidUserID              = "invalid_user_id"
+	CodeUserNotFound               = "user_not_found"
+	CodeNewsNotFound               = "news_not_found"
+	CodeCategoryNotFound           = "category_not_found"
+	CodePageNotFound               = "page_not_found"
+	CodeCommentNotFound            = "comment_not_found"
+	CodeMediaNotFound              = "media_not_found"
+	CodeMenuNotFound               = "menu_not_found"
+	CodeRedirectNotFound           = "redirect_not_found"
+	CodeInvalidType                = "invalid_type"
+	CodeInvalidStatus              = "invalid_status"
+	CodeInvalidSort                = "invalid_sort"
+	CodeInvalidSlug                = "invalid_slug"
+	CodeInvalidFormat              = "invalid_format"
+	CodeInvalidRender              = "invalid_render"
+	CodeInvalidContent             = "invalid_content"
+	CodeInvalidCustomURL           = "invalid_custom_url"
+	CodeCustomURLExists            = "custom_url_exists"
+	CodeInvalidParentPage          = "invalid_parent_page"
+	CodeInvalidMenu                = "invalid_menu"
+	CodeInvalidRedirect            = "invalid_redirect"
+	CodeRedirectExists             = "redirect_exists"
+	CodeRedirectLoop               = "redirect_loop"
+	CodeMediaInUse                 = "media_in_use"
+	CodeInvalidReaction            = "invalid_reaction"
+	CodeInvalidVote                = "invalid_vote"
+	CodeInvalidReportReason        = "invalid_report_reason"
+	CodeInvalidParentComment       = "invalid_parent_comment"
+	CodeMaxReplyDepth              = "max_reply_depth"
+	CodeCommentRejected            = "comment_rejected"
+	CodeInvalidChallenge           = "invalid_challenge"
+	CodeInvalidProofOfWork         = "invalid_proof_of_work"
+	CodeChallengeUsed              = "challenge_used"
+	CodeInvalidEditToken           = "invalid_edit_token"
+	CodeCommentNotEditable         = "comment_not_editable"
+	CodeCommentsClosed             = "comments_closed"
+	CodeUnsupportedLocale          = "unsupported_locale"
+	CodeInvalidTranslation         = "invalid_translation"
+	CodeTranslationExists          = "translation_exists"
+)
+
+// Field codes identify why a single request field is invalid.
+const (
+	FieldRequired             = "required"
+	FieldRequiredForType      = "required_for_type"
+	FieldRequiredWithout      = "required_without"
+	FieldMax                  = "max"
+	FieldMaxLength            = "max_length"
+	FieldMin                  = "min"
+	FieldMinLength            = "min_length"
+	FieldOneOf                = "one_of"
+	FieldUUID                 = "uuid"
+	FieldInvalid              = "invalid"
+	FieldInvalidPath          = "invalid_path"
+	FieldPathTooLong          = "path_too_long"
+	FieldReservedPath         = "reserved_path"
+	FieldPathSegment          = "path_segment"
+	FieldPageURLTaken         = "page_url_taken"
+	FieldParentPage           = "parent_page"
+	FieldURLOrPath            = "url_or_path"
+	FieldAbsoluteURL          = "absolute_url"
+	FieldAlreadyRedirected    = "already_redirected"
+	FieldRedirectLoop         = "redirect_loop"
+	FieldMenuName             = "menu_name"
+	FieldMenuItem             = "menu_item"
+	FieldMenuDepth            = "menu_depth"
+	FieldImageMedia           = "image_media"
+	FieldUnsupportedLocale    = "unsupported_locale"
+	FieldTranslationSource    = "translation_source"
+	FieldTranslationTaken     = "translation_taken"
+	FieldBlockDocument        = "block_document"
+	FieldBlockFieldNotAllowed = "block_field_not_allowed"
+	FieldHeadingLevel         = "heading_level"
+	FieldListStyle            = "list_style"
+	FieldListItems            = "list_items"
+)
@@ -4,11 +4,14 @@ import (
 	"net/http"
 
 	// Import generated swagger docs.
+	"github.com/RizqiSugiarto/coding-test/config"
 	_ "github.com/RizqiSugiarto/coding-test/docs"
 	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/middleware"
 	"github.com/RizqiSugiarto/coding-test/internal/usecase"
 	"github.com/RizqiSugiarto/coding-test/pkg/jwt"
 	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/RizqiSugiarto/coding-test/pkg/ratelimit"
+	"github.com/RizqiSugiarto/coding-test/pkg/render"
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -31,8 +34,20 @@ func NewRouter(
 	categoryUc usecase.Category,
 	newsUc usecase.News,
 	customPageUc usecase.CustomPage,
+	redirectUc usecase.Redirect,
+	menuUc usecase.Menu,
+	mediaUc usecase.Media,
 	commentUc usecase.Comment,
+	feedUc usecase.Feed,
+	sitemapUc usecase.Sitemap,
+	translationUc usecase.Translation,
 	jwtManager jwt.Manager,
+	rateLimitStore ratelimit.Store,
+	rateLimitCfg config.RateLimit,
+	pageCfg config.Page,
+	mediaCfg config.Media,
+	renderer *render.Renderer,
+	renderCfg config.Render,
 ) {
 	// Options
 	handler.Use(gin.Logger())
@@ -43,16 +58,48 @@ func NewRouter(
 
 	handler.GET("/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
 
+	newMediaServer(handler, mediaUc, log)
+	newFeedRoutes(handler, feedUc, log)
+	newSitemapRoutes(handler, sitemapUc, log)
+
 	// Middleware
 	authMiddleware := middleware.AuthMiddleware(jwtManager)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(jwtManager)
+	loginRateLimit := middleware.RateLimitMiddleware(rateLimitStore, ratelimit.Policy{
+		Name:   "login",
+		Limit:  rateLimitCfg.LoginLimit,
+		Period: rateLimitCfg.LoginPeriod,
+	}, middleware.ByClientIP, log)
+	commentRateLimit := middleware.RateLimitMiddleware(rateLimitStore, ratelimit.Policy{
+		Name:   "comment",
+		Limit:  rateLimitCfg.CommentLimit,
+		Period: rateLimitCfg.CommentPeriod,
+	}, middleware.ByUserID, log)
 
 	// Routers
 	h := handler.Group("api/v1")
 	{
-		newAuthRoutes(h, authUc, log)
+		newAuthRoutes(h, authUc, log, loginRateLimit)
 		newCategoryRoutes(h, categoryUc, log, authMiddleware)
-		newNewsRoutes(h, newsUc, log, authMiddleware)
+		newNewsRoutes(h, newsUc, log, authMiddleware, optionalAuthMiddleware)
 		newCustomPageRoutes(h, customPageUc, log, authMiddleware)
-		newCommentRoutes(h, commentUc, log)
+		newRedirectRoutes(h, redirectUc, log, authMiddleware)
+		newMenuRoutes(h, menuUc, log, authMiddleware)
+		newMediaRoutes(h, mediaUc, log, mediaCfg.MaxSize, authMiddleware)
+		newCommentRoutes(h, commentUc, log, authMiddleware, optionalAuthMiddleware, commentRateLimit)
+		newTranslationRoutes(h, translationUc, log, authMiddleware)
 	}
+
+	// Unmatched paths: managed redirects first, then custom pages at their own URL, e.g. /about-us,
+	// rendered as HTML when a theme is loaded
+	fallback := []gin.HandlerFunc{newRedirectResolver(redirectUc, log)}
+
+	switch {
+	case renderer != nil:
+		fallback = append(fallback, newSiteRoutes(handler, renderer, newsUc, categoryUc, customPageUc, menuUc, renderCfg.Menu, log))
+	case pageCfg.ServeCustomURLs:
+		fallback = append(fallback, newCustomPageServer(customPageUc, log))
+	}
+
+	handler.NoRoute(fallback...)
 }
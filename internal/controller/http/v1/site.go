@@ -0,0 +1,211 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/RizqiSugiarto/coding-test/pkg/render"
+	"github.com/gin-gonic/gin"
+)
+
+// Views a theme provides for the rendered site.
+const (
+	_viewNews     = "news"
+	_viewCategory = "category"
+	_viewPage     = "page"
+	_viewNotFound = "not_found"
+)
+
+type siteRoutes struct {
+	renderer   *render.Renderer
+	news       usecase.News
+	category   usecase.Category
+	customPage usecase.CustomPage
+	menu       usecase.Menu
+	menuName   string
+	log        logger.Interface
+}
+
+// newSiteRoutes registers the HTML pages of the site and returns the handler
+// rendering custom pages at their own URL, for requests that match no other
+// route.
+func newSiteRoutes(
+	handler *gin.Engine,
+	renderer *render.Renderer,
+	news usecase.News,
+	category usecase.Category,
+	customPage usecase.CustomPage,
+	menu usecase.Menu,
+	menuName string,
+	log logger.Interface,
+) gin.HandlerFunc {
+	siteRouter := siteRoutes{renderer, news, category, customPage, menu, menuName, log}
+
+	handler.GET("/news/:id", siteRouter.News)
+	handler.GET("/categories/:id", siteRouter.Category)
+
+	return siteRouter.Page
+}
+
+// News renders a news article, or redirects to its translation in the
+// locale asked for.
+func (s *siteRoutes) News(ctx *gin.Context) {
+	locales := readerLocales(ctx, false)
+
+	news, err := s.news.GetByID(ctx, ctx.Param("id"), locales)
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			s.notFound(ctx)
+
+			return
+		}
+
+		s.fail(ctx, err, "SiteController - News - s.news.GetByID")
+
+		return
+	}
+
+	if locales != nil && s.redirectTranslation(ctx, "/news/"+news.ID) {
+		return
+	}
+
+	s.render(ctx, http.StatusOK, _viewNews, gin.H{
+		"News":   news,
+		"Locale": news.Locale,
+	})
+}
+
+// Category renders a category with its news, newest first, one translation
+// of each in the locale the reader prefers, or redirects to its translation
+// in the locale asked for.
+func (s *siteRoutes) Category(ctx *gin.Context) {
+	locales := readerLocales(ctx, false)
+
+	category, err := s.category.GetByID(ctx, ctx.Param("id"), locales)
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			s.notFound(ctx)
+
+			return
+		}
+
+		s.fail(ctx, err, "SiteController - Category - s.category.GetByID")
+
+		return
+	}
+
+	if locales != nil && s.redirectTranslation(ctx, "/categories/"+category.ID) {
+		return
+	}
+
+	newsList, err := s.news.GetByCategory(ctx, category.ID, readerLocales(ctx, true))
+	if err != nil {
+		s.fail(ctx, err, "SiteController - Category - s.news.GetByCategory")
+
+		return
+	}
+
+	s.render(ctx, http.StatusOK, _viewCategory, gin.H{
+		"Category": category,
+		"News":     newsList,
+		"Locale":   category.Locale,
+	})
+}
+
+// Page renders the custom page at the request path, or redirects to its
+// translation in the locale asked for. It backs the catch-all route, so
+// anything that is not a readable page renders the not found view.
+func (s *siteRoutes) Page(ctx *gin.Context) {
+	if ctx.Request.Method != http.MethodGet && ctx.Request.Method != http.MethodHead {
+		s.notFound(ctx)
+
+		return
+	}
+
+	locales := readerLocales(ctx, false)
+
+	page, err := s.customPage.GetByURL(ctx, ctx.Request.URL.EscapedPath(), locales)
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) || errors.Is(err, apperror.ErrInvalidPath) {
+			s.notFound(ctx)
+
+			return
+		}
+
+		s.fail(ctx, err, "SiteController - Page - s.customPage.GetByURL")
+
+		return
+	}
+
+	if locales != nil && s.redirectTranslation(ctx, page.CustomURL) {
+		return
+	}
+
+	s.render(ctx, http.StatusOK, _viewPage, gin.H{
+		"Page":   page,
+		"Locale": page.Locale,
+	})
+}
+
+// redirectTranslation redirects to path, keeping the query, when the
+// translation picked for the reader lives there rather than at the request
+// path, and reports whether it did.
+func (s *siteRoutes) redirectTranslation(ctx *gin.Context, path string) bool {
+	if path == ctx.Request.URL.Path {
+		return false
+	}
+
+	if query := ctx.Request.URL.RawQuery; query != "" {
+		path += "?" + query
+	}
+
+	ctx.Redirect(http.StatusFound, path)
+
+	return true
+}
+
+func (s *siteRoutes) notFound(ctx *gin.Context) {
+	s.render(ctx, http.StatusNotFound, _viewNotFound, gin.H{})
+}
+
+func (s *siteRoutes) fail(ctx *gin.Context, err error, msg string) {
+	s.log.Error(err, msg)
+	ctx.String(http.StatusInternalServerError, "Internal server error")
+}
+
+// render executes a view with the site menu added to data. A missing menu
+// renders the page without one.
+func (s *siteRoutes) render(ctx *gin.Context, status int, view string, data gin.H) {
+	data["Menu"] = s.siteMenu(ctx)
+
+	var buf bytes.Buffer
+	if err := s.renderer.Render(&buf, view, data); err != nil {
+		s.fail(ctx, err, "SiteController - render - s.renderer.Render")
+
+		return
+	}
+
+	ctx.Data(status, "text/html; charset=utf-8", buf.Bytes())
+}
+
+func (s *siteRoutes) siteMenu(ctx *gin.Context) *dto.MenuResponseDTO {
+	if s.menuName == "" {
+		return nil
+	}
+
+	menu, err := s.menu.GetByName(ctx, s.menuName)
+	if err != nil {
+		if !errors.Is(err, apperror.ErrNotFound) {
+			s.log.Error(err, "SiteController - siteMenu - s.menu.GetByName")
+		}
+
+		return nil
+	}
+
+	return menu
+}
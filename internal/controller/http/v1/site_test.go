@@ -0,0 +1,276 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testSiteNewsID     = "550e8400-e29b-41d4-a716-446655440040"
+	testSiteCategoryID = "550e8400-e29b-41d4-a716-446655440041"
+)
+
+// setupSiteRoutes renders with the default theme shipped with the app.
+func setupSiteRoutes(t *testing.T) (*siteRoutes, *MockNewsUseCase, *MockCategoryUseCase, *MockCustomPageUseCase, *MockMenuUseCase) {
+	t.Helper()
+
+	renderer, err := render.New(os.DirFS("../../../../themes/default"))
+	require.NoError(t, err)
+
+	mockNewsUseCase := new(MockNewsUseCase)
+	mockCategoryUseCase := new(MockCategoryUseCase)
+	mockCustomPageUseCase := new(MockCustomPageUseCase)
+	mockMenuUseCase := new(MockMenuUseCase)
+
+	siteRouter := &siteRoutes{
+		renderer:   renderer,
+		news:       mockNewsUseCase,
+		category:   mockCategoryUseCase,
+		customPage: mockCustomPageUseCase,
+		menu:       mockMenuUseCase,
+		menuName:   "main",
+		log:        new(MockLogger),
+	}
+
+	return siteRouter, mockNewsUseCase, mockCategoryUseCase, mockCustomPageUseCase, mockMenuUseCase
+}
+
+func TestSiteRoutes_News(t *testing.T) {
+	t.Run("success - render article with escaped content and menu", func(t *testing.T) {
+		// Arrange
+		siteRouter, mockNewsUseCase, _, _, mockMenuUseCase := setupSiteRoutes(t)
+
+		router := setupTestRouter()
+		router.GET("/news/:id", siteRouter.News)
+
+		// Mock expectations
+		mockNewsUseCase.On("GetByID", mock.Anything, testSiteNewsID, mock.Anything).Return(&dto.NewsResponseDTO{
+			ID:          testSiteNewsID,
+			CategoryID:  testSiteCategoryID,
+			Title:       "Launch <day>",
+			ContentHTML: "First paragraph\n\n<script>alert(1)</script>",
+			CreatedAt:   time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		}, nil)
+		mockMenuUseCase.On("GetByName", mock.Anything, "main").Return(&dto.MenuResponseDTO{
+			Name: "main",
+			Items: []dto.MenuItemResponseDTO{
+				{Type: "url", Title: "About", Href: "/about"},
+				{Type: "page", Title: "Gone", Broken: true},
+			},
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testSiteNewsID, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+
+		body := w.Body.String()
+		assert.Contains(t, body, "<title>Launch &lt;day&gt;</title>")
+		assert.Contains(t, body, "<p>First paragraph</p>")
+		assert.Contains(t, body, "&lt;script&gt;alert(1)&lt;/script&gt;")
+		assert.NotContains(t, body, "<script>")
+		assert.Contains(t, body, `<a href="/about">About</a>`)
+		assert.NotContains(t, body, "Gone")
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - render SEO metadata", func(t *testing.T) {
+		// Arrange
+		siteRouter, mockNewsUseCase, _, _, mockMenuUseCase := setupSiteRoutes(t)
+
+		router := setupTestRouter()
+		router.GET("/news/:id", siteRouter.News)
+
+		// Mock expectations
+		mockNewsUseCase.On("GetByID", mock.Anything, testSiteNewsID, mock.Anything).Return(&dto.NewsResponseDTO{
+			ID:               testSiteNewsID,
+			Title:            "Launch day",
+			FeaturedImageURL: "/media/abcd.jpg",
+			NewsMetadataDTO: dto.NewsMetadataDTO{
+				Excerpt:      "We launched",
+				MetaTitle:    "Launch day | Example",
+				CanonicalURL: "https://example.com/news/launch-day",
+				NoIndex:      true,
+			},
+		}, nil)
+		mockMenuUseCase.On("GetByName", mock.Anything, "main").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testSiteNewsID, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		body := w.Body.String()
+		assert.Contains(t, body, "<title>Launch day | Example</title>")
+		assert.Contains(t, body, `<meta name="description" content="We launched">`)
+		assert.Contains(t, body, `<link rel="canonical" href="https://example.com/news/launch-day">`)
+		assert.Contains(t, body, `<meta name="robots" content="noindex">`)
+		assert.Contains(t, body, `<meta property="og:title" content="Launch day | Example">`)
+		assert.Contains(t, body, `<meta property="og:image" content="/media/abcd.jpg">`)
+	})
+
+	t.Run("success - redirect to the translation asked for", func(t *testing.T) {
+		// Arrange
+		siteRouter, mockNewsUseCase, _, _, _ := setupSiteRoutes(t)
+
+		router := setupTestRouter()
+		router.GET("/news/:id", siteRouter.News)
+
+		translationID := "550e8400-e29b-41d4-a716-446655440042"
+
+		// Mock expectations
+		mockNewsUseCase.On("GetByID", mock.Anything, testSiteNewsID, []string{"id"}).Return(&dto.NewsResponseDTO{
+			ID:     translationID,
+			Locale: "id",
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testSiteNewsID+"?lang=id", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusFound, w.Code)
+		assert.Equal(t, "/news/"+translationID+"?lang=id", w.Header().Get("Location"))
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - news not found renders not found view", func(t *testing.T) {
+		// Arrange
+		siteRouter, mockNewsUseCase, _, _, mockMenuUseCase := setupSiteRoutes(t)
+
+		router := setupTestRouter()
+		router.GET("/news/:id", siteRouter.News)
+
+		// Mock expectations
+		mockNewsUseCase.On("GetByID", mock.Anything, testSiteNewsID, mock.Anything).Return(nil, apperror.ErrNotFound)
+		mockMenuUseCase.On("GetByName", mock.Anything, "main").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/news/"+testSiteNewsID, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Page not found")
+		assert.NotContains(t, w.Body.String(), "<nav>")
+
+		mockNewsUseCase.AssertExpectations(t)
+	})
+}
+
+func TestSiteRoutes_Category(t *testing.T) {
+	t.Run("success - render category listing", func(t *testing.T) {
+		// Arrange
+		siteRouter, mockNewsUseCase, mockCategoryUseCase, _, mockMenuUseCase := setupSiteRoutes(t)
+
+		router := setupTestRouter()
+		router.GET("/categories/:id", siteRouter.Category)
+
+		// Mock expectations
+		mockCategoryUseCase.On("GetByID", mock.Anything, testSiteCategoryID, mock.Anything).Return(&dto.CategoryResponseDTO{
+			ID:   testSiteCategoryID,
+			Name: "Technology",
+		}, nil)
+		mockNewsUseCase.On("GetByCategory", mock.Anything, testSiteCategoryID, mock.Anything).Return([]dto.NewsResponseDTO{
+			{ID: testSiteNewsID, Title: "Launch"},
+		}, nil)
+		mockMenuUseCase.On("GetByName", mock.Anything, "main").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/categories/"+testSiteCategoryID, nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "<h1>Technology</h1>")
+		assert.Contains(t, w.Body.String(), `<a href="/news/`+testSiteNewsID+`">Launch</a>`)
+
+		mockCategoryUseCase.AssertExpectations(t)
+		mockNewsUseCase.AssertExpectations(t)
+	})
+}
+
+func TestSiteRoutes_Page(t *testing.T) {
+	t.Run("success - render custom page at its URL", func(t *testing.T) {
+		// Arrange
+		siteRouter, _, _, mockCustomPageUseCase, mockMenuUseCase := setupSiteRoutes(t)
+
+		router := setupTestRouter()
+		router.NoRoute(siteRouter.Page)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, "/about/team", mock.Anything).Return(&dto.CustomPageResponseDTO{
+			CustomURL:   "/about/team",
+			ContentHTML: "Meet the team",
+			Breadcrumbs: []dto.PageBreadcrumbDTO{
+				{CustomURL: "/about"},
+				{CustomURL: "/about/team"},
+			},
+		}, nil)
+		mockMenuUseCase.On("GetByName", mock.Anything, "main").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/about/team", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "<p>Meet the team</p>")
+		assert.Contains(t, w.Body.String(), `<a href="/about">/about</a>`)
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - unknown path renders not found view", func(t *testing.T) {
+		// Arrange
+		siteRouter, _, _, mockCustomPageUseCase, mockMenuUseCase := setupSiteRoutes(t)
+
+		router := setupTestRouter()
+		router.NoRoute(siteRouter.Page)
+
+		// Mock expectations
+		mockCustomPageUseCase.On("GetByURL", mock.Anything, "/missing", mock.Anything).Return(nil, apperror.ErrNotFound)
+		mockMenuUseCase.On("GetByName", mock.Anything, "main").Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/missing", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Page not found")
+
+		mockCustomPageUseCase.AssertExpectations(t)
+	})
+}
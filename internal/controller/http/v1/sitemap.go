@@ -0,0 +1,189 @@
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/RizqiSugiarto/coding-test/pkg/sitemap"
+	"github.com/gin-gonic/gin"
+)
+
+// Extensions of sitemap files, served plain or gzipped.
+const (
+	_sitemapExt     = ".xml"
+	_sitemapGzipExt = ".gz"
+)
+
+// _gzipContentType is the content type of gzipped sitemap files.
+const _gzipContentType = "application/gzip"
+
+type sitemapRoutes struct {
+	sitemap usecase.Sitemap
+	log     logger.Interface
+}
+
+// newSitemapRoutes registers the public sitemap index and the sitemap files
+// it lists, e.g. /sitemaps/news-1.xml, each also served gzipped with a .gz
+// extension.
+func newSitemapRoutes(handler *gin.Engine, sitemapUc usecase.Sitemap, log logger.Interface) {
+	sitemapRouter := sitemapRoutes{sitemap: sitemapUc, log: log}
+
+	handler.GET("/sitemap.xml", sitemapRouter.Index)
+	handler.HEAD("/sitemap.xml", sitemapRouter.Index)
+	handler.GET("/sitemap.xml.gz", sitemapRouter.Index)
+	handler.HEAD("/sitemap.xml.gz", sitemapRouter.Index)
+	handler.GET("/sitemaps/:file", sitemapRouter.File)
+	handler.HEAD("/sitemaps/:file", sitemapRouter.File)
+}
+
+// Index serves the sitemap index.
+func (sr *sitemapRoutes) Index(ctx *gin.Context) {
+	files, err := sr.sitemap.Index(ctx)
+	if err != nil {
+		sr.fail(ctx, err, "SitemapController - Index - sr.sitemap.Index")
+
+		return
+	}
+
+	sitemaps := make([]sitemap.Sitemap, 0, len(files))
+	for _, file := range files {
+		sitemaps = append(sitemaps, sitemap.Sitemap{Loc: file.URL, LastMod: file.LastMod})
+	}
+
+	sr.serve(ctx, strings.HasSuffix(ctx.Request.URL.Path, _sitemapGzipExt), lastModified(files), func(w io.Writer) error {
+		return sitemap.WriteIndex(w, sitemaps)
+	})
+}
+
+// File serves a sitemap file listed in the index: a file of a section,
+// named by the section and its number, or the Google News sitemap.
+func (sr *sitemapRoutes) File(ctx *gin.Context) {
+	name, gzipped := strings.CutSuffix(ctx.Param("file"), _sitemapGzipExt)
+
+	name, ok := strings.CutSuffix(name, _sitemapExt)
+	if !ok {
+		ctx.Status(http.StatusNotFound)
+
+		return
+	}
+
+	if name == entity.SitemapGoogleNews {
+		sr.news(ctx, gzipped)
+
+		return
+	}
+
+	section, number, _ := strings.Cut(name, "-")
+
+	file, err := strconv.Atoi(number)
+	if err != nil {
+		ctx.Status(http.StatusNotFound)
+
+		return
+	}
+
+	urls, err := sr.sitemap.URLs(ctx, section, file)
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			ctx.Status(http.StatusNotFound)
+
+			return
+		}
+
+		sr.fail(ctx, err, "SitemapController - File - sr.sitemap.URLs")
+
+		return
+	}
+
+	entries := make([]sitemap.URL, 0, len(urls))
+	for _, u := range urls {
+		entries = append(entries, sitemap.URL{Loc: u.URL, LastMod: u.LastMod})
+	}
+
+	sr.serve(ctx, gzipped, lastModified(urls), func(w io.Writer) error {
+		return sitemap.WriteURLs(w, entries)
+	})
+}
+
+func (sr *sitemapRoutes) news(ctx *gin.Context, gzipped bool) {
+	result, err := sr.sitemap.News(ctx)
+	if err != nil {
+		sr.fail(ctx, err, "SitemapController - News - sr.sitemap.News")
+
+		return
+	}
+
+	var updated time.Time
+
+	articles := make([]sitemap.Article, 0, len(result.Articles))
+	for _, a := range result.Articles {
+		articles = append(articles, sitemap.Article{Loc: a.URL, Title: a.Title, Published: a.Published})
+
+		if a.Published.After(updated) {
+			updated = a.Published
+		}
+	}
+
+	pub := sitemap.Publication{Name: result.Publication, Language: result.Language}
+
+	sr.serve(ctx, gzipped, updated, func(w io.Writer) error {
+		return sitemap.WriteNews(w, pub, articles)
+	})
+}
+
+// serve writes a sitemap, gzipped when requested with the .gz extension.
+func (sr *sitemapRoutes) serve(ctx *gin.Context, gzipped bool, modTime time.Time, write func(io.Writer) error) {
+	var body bytes.Buffer
+
+	contentType := sitemap.ContentType
+	w := io.Writer(&body)
+
+	var zw *gzip.Writer
+	if gzipped {
+		contentType = _gzipContentType
+		zw = gzip.NewWriter(&body)
+		w = zw
+	}
+
+	err := write(w)
+	if err == nil && zw != nil {
+		err = zw.Close()
+	}
+
+	if err != nil {
+		sr.fail(ctx, err, "SitemapController - serve - write")
+
+		return
+	}
+
+	serveDocument(ctx, contentType, modTime, body.Bytes())
+}
+
+func (sr *sitemapRoutes) fail(ctx *gin.Context, err error, msg string) {
+	sr.log.Error(err, msg)
+	ctx.Status(http.StatusInternalServerError)
+}
+
+// lastModified is when the latest of urls changed, zero when unknown.
+func lastModified(urls []dto.SitemapURLDTO) time.Time {
+	var latest time.Time
+
+	for _, u := range urls {
+		if u.LastMod.After(latest) {
+			latest = u.LastMod
+		}
+	}
+
+	return latest
+}
@@ -0,0 +1,265 @@
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// MockSitemapUseCase is a mock implementation of usecase.Sitemap.
+type MockSitemapUseCase struct {
+	mock.Mock
+}
+
+func (m *MockSitemapUseCase) Index(ctx context.Context) ([]dto.SitemapURLDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.SitemapURLDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockSitemapUseCase) URLs(ctx context.Context, section string, file int) ([]dto.SitemapURLDTO, error) {
+	args := m.Called(ctx, section, file)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.SitemapURLDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockSitemapUseCase) News(ctx context.Context) (*dto.SitemapNewsDTO, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*dto.SitemapNewsDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func setupSitemapRouter(sitemapUseCase *MockSitemapUseCase, mockLogger *MockLogger) *gin.Engine {
+	router := setupTestRouter()
+	newSitemapRoutes(router, sitemapUseCase, mockLogger)
+
+	return router
+}
+
+func TestSitemapRoutes_Index(t *testing.T) {
+	lastMod := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	files := []dto.SitemapURLDTO{
+		{URL: "https://example.com/sitemaps/news-1.xml", LastMod: lastMod},
+		{URL: "https://example.com/sitemaps/google-news.xml"},
+	}
+
+	t.Run("success - sitemap index", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		router := setupSitemapRouter(mockSitemapUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockSitemapUseCase.On("Index", mock.Anything).Return(files, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/xml; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "Fri, 01 Mar 2024 12:00:00 GMT", w.Header().Get("Last-Modified"))
+		assert.Contains(t, w.Body.String(), "<loc>https://example.com/sitemaps/news-1.xml</loc>")
+		assert.Contains(t, w.Body.String(), "<lastmod>2024-03-01T12:00:00Z</lastmod>")
+		mockSitemapUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - gzipped sitemap index", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		router := setupSitemapRouter(mockSitemapUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockSitemapUseCase.On("Index", mock.Anything).Return(files, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/sitemap.xml.gz", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/gzip", w.Header().Get("Content-Type"))
+
+		zr, err := gzip.NewReader(bytes.NewReader(w.Body.Bytes()))
+		require.NoError(t, err)
+
+		body, err := io.ReadAll(zr)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "<sitemapindex")
+	})
+
+	t.Run("error - internal server error", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		mockLogger := new(MockLogger)
+		router := setupSitemapRouter(mockSitemapUseCase, mockLogger)
+
+		// Mock expectations
+		mockSitemapUseCase.On("Index", mock.Anything).Return(nil, apperror.ErrDatabaseConnection)
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/sitemap.xml", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		mockLogger.AssertExpectations(t)
+	})
+}
+
+func TestSitemapRoutes_File(t *testing.T) {
+	t.Run("success - file of a section", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		router := setupSitemapRouter(mockSitemapUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockSitemapUseCase.On("URLs", mock.Anything, "news", 2).Return([]dto.SitemapURLDTO{
+			{URL: "https://example.com/news/1?a=1&b=2", LastMod: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/sitemaps/news-2.xml", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "<urlset xmlns=\"http://www.sitemaps.org/schemas/sitemap/0.9\">")
+		assert.Contains(t, w.Body.String(), "<loc>https://example.com/news/1?a=1&amp;b=2</loc>")
+		mockSitemapUseCase.AssertExpectations(t)
+	})
+
+	t.Run("success - not modified for a matching ETag", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		router := setupSitemapRouter(mockSitemapUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockSitemapUseCase.On("URLs", mock.Anything, "pages", 1).
+			Return([]dto.SitemapURLDTO{{URL: "https://example.com/about-us"}}, nil)
+
+		first := httptest.NewRecorder()
+		router.ServeHTTP(first, httptest.NewRequest(http.MethodGet, "/sitemaps/pages-1.xml.gz", nil))
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/sitemaps/pages-1.xml.gz", nil)
+		req.Header.Set("If-None-Match", first.Header().Get("ETag"))
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotModified, w.Code)
+	})
+
+	t.Run("success - Google News sitemap", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		router := setupSitemapRouter(mockSitemapUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockSitemapUseCase.On("News", mock.Anything).Return(&dto.SitemapNewsDTO{
+			Publication: "Daily",
+			Language:    "en",
+			Articles: []dto.SitemapArticleDTO{
+				{URL: "https://example.com/news/1", Title: "Launch <day>", Published: time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/sitemaps/google-news.xml", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "<news:name>Daily</news:name>")
+		assert.Contains(t, w.Body.String(), "<news:title>Launch &lt;day&gt;</news:title>")
+		assert.Contains(t, w.Body.String(), "<news:publication_date>2024-03-01T00:00:00Z</news:publication_date>")
+		mockSitemapUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - file past the last", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		router := setupSitemapRouter(mockSitemapUseCase, new(MockLogger))
+
+		// Mock expectations
+		mockSitemapUseCase.On("URLs", mock.Anything, "news", 9).Return(nil, apperror.ErrNotFound)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/sitemaps/news-9.xml", nil)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		mockSitemapUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - malformed file name", func(t *testing.T) {
+		// Arrange
+		mockSitemapUseCase := new(MockSitemapUseCase)
+		router := setupSitemapRouter(mockSitemapUseCase, new(MockLogger))
+
+		for _, path := range []string{"/sitemaps/news-1.json", "/sitemaps/news.xml", "/sitemaps/news-one.xml"} {
+			// Act
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusNotFound, w.Code, path)
+		}
+
+		mockSitemapUseCase.AssertNotCalled(t, "URLs")
+	})
+}
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
+	"github.com/RizqiSugiarto/coding-test/internal/usecase"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/locale"
+	"github.com/RizqiSugiarto/coding-test/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+type translationRoutes struct {
+	translation usecase.Translation
+	log         logger.Interface
+}
+
+func newTranslationRoutes(handler *gin.RouterGroup, translation usecase.Translation, log logger.Interface, authMiddleware gin.HandlerFunc) {
+	translationRouter := translationRoutes{translation, log}
+
+	h := handler.Group("translations", authMiddleware)
+	{
+		// Protected endpoints - only authenticated users
+		h.GET("/missing", translationRouter.GetMissing)
+	}
+}
+
+// @Summary Get missing translations
+// @Description Retrieve news, categories and custom pages not yet translated into every supported locale, with the
+// @Description translations written so far and the locales still missing (requires authentication)
+// @Tags Translations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param type query string false "Type of content, every type when omitted" Enums(news, category, page)
+// @Success 200 {object} response.Response "Content missing translations"
+// @Failure 400 {object} response.ErrorResponse "Invalid type"
+// @Failure 401 {object} response.ErrorResponse "Unauthorized"
+// @Failure 500 {object} response.ErrorResponse "Internal server error"
+// @Router /translations/missing [get]
+func (tr *translationRoutes) GetMissing(ctx *gin.Context) {
+	missing, err := tr.translation.Missing(ctx, ctx.Query("type"))
+	if err != nil {
+		if errors.Is(err, apperror.ErrInvalidTranslationType) {
+			response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidType)
+
+			return
+		}
+
+		tr.log.Error(err, "TranslationController - GetMissing - tr.translation.Missing")
+		response.SendError(ctx, http.StatusInternalServerError, response.CodeInternalError)
+
+		return
+	}
+
+	response.SendSuccess(ctx, http.StatusOK, gin.H{
+		"translations": missing,
+	})
+}
+
+// readerLocales returns the locales a reader prefers, best first: those in
+// the comma separated lang query parameter or, for lists, the ones in the
+// Accept-Language header. Single items live at URLs of their own, so only
+// an explicit lang switches them to a translation. A lang of * asks for no
+// translation in particular.
+func readerLocales(ctx *gin.Context, list bool) []string {
+	if lang := ctx.Query("lang"); lang != "" {
+		var locales []string
+
+		for _, tag := range strings.Split(lang, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" && tag != "*" {
+				locales = append(locales, tag)
+			}
+		}
+
+		return locales
+	}
+
+	if !list {
+		return nil
+	}
+
+	response.VaryLanguage(ctx)
+
+	return locale.Parse(ctx.GetHeader("Accept-Language"))
+}
+
+// sendTranslationError responds to errors in the locale of submitted
+// content or the content it translates, and reports whether err was one of
+// them.
+func sendTranslationError(ctx *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, apperror.ErrUnsupportedLocale):
+		response.SendValidationError(ctx, http.StatusBadRequest, response.CodeUnsupportedLocale, []response.FieldError{
+			{Field: "locale", Code: response.FieldUnsupportedLocale},
+		})
+	case errors.Is(err, apperror.ErrInvalidTranslation):
+		response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidTranslation, []response.FieldError{
+			{Field: "translation_of", Code: response.FieldTranslationSource},
+		})
+	case errors.Is(err, apperror.ErrTranslationExists):
+		response.SendValidationError(ctx, http.StatusConflict, response.CodeTranslationExists, []response.FieldError{
+			{Field: "locale", Code: response.FieldTranslationTaken},
+		})
+	default:
+		return false
+	}
+
+	return true
+}
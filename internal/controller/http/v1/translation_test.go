@@ -0,0 +1,137 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockTranslationUseCase is a mock implementation of usecase.Translation.
+type MockTranslationUseCase struct {
+	mock.Mock
+}
+
+func (m *MockTranslationUseCase) Missing(ctx context.Context, contentType string) ([]dto.MissingTranslationDTO, error) {
+	args := m.Called(ctx, contentType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]dto.MissingTranslationDTO)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func TestTranslationRoutes_GetMissing(t *testing.T) {
+	t.Run("success - list content missing translations", func(t *testing.T) {
+		// Arrange
+		mockTranslationUseCase := new(MockTranslationUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		translationRouter := &translationRoutes{
+			translation: mockTranslationUseCase,
+			log:         mockLogger,
+		}
+
+		router.GET("/translations/missing", translationRouter.GetMissing)
+
+		// Mock expectations
+		mockTranslationUseCase.On("Missing", mock.Anything, "news").Return([]dto.MissingTranslationDTO{{
+			Type:             "news",
+			TranslationGroup: "550e8400-e29b-41d4-a716-446655440050",
+			Translations:     []dto.TranslationDTO{{ID: testNewsID, Locale: "en", Title: "Elections"}},
+			Missing:          []string{"id"},
+		}}, nil)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/translations/missing?type=news", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response struct {
+			Data struct {
+				Translations []dto.MissingTranslationDTO `json:"translations"`
+			} `json:"data"`
+		}
+
+		err := json.Unmarshal(w.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Data.Translations, 1)
+		assert.Equal(t, []string{"id"}, response.Data.Translations[0].Missing)
+
+		mockTranslationUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid type", func(t *testing.T) {
+		// Arrange
+		mockTranslationUseCase := new(MockTranslationUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		translationRouter := &translationRoutes{
+			translation: mockTranslationUseCase,
+			log:         mockLogger,
+		}
+
+		router.GET("/translations/missing", translationRouter.GetMissing)
+
+		// Mock expectations
+		mockTranslationUseCase.On("Missing", mock.Anything, "tags").Return(nil, apperror.ErrInvalidTranslationType)
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/translations/missing?type=tags", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		mockTranslationUseCase.AssertExpectations(t)
+	})
+
+	t.Run("error - internal server error", func(t *testing.T) {
+		// Arrange
+		mockTranslationUseCase := new(MockTranslationUseCase)
+		mockLogger := new(MockLogger)
+
+		router := setupTestRouter()
+		translationRouter := &translationRoutes{
+			translation: mockTranslationUseCase,
+			log:         mockLogger,
+		}
+
+		router.GET("/translations/missing", translationRouter.GetMissing)
+
+		// Mock expectations
+		mockTranslationUseCase.On("Missing", mock.Anything, "").Return(nil, apperror.ErrDatabaseConnection)
+		mockLogger.On("Error", mock.Anything, mock.Anything).Return()
+
+		// Act
+		req := httptest.NewRequest(http.MethodGet, "/translations/missing", http.NoBody)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		mockTranslationUseCase.AssertExpectations(t)
+		mockLogger.AssertExpectations(t)
+	})
+}
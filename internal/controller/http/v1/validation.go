@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/internal/controller/http/v1/response"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+// sendBindError responds to a request body that failed to bind into req,
+// listing the invalid fields when the body was well-formed JSON.
+func sendBindError(ctx *gin.Context, req interface{}, err error) {
+	errs := fieldErrors(req, err)
+	if len(errs) == 0 {
+		response.SendError(ctx, http.StatusBadRequest, response.CodeInvalidPayload)
+
+		return
+	}
+
+	response.SendValidationError(ctx, http.StatusBadRequest, response.CodeInvalidPayload, errs)
+}
+
+// fieldErrors converts validation errors from binding req into field-level
+// errors named after the JSON fields. It returns nil for other errors, such
+// as malformed JSON.
+func fieldErrors(req interface{}, err error) []response.FieldError {
+	var validationErrs validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		return nil
+	}
+
+	reqType := reflect.TypeOf(req)
+	for reqType.Kind() == reflect.Pointer {
+		reqType = reqType.Elem()
+	}
+
+	result := make([]response.FieldError, 0, len(validationErrs))
+
+	for _, fe := range validationErrs {
+		code, args := validationCode(fe)
+
+		result = append(result, response.FieldError{
+			Field: jsonFieldName(reqType, fe),
+			Code:  code,
+			Args:  args,
+		})
+	}
+
+	return result
+}
+
+// jsonFieldName names the field fe failed on by its JSON path, such as
+// items[0].children[1].type for nested structs and slices.
+func jsonFieldName(reqType reflect.Type, fe validator.FieldError) string {
+	// The namespace starts with the request type name, e.g. Menu.Items[0].Type
+	parts := strings.Split(fe.StructNamespace(), ".")[1:]
+	names := make([]string, 0, len(parts))
+	current := reqType
+
+	for _, part := range parts {
+		fieldName, index, _ := strings.Cut(part, "[")
+
+		for current.Kind() == reflect.Pointer || current.Kind() == reflect.Slice {
+			current = current.Elem()
+		}
+
+		if current.Kind() != reflect.Struct {
+			return fe.Field()
+		}
+
+		field, ok := current.FieldByName(fieldName)
+		if !ok {
+			return fe.Field()
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return fe.Field()
+		}
+
+		if index != "" {
+			name += "[" + index
+		}
+
+		names = append(names, name)
+		current = field.Type
+	}
+
+	if len(names) == 0 {
+		return fe.Field()
+	}
+
+	return strings.Join(names, ".")
+}
+
+// validationCode returns the field code of a failed validation and the
+// arguments of its message.
+func validationCode(fe validator.FieldError) (string, []interface{}) {
+	switch fe.Tag() {
+	case "required":
+		return response.FieldRequired, nil
+	case "max":
+		if isNumber(fe.Kind()) {
+			return response.FieldMax, []interface{}{fe.Param()}
+		}
+
+		return response.FieldMaxLength, []interface{}{fe.Param()}
+	case "min":
+		if isNumber(fe.Kind()) {
+			return response.FieldMin, []interface{}{fe.Param()}
+		}
+
+		return response.FieldMinLength, []interface{}{fe.Param()}
+	case "oneof":
+		return response.FieldOneOf, []interface{}{strings.ReplaceAll(fe.Param(), " ", ", ")}
+	case "required_if", "required_unless":
+		return response.FieldRequiredForType, nil
+	case "required_without":
+		return response.FieldRequiredWithout, []interface{}{strings.ToLower(fe.Param())}
+	case "uuid":
+		return response.FieldUUID, nil
+	default:
+		return response.FieldInvalid, nil
+	}
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// sitePathCode returns the field code of why the use case rejected a custom
+// URL or redirect source path.
+func sitePathCode(err error) (string, bool) {
+	switch {
+	case errors.Is(err, apperror.ErrInvalidPath):
+		return response.FieldInvalidPath, true
+	case errors.Is(err, apperror.ErrPathTooLong):
+		return response.FieldPathTooLong, true
+	case errors.Is(err, apperror.ErrReservedPath):
+		return response.FieldReservedPath, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+// visitorFingerprint identifies an anonymous visitor by hashing the client IP
+// together with the user agent, so raw addresses are never stored.
+func visitorFingerprint(ctx *gin.Context) string {
+	sum := sha256.Sum256([]byte(ctx.ClientIP() + "|" + ctx.Request.UserAgent()))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// voterID identifies the reader behind a reaction or vote: the signed in user
+// when authenticated, otherwise the anonymous visitor fingerprint.
+func voterID(ctx *gin.Context) string {
+	if userID := ctx.GetString("user_id"); userID != "" {
+		return "user:" + userID
+	}
+
+	return "visitor:" + visitorFingerprint(ctx)
+}
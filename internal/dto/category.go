@@ -2,17 +2,29 @@ package dto
 
 import "time"
 
+// CreateCategoryRequestDTO represents the request to create a category.
+// Locale defaults to the default locale when omitted; with TranslationOf,
+// the category is added as a translation of the category with that ID.
 type CreateCategoryRequestDTO struct {
-	Name string `json:"name" binding:"required"`
+	Name          string `json:"name" binding:"required"`
+	Locale        string `json:"locale"`
+	TranslationOf string `json:"translation_of"`
 }
 
+// UpdateCategoryRequestDTO represents the request to update a category. An
+// empty Locale keeps the current one.
 type UpdateCategoryRequestDTO struct {
-	Name string `json:"name" binding:"required"`
+	Name   string `json:"name" binding:"required"`
+	Locale string `json:"locale"`
 }
 
+// CategoryResponseDTO represents the category response. Translations of a
+// category share its TranslationGroup.
 type CategoryResponseDTO struct {
-	ID        string    `json:"id"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               string    `json:"id"`
+	Name             string    `json:"name"`
+	Locale           string    `json:"locale"`
+	TranslationGroup string    `json:"translation_group"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
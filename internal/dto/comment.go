@@ -1,7 +1,76 @@
 package dto
 
+import "time"
+
 type CreateCommentRequestDTO struct {
-	Name    string `json:"name"`
-	Comment string `json:"comment"`
-	NewsID  string `json:"news_id"`
+	Name        string `json:"name"`
+	Comment     string `json:"comment"`
+	NewsID      string `json:"news_id"`
+	ParentID    string `json:"parent_id"`
+	Fingerprint string `json:"-"`
+	Challenge   string `json:"challenge"`
+	Nonce       string `json:"nonce"`
+}
+
+// UpdateCommentRequestDTO carries an edit by the comment author, proven by
+// the edit token, or by a moderator.
+type UpdateCommentRequestDTO struct {
+	Comment   string `json:"comment"`
+	EditToken string `json:"-"`
+	Moderator bool   `json:"-"`
+}
+
+// DeleteCommentRequestDTO carries a deletion by the comment author or by a
+// moderator.
+type DeleteCommentRequestDTO struct {
+	EditToken string `json:"-"`
+	Moderator bool   `json:"-"`
+}
+
+// CommentResponseDTO represents a comment within a news thread. EditToken is
+// only returned once, when the comment is created.
+type CommentResponseDTO struct {
+	ID        string               `json:"id"`
+	NewsID    string               `json:"news_id"`
+	ParentID  string               `json:"parent_id,omitempty"`
+	Name      string               `json:"name"`
+	Comment   string               `json:"comment"`
+	Status    string               `json:"status"`
+	Depth     int                  `json:"depth"`
+	Path      string               `json:"path"`
+	CreatedAt time.Time            `json:"created_at"`
+	Upvotes   int                  `json:"upvotes"`
+	Downvotes int                  `json:"downvotes"`
+	Score     int                  `json:"score"`
+	EditToken string               `json:"edit_token,omitempty"`
+	Replies   []CommentResponseDTO `json:"replies,omitempty"`
+}
+
+// ReportCommentRequestDTO carries a reader's report of an abusive comment.
+type ReportCommentRequestDTO struct {
+	Reason string `json:"reason"`
+	Note   string `json:"note"`
+}
+
+// ReportedCommentDTO is a comment with open reports, shown to moderators.
+type ReportedCommentDTO struct {
+	Comment        CommentResponseDTO `json:"comment"`
+	Reports        int                `json:"reports"`
+	Reasons        []string           `json:"reasons"`
+	LastReportedAt time.Time          `json:"last_reported_at"`
+}
+
+// CommentVotesDTO is the vote tally of a comment.
+type CommentVotesDTO struct {
+	Upvotes   int `json:"upvotes"`
+	Downvotes int `json:"downvotes"`
+	Score     int `json:"score"`
+}
+
+// CommentChallengeDTO is a proof-of-work challenge to solve before commenting.
+type CommentChallengeDTO struct {
+	Challenge  string    `json:"challenge"`
+	Algorithm  string    `json:"algorithm"`
+	Difficulty int       `json:"difficulty"`
+	ExpiresAt  time.Time `json:"expires_at"`
 }
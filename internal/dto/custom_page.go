@@ -3,23 +3,75 @@ package dto
 import "time"
 
 // CreateCustomPageRequestDTO represents the request to create a custom page.
+// With a slug, the custom URL is derived from the parent's instead. Each
+// translation is a page of its own, at its own URL.
 type CreateCustomPageRequestDTO struct {
-	CustomURL string `json:"custom_url" binding:"required"`
+	ParentID  string `json:"parent_id"`
+	Position  int    `json:"position"`
+	Slug      string `json:"slug"`
+	CustomURL string `json:"custom_url"`
 	Content   string `json:"content" binding:"required"`
+
+	// ContentFormat defaults to html when omitted.
+	ContentFormat string `json:"content_format"`
+
+	// Locale defaults to the default locale when omitted. With
+	// TranslationOf, the page is added as a translation of the page with
+	// that ID.
+	Locale        string `json:"locale"`
+	TranslationOf string `json:"translation_of"`
 }
 
 // UpdateCustomPageRequestDTO represents the request to update a custom page.
+// With a slug, the custom URL is derived from the parent's instead.
 type UpdateCustomPageRequestDTO struct {
-	CustomURL string `json:"custom_url" binding:"required"`
+	ParentID  string `json:"parent_id"`
+	Position  int    `json:"position"`
+	Slug      string `json:"slug"`
+	CustomURL string `json:"custom_url"`
 	Content   string `json:"content" binding:"required"`
+
+	// ContentFormat defaults to html when omitted.
+	ContentFormat string `json:"content_format"`
+
+	// Locale keeps the current one when omitted.
+	Locale string `json:"locale"`
 }
 
 // CustomPageResponseDTO represents the response for a custom page.
+// Breadcrumbs run from the root page down to this one and are only set when
+// a single page is fetched. Content holds the source in ContentFormat;
+// ContentHTML and ContentText are the sanitized HTML and the plain text
+// rendered from it. Translations of a page share its TranslationGroup.
 type CustomPageResponseDTO struct {
-	ID        string    `json:"id"`
-	CustomURL string    `json:"custom_url"`
-	Content   string    `json:"content"`
-	AuthorID  string    `json:"author_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            string              `json:"id"`
+	ParentID      string              `json:"parent_id"`
+	Position      int                 `json:"position"`
+	Slug          string              `json:"slug"`
+	CustomURL     string              `json:"custom_url"`
+	Content       string              `json:"content"`
+	ContentFormat string              `json:"content_format"`
+	ContentHTML   string              `json:"-"`
+	ContentText   string              `json:"-"`
+	AuthorID      string              `json:"author_id"`
+	Breadcrumbs   []PageBreadcrumbDTO `json:"breadcrumbs,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at"`
+
+	Locale           string `json:"locale"`
+	TranslationGroup string `json:"translation_group"`
+}
+
+// PageBreadcrumbDTO represents a page on the path to the current one.
+type PageBreadcrumbDTO struct {
+	ID        string `json:"id"`
+	CustomURL string `json:"custom_url"`
+}
+
+// CustomPageTreeDTO represents a page and its children, without content.
+type CustomPageTreeDTO struct {
+	ID        string              `json:"id"`
+	CustomURL string              `json:"custom_url"`
+	Position  int                 `json:"position"`
+	Children  []CustomPageTreeDTO `json:"children"`
 }
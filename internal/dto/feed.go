@@ -0,0 +1,27 @@
+package dto
+
+import "time"
+
+// FeedDTO is a feed of the latest news, newest first. Link is the page the
+// feed is about and URL the address of the feed without its format
+// extension, e.g. https://example.com/feeds/news.
+type FeedDTO struct {
+	Title       string
+	Description string
+	Link        string
+	URL         string
+	Updated     time.Time
+	Items       []FeedItemDTO
+}
+
+// FeedItemDTO is an article in a feed. Summary is plain text and Content,
+// only set when feeds carry the full content, sanitized HTML.
+type FeedItemDTO struct {
+	ID        string
+	Title     string
+	Link      string
+	Summary   string
+	Content   string
+	Published time.Time
+	Updated   time.Time
+}
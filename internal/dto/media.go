@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"io"
+	"time"
+)
+
+// UploadMediaRequestDTO represents an uploaded file and its metadata.
+type UploadMediaRequestDTO struct {
+	Filename string `json:"filename"`
+	AltText  string `json:"alt_text"`
+	Data     []byte `json:"-"`
+}
+
+// UpdateMediaRequestDTO represents the request to update media metadata.
+type UpdateMediaRequestDTO struct {
+	AltText string  `json:"alt_text"`
+	FocalX  float64 `json:"focal_x"`
+	FocalY  float64 `json:"focal_y"`
+}
+
+// MediaResponseDTO represents the response for a media file. References are
+// only set when a single file is fetched, and Sizes and SrcSet only for
+// images that can be resized.
+type MediaResponseDTO struct {
+	ID             string                  `json:"id"`
+	URL            string                  `json:"url"`
+	Filename       string                  `json:"filename"`
+	MIMEType       string                  `json:"mime_type"`
+	Size           int64                   `json:"size"`
+	Width          int                     `json:"width"`
+	Height         int                     `json:"height"`
+	AltText        string                  `json:"alt_text"`
+	FocalX         float64                 `json:"focal_x"`
+	FocalY         float64                 `json:"focal_y"`
+	UploaderID     string                  `json:"uploader_id"`
+	ReferenceCount int                     `json:"reference_count"`
+	Sizes          map[string]MediaSizeDTO `json:"sizes,omitempty"`
+	SrcSet         string                  `json:"srcset,omitempty"`
+	References     []MediaReferenceDTO     `json:"references,omitempty"`
+	CreatedAt      time.Time               `json:"created_at"`
+	UpdatedAt      time.Time               `json:"updated_at"`
+}
+
+// MediaSizeDTO represents a derived size of an image.
+type MediaSizeDTO struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// MediaReferenceDTO represents content embedding a media file.
+type MediaReferenceDTO struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// MediaFileDTO is a stored file opened for reading. Size is -1 when it is
+// not known. The caller closes Content.
+type MediaFileDTO struct {
+	MIMEType string
+	Size     int64
+	Content  io.ReadCloser
+}
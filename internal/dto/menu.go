@@ -0,0 +1,46 @@
+package dto
+
+import "time"
+
+// SaveMenuRequestDTO represents the request to create or replace a menu.
+type SaveMenuRequestDTO struct {
+	Items []MenuItemRequestDTO `json:"items"`
+}
+
+// MenuItemRequestDTO represents a menu item and its children.
+type MenuItemRequestDTO struct {
+	Type     string               `json:"type"`
+	TargetID string               `json:"target_id"`
+	URL      string               `json:"url"`
+	Title    string               `json:"title"`
+	Children []MenuItemRequestDTO `json:"children"`
+}
+
+// MenuSummaryDTO represents a menu without its items.
+type MenuSummaryDTO struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// MenuResponseDTO represents the response for a resolved menu.
+type MenuResponseDTO struct {
+	ID        string                `json:"id"`
+	Name      string                `json:"name"`
+	Items     []MenuItemResponseDTO `json:"items"`
+	CreatedAt time.Time             `json:"created_at"`
+	UpdatedAt time.Time             `json:"updated_at"`
+}
+
+// MenuItemResponseDTO represents a resolved menu item. Broken items reference
+// a page, category or news article that no longer exists and have no href.
+type MenuItemResponseDTO struct {
+	ID       string                `json:"id"`
+	Type     string                `json:"type"`
+	TargetID string                `json:"target_id,omitempty"`
+	Title    string                `json:"title"`
+	Href     string                `json:"href"`
+	Broken   bool                  `json:"broken"`
+	Children []MenuItemResponseDTO `json:"children"`
+}
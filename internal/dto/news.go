@@ -2,11 +2,44 @@ package dto
 
 import "time"
 
+// NewsMetadataDTO is the featured image, excerpt, tags and metadata for
+// search engines and social sharing of a news article. Empty texts fall
+// back to the article's own title, content and URL.
+type NewsMetadataDTO struct {
+	FeaturedMediaID *string `json:"featured_media_id"`
+	Excerpt         string  `json:"excerpt"`
+	MetaTitle       string  `json:"meta_title"`
+	MetaDescription string  `json:"meta_description"`
+	CanonicalURL    string  `json:"canonical_url"`
+	OGTitle         string  `json:"og_title"`
+	OGDescription   string  `json:"og_description"`
+	OGImage         string  `json:"og_image"`
+	NoIndex         bool    `json:"noindex"`
+
+	// Tags are lowercased, with blanks and duplicates removed.
+	Tags []string `json:"tags"`
+}
+
 // CreateNewsRequestDTO represents the request to create news.
 type CreateNewsRequestDTO struct {
 	CategoryID string `json:"category_id" binding:"required"`
 	Title      string `json:"title" binding:"required"`
 	Content    string `json:"content" binding:"required"`
+
+	// ContentFormat defaults to html when omitted.
+	ContentFormat string `json:"content_format"`
+
+	// CommentsEnabled defaults to true when omitted.
+	CommentsEnabled *bool      `json:"comments_enabled"`
+	CommentsCloseAt *time.Time `json:"comments_close_at"`
+
+	// Locale defaults to the default locale when omitted. With
+	// TranslationOf, the news is added as a translation of the news with
+	// that ID.
+	Locale        string `json:"locale"`
+	TranslationOf string `json:"translation_of"`
+
+	NewsMetadataDTO
 }
 
 // UpdateNewsRequestDTO represents the request to update news.
@@ -14,15 +47,48 @@ type UpdateNewsRequestDTO struct {
 	CategoryID string `json:"category_id" binding:"required"`
 	Title      string `json:"title" binding:"required"`
 	Content    string `json:"content" binding:"required"`
+
+	// ContentFormat defaults to html when omitted.
+	ContentFormat string `json:"content_format"`
+
+	// CommentsEnabled and CommentsCloseAt keep the current ones when
+	// omitted. ClearCommentsCloseAt removes the closing time instead.
+	CommentsEnabled      *bool      `json:"comments_enabled"`
+	CommentsCloseAt      *time.Time `json:"comments_close_at"`
+	ClearCommentsCloseAt bool       `json:"-"`
+
+	// Locale keeps the current one when omitted.
+	Locale string `json:"locale"`
+
+	NewsMetadataDTO
 }
 
-// NewsResponseDTO represents the news response.
+// NewsResponseDTO represents the news response. Content holds the source
+// in ContentFormat; ContentHTML and ContentText are the sanitized HTML and
+// the plain text rendered from it. Excerpt is derived from the content when
+// none was written, and FeaturedImageURL is the URL of the featured image.
+// Translations of an article share its TranslationGroup.
 type NewsResponseDTO struct {
-	ID         string    `json:"id"`
-	CategoryID string    `json:"category_id"`
-	AuthorID   string    `json:"author_id"`
-	Title      string    `json:"title"`
-	Content    string    `json:"content"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID            string    `json:"id"`
+	CategoryID    string    `json:"category_id"`
+	AuthorID      string    `json:"author_id"`
+	Title         string    `json:"title"`
+	Content       string    `json:"content"`
+	ContentFormat string    `json:"content_format"`
+	ContentHTML   string    `json:"-"`
+	ContentText   string    `json:"-"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	CommentsEnabled bool       `json:"comments_enabled"`
+	CommentsCloseAt *time.Time `json:"comments_close_at"`
+
+	// Reactions counts every configured reaction, including those nobody left.
+	Reactions map[string]int `json:"reactions"`
+
+	Locale           string `json:"locale"`
+	TranslationGroup string `json:"translation_group"`
+
+	NewsMetadataDTO
+	FeaturedImageURL string `json:"featured_image_url,omitempty"`
 }
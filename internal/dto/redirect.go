@@ -0,0 +1,31 @@
+package dto
+
+import "time"
+
+// CreateRedirectRequestDTO represents the request to create a redirect.
+type CreateRedirectRequestDTO struct {
+	SourcePath string     `json:"source_path"`
+	Target     string     `json:"target"`
+	StatusCode int        `json:"status_code"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+// UpdateRedirectRequestDTO represents the request to update a redirect.
+type UpdateRedirectRequestDTO struct {
+	SourcePath string     `json:"source_path"`
+	Target     string     `json:"target"`
+	StatusCode int        `json:"status_code"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+}
+
+// RedirectResponseDTO represents the response for a redirect.
+type RedirectResponseDTO struct {
+	ID         string     `json:"id"`
+	SourcePath string     `json:"source_path"`
+	Target     string     `json:"target"`
+	StatusCode int        `json:"status_code"`
+	Hits       int64      `json:"hits"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+// SitemapURLDTO is a page listed in a sitemap, or a sitemap file listed in
+// the sitemap index. A zero LastMod is unknown.
+type SitemapURLDTO struct {
+	URL     string
+	LastMod time.Time
+}
+
+// SitemapNewsDTO is the Google News sitemap: the recent articles of a
+// publication, newest first.
+type SitemapNewsDTO struct {
+	Publication string
+	Language    string
+	Articles    []SitemapArticleDTO
+}
+
+// SitemapArticleDTO is an article listed in the Google News sitemap.
+type SitemapArticleDTO struct {
+	URL       string
+	Title     string
+	Published time.Time
+}
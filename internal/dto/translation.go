@@ -0,0 +1,19 @@
+package dto
+
+// TranslationDTO represents a variant of translated content. Title is the
+// title of news, the name of a category or the URL of a custom page.
+type TranslationDTO struct {
+	ID     string `json:"id"`
+	Locale string `json:"locale"`
+	Title  string `json:"title"`
+}
+
+// MissingTranslationDTO represents content of a Type that is not yet
+// translated into every supported locale: the variants written so far and
+// the locales still Missing.
+type MissingTranslationDTO struct {
+	Type             string           `json:"type"`
+	TranslationGroup string           `json:"translation_group"`
+	Translations     []TranslationDTO `json:"translations"`
+	Missing          []string         `json:"missing"`
+}
@@ -7,4 +7,7 @@ type Category struct {
 	Name      string    `json:"name"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+
+	Locale           string `json:"locale"`
+	TranslationGroup string `json:"translation_group"`
 }
@@ -2,10 +2,28 @@ package entity
 
 import "time"
 
+// Comment moderation statuses.
+const (
+	CommentStatusPending  = "pending"
+	CommentStatusApproved = "approved"
+	CommentStatusRejected = "rejected"
+	CommentStatusSpam     = "spam"
+)
+
 type Comment struct {
-	ID        string    `json:"id"`
-	NewsID    string    `json:"news_id"`
-	Name      string    `json:"name"`
-	Comment   string    `json:"comment"`
-	CreatedAt time.Time `json:"created_at"`
+	ID            string    `json:"id"`
+	NewsID        string    `json:"news_id"`
+	ParentID      string    `json:"parent_id"`
+	Name          string    `json:"name"`
+	Comment       string    `json:"comment"`
+	Status        string    `json:"status"`
+	Fingerprint   string    `json:"fingerprint"`
+	EditTokenHash string    `json:"-"`
+	TrainedClass  string    `json:"-"`
+	TrainedText   string    `json:"-"`
+	Depth         int       `json:"depth"`
+	Path          string    `json:"path"`
+	CreatedAt     time.Time `json:"created_at"`
+
+	Votes CommentVotes `json:"votes"`
 }
@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+// Reasons a reader can give when reporting a comment.
+const (
+	CommentReportSpam       = "spam"
+	CommentReportAbuse      = "abuse"
+	CommentReportHarassment = "harassment"
+	CommentReportOffTopic   = "off_topic"
+	CommentReportOther      = "other"
+)
+
+// CommentReport is a reader's report of an abusive comment.
+type CommentReport struct {
+	CommentID string    `json:"comment_id"`
+	Reporter  string    `json:"-"`
+	Reason    string    `json:"reason"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ReportedComment is a comment with open reports, summarised for moderators.
+type ReportedComment struct {
+	Comment        Comment   `json:"comment"`
+	Reports        int       `json:"reports"`
+	Reasons        []string  `json:"reasons"`
+	LastReportedAt time.Time `json:"last_reported_at"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+import "time"
+
+// Types of content rendered from a source format.
+const (
+	ContentNews = "news"
+	ContentPage = "page"
+)
+
+// StoredContent is the content of a news article or custom page as stored:
+// its source in Format along with the HTML and plain text rendered from it.
+type StoredContent struct {
+	ID       string
+	AuthorID string
+	Content  string
+	Format   string
+	HTML     string
+	Text     string
+}
+
+// ContentRenderState records whether stored content was sanitized when it
+// was last rendered as a whole.
+type ContentRenderState struct {
+	Sanitized bool      `json:"sanitized"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
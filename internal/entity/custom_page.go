@@ -2,12 +2,33 @@ package entity
 
 import "time"
 
-// CustomPage represents a custom page in the system.
+// CustomPage represents a custom page in the system. Pages form a tree
+// through ParentID, ordered by Position among their siblings. A page with a
+// Slug has its CustomURL derived from its parent's. Content is kept in its
+// ContentFormat, with ContentHTML and ContentText rendered from it when it is
+// written. Variants of the page in other locales share its TranslationGroup,
+// each at a URL of its own.
 type CustomPage struct {
-	ID        string    `json:"id"`
-	CustomURL string    `json:"custom_url"`
-	Content   string    `json:"content"`
-	AuthorID  string    `json:"author_id"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID            string    `json:"id"`
+	ParentID      string    `json:"parent_id"`
+	Position      int       `json:"position"`
+	Slug          string    `json:"slug"`
+	CustomURL     string    `json:"custom_url"`
+	Content       string    `json:"content"`
+	ContentFormat string    `json:"content_format"`
+	ContentHTML   string    `json:"content_html"`
+	ContentText   string    `json:"content_text"`
+	AuthorID      string    `json:"author_id"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	Locale           string `json:"locale"`
+	TranslationGroup string `json:"translation_group"`
+}
+
+// PageMove is a page moving from one custom URL to another.
+type PageMove struct {
+	ID   string
+	From string
+	To   string
 }
@@ -0,0 +1,38 @@
+package entity
+
+import "time"
+
+// Kinds of content that reference media.
+const (
+	MediaOwnerNews = "news"
+	MediaOwnerPage = "page"
+)
+
+// Media is an uploaded file in the media library. Files are stored under a
+// key derived from their content, so an identical upload is the same media.
+// Width and Height are zero for files that are not images. FocalX and FocalY
+// locate the point kept in view when an image is cropped, as fractions of
+// its width and height.
+type Media struct {
+	ID             string    `json:"id"`
+	StorageKey     string    `json:"storage_key"`
+	Filename       string    `json:"filename"`
+	MIMEType       string    `json:"mime_type"`
+	Size           int64     `json:"size"`
+	Width          int       `json:"width"`
+	Height         int       `json:"height"`
+	AltText        string    `json:"alt_text"`
+	FocalX         float64   `json:"focal_x"`
+	FocalY         float64   `json:"focal_y"`
+	UploaderID     string    `json:"uploader_id"`
+	ReferenceCount int       `json:"reference_count"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// MediaReference is a news article or custom page whose content embeds a
+// media file.
+type MediaReference struct {
+	OwnerType string `json:"owner_type"`
+	OwnerID   string `json:"owner_id"`
+}
@@ -0,0 +1,39 @@
+package entity
+
+import "time"
+
+// Menu item types.
+const (
+	MenuItemPage     = "page"
+	MenuItemCategory = "category"
+	MenuItemNews     = "news"
+	MenuItemURL      = "url"
+)
+
+// Menu is a named navigation menu.
+type Menu struct {
+	ID        string     `json:"id"`
+	Name      string     `json:"name"`
+	Items     []MenuItem `json:"items"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// MenuItem links to a custom page, a category, a news article or a URL.
+// Items are saved as a tree through Children and loaded as a flat list
+// ordered by Position, linked through ParentID.
+type MenuItem struct {
+	ID       string     `json:"id"`
+	ParentID string     `json:"parent_id"`
+	Position int        `json:"position"`
+	Type     string     `json:"type"`
+	TargetID string     `json:"target_id"`
+	URL      string     `json:"url"`
+	Title    string     `json:"title"`
+	Children []MenuItem `json:"children,omitempty"`
+
+	// Resolved from the referenced page, category or news article on load.
+	TargetExists bool   `json:"-"`
+	TargetTitle  string `json:"-"`
+	TargetPath   string `json:"-"`
+}
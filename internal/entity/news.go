@@ -2,13 +2,39 @@ package entity
 
 import "time"
 
-// News represents a news article in the system.
+// News represents a news article in the system. Content is kept in its
+// ContentFormat, with ContentHTML and ContentText rendered from it when it is
+// written. FeaturedMediaKey is the storage key of the featured image, read
+// along with the article. Tags are lowercase. Variants of the article in
+// other locales share its TranslationGroup.
 type News struct {
-	ID         string    `json:"id"`
-	CategoryID string    `json:"category_id"`
-	AuthorID   string    `json:"author_id"`
-	Title      string    `json:"title"`
-	Content    string    `json:"content"`
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID            string    `json:"id"`
+	CategoryID    string    `json:"category_id"`
+	AuthorID      string    `json:"author_id"`
+	Title         string    `json:"title"`
+	Content       string    `json:"content"`
+	ContentFormat string    `json:"content_format"`
+	ContentHTML   string    `json:"content_html"`
+	ContentText   string    `json:"content_text"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	CommentsEnabled bool       `json:"comments_enabled"`
+	CommentsCloseAt *time.Time `json:"comments_close_at"`
+
+	FeaturedMediaID  *string `json:"featured_media_id"`
+	FeaturedMediaKey string  `json:"-"`
+	Excerpt          string  `json:"excerpt"`
+	MetaTitle        string  `json:"meta_title"`
+	MetaDescription  string  `json:"meta_description"`
+	CanonicalURL     string  `json:"canonical_url"`
+	OGTitle          string  `json:"og_title"`
+	OGDescription    string  `json:"og_description"`
+	OGImage          string  `json:"og_image"`
+	NoIndex          bool    `json:"noindex"`
+
+	Tags []string `json:"tags"`
+
+	Locale           string `json:"locale"`
+	TranslationGroup string `json:"translation_group"`
 }
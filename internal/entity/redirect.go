@@ -0,0 +1,20 @@
+package entity
+
+import "time"
+
+// Redirect sends requests for a moved path to its new location.
+type Redirect struct {
+	ID         string     `json:"id"`
+	SourcePath string     `json:"source_path"`
+	Target     string     `json:"target"`
+	StatusCode int        `json:"status_code"`
+	Hits       int64      `json:"hits"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// Expired reports whether the redirect stopped applying at or before now.
+func (r *Redirect) Expired(now time.Time) bool {
+	return r.ExpiresAt != nil && !now.Before(*r.ExpiresAt)
+}
@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+// Sitemap sections, each listed in sitemap files of its own.
+const (
+	SitemapNews       = "news"
+	SitemapCategories = "categories"
+	SitemapPages      = "pages"
+)
+
+// SitemapGoogleNews names the Google News sitemap file, listed next to the
+// files of the sections.
+const SitemapGoogleNews = "google-news"
+
+// SitemapEntry is a page listed in a sitemap: a news article or category,
+// addressed by its ID, or a custom page, at its URL.
+type SitemapEntry struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	Title     string    `json:"title"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
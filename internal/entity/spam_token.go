@@ -0,0 +1,26 @@
+package entity
+
+// SpamTokenDocuments is the reserved token holding the number of trained
+// comments per class. Tokenizers never emit underscores, so it cannot
+// collide with a real word.
+const SpamTokenDocuments = "__documents__"
+
+// Classes a comment can be trained as.
+const (
+	SpamClassSpam = "spam"
+	SpamClassHam  = "ham"
+)
+
+type SpamToken struct {
+	Token     string `json:"token"`
+	SpamCount int    `json:"spam_count"`
+	HamCount  int    `json:"ham_count"`
+}
+
+// SpamTraining is what a comment teaches the spam filter: the class its text
+// is counted in, empty for none, along with the text and its tokens.
+type SpamTraining struct {
+	Class  string
+	Text   string
+	Tokens []string
+}
@@ -0,0 +1,19 @@
+package entity
+
+// Types of translated content. The variants of an item in other locales are
+// items of the same type sharing its translation group.
+const (
+	TranslationNews     = "news"
+	TranslationCategory = "category"
+	TranslationPage     = "page"
+)
+
+// Translation is a variant of translated content in its Locale, linked to
+// the others by their Group. Title names it in listings: the title of news,
+// the name of a category or the URL of a custom page.
+type Translation struct {
+	ID     string `json:"id"`
+	Locale string `json:"locale"`
+	Group  string `json:"group"`
+	Title  string `json:"title"`
+}
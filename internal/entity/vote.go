@@ -0,0 +1,12 @@
+package entity
+
+// CommentVotes holds the number of up and down votes of a comment.
+type CommentVotes struct {
+	Upvotes   int `json:"upvotes"`
+	Downvotes int `json:"downvotes"`
+}
+
+// Score is the net vote count used to rank comments.
+func (v CommentVotes) Score() int {
+	return v.Upvotes - v.Downvotes
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 )
@@ -23,6 +25,8 @@ type NewsRepo interface {
 	Create(ctx context.Context, news *entity.News) (*entity.News, error)
 	GetByID(ctx context.Context, id string) (*entity.News, error)
 	GetAll(ctx context.Context) ([]entity.News, error)
+	GetByCategory(ctx context.Context, categoryID string) ([]entity.News, error)
+	GetLatest(ctx context.Context, categoryID, tag string, limit int) ([]entity.News, error)
 	Update(ctx context.Context, news *entity.News) error
 	Delete(ctx context.Context, id string) error
 }
@@ -30,11 +34,108 @@ type NewsRepo interface {
 type CustomPageRepo interface {
 	Create(ctx context.Context, page *entity.CustomPage) (*entity.CustomPage, error)
 	GetByID(ctx context.Context, id string) (*entity.CustomPage, error)
+	GetByURL(ctx context.Context, customURL string) (*entity.CustomPage, error)
 	GetAll(ctx context.Context) ([]entity.CustomPage, error)
+	GetChildren(ctx context.Context, parentID string) ([]entity.CustomPage, error)
+	GetAncestors(ctx context.Context, id string) ([]entity.CustomPage, error)
 	Update(ctx context.Context, page *entity.CustomPage) error
+	Move(ctx context.Context, page *entity.CustomPage, moves []entity.PageMove) error
 	Delete(ctx context.Context, id string) error
 }
 
+type ContentRepo interface {
+	GetRenderState(ctx context.Context) (*entity.ContentRenderState, error)
+	SetRenderState(ctx context.Context, sanitized bool) error
+	GetAfter(ctx context.Context, contentType, afterID string, limit int) ([]entity.StoredContent, error)
+	UpdateRendered(ctx context.Context, contentType string, content entity.StoredContent) error
+}
+
+type SitemapRepo interface {
+	GetFiles(ctx context.Context, section string, perFile int) ([]time.Time, error)
+	GetEntries(ctx context.Context, section string, offset, limit int) ([]entity.SitemapEntry, error)
+	GetNewsSince(ctx context.Context, since time.Time, limit int) ([]entity.SitemapEntry, error)
+}
+
+type TranslationRepo interface {
+	GetByType(ctx context.Context, contentType string) ([]entity.Translation, error)
+	GetGroup(ctx context.Context, contentType, id string) ([]entity.Translation, error)
+}
+
 type CommentRepo interface {
-	Create(ctx context.Context, comment *entity.Comment) error
+	Create(ctx context.Context, comment *entity.Comment) (*entity.Comment, error)
+	GetByID(ctx context.Context, id string) (*entity.Comment, error)
+	GetByNewsID(ctx context.Context, newsID, status string) ([]entity.Comment, error)
+	GetByStatus(ctx context.Context, status string, limit, offset int) ([]entity.Comment, error)
+	UpdateStatus(ctx context.Context, ids []string, status string) (int64, error)
+	HasApprovedByFingerprint(ctx context.Context, fingerprint string) (bool, error)
+	GetByIDs(ctx context.Context, ids []string) ([]entity.Comment, error)
+	CountRecentByContent(ctx context.Context, content string, since time.Time, excludeID string) (int, error)
+	Update(ctx context.Context, comment *entity.Comment) error
+	Delete(ctx context.Context, id string) error
+}
+
+type SpamTokenRepo interface {
+	GetByTokens(ctx context.Context, tokens []string) (map[string]entity.SpamToken, error)
+	Retrain(ctx context.Context, commentID string, from, to entity.SpamTraining) error
+}
+
+type NewsReactionRepo interface {
+	Add(ctx context.Context, newsID, voter, reaction string) error
+	Remove(ctx context.Context, newsID, voter, reaction string) error
+	CountByNewsIDs(ctx context.Context, newsIDs []string) (map[string]map[string]int, error)
+}
+
+type CommentVoteRepo interface {
+	Vote(ctx context.Context, commentID, voter string, value int) error
+	CountByCommentIDs(ctx context.Context, commentIDs []string) (map[string]entity.CommentVotes, error)
+}
+
+type CommentReportRepo interface {
+	Add(ctx context.Context, report *entity.CommentReport) error
+	CountByCommentID(ctx context.Context, commentID string) (int, error)
+	GetReported(ctx context.Context, limit, offset int) ([]entity.ReportedComment, error)
+	DeleteByCommentIDs(ctx context.Context, commentIDs []string) error
+}
+
+type ChallengeRepo interface {
+	Redeem(ctx context.Context, id string, expiresAt time.Time) error
+}
+
+type RedirectRepo interface {
+	Create(ctx context.Context, redirect *entity.Redirect) (*entity.Redirect, error)
+	GetByID(ctx context.Context, id string) (*entity.Redirect, error)
+	GetBySource(ctx context.Context, sourcePath string) (*entity.Redirect, error)
+	GetAll(ctx context.Context) ([]entity.Redirect, error)
+	Update(ctx context.Context, redirect *entity.Redirect) error
+	Upsert(ctx context.Context, redirect *entity.Redirect) error
+	Retarget(ctx context.Context, from, to string) error
+	RecordHit(ctx context.Context, id string) error
+	Delete(ctx context.Context, id string) error
+	DeleteBySource(ctx context.Context, sourcePath string) error
+}
+
+type MenuRepo interface {
+	GetAll(ctx context.Context) ([]entity.Menu, error)
+	GetByName(ctx context.Context, name string) (*entity.Menu, error)
+	Save(ctx context.Context, menu *entity.Menu) (*entity.Menu, error)
+	Delete(ctx context.Context, name string) error
+}
+
+type MediaRepo interface {
+	Create(ctx context.Context, media *entity.Media) (*entity.Media, error)
+	GetByID(ctx context.Context, id string) (*entity.Media, error)
+	GetByKey(ctx context.Context, storageKey string) (*entity.Media, error)
+	GetAll(ctx context.Context) ([]entity.Media, error)
+	Update(ctx context.Context, media *entity.Media) error
+	Delete(ctx context.Context, id string) error
+	GetReferences(ctx context.Context, id string) ([]entity.MediaReference, error)
+	SetReferences(ctx context.Context, ownerType, ownerID string, storageKeys []string) error
+}
+
+// Storage holds media file content by key. Get returns apperror.ErrNotFound
+// for unknown keys, and deleting an unknown key is not an error.
+type Storage interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
 }
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"errors"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/lib/pq"
+)
+
+// uniqueViolation is the Postgres error code raised when an insert or update
+// breaks a unique constraint.
+const uniqueViolation pq.ErrorCode = "23505"
+
+// foreignKeyViolation is the Postgres error code raised when a row still
+// referenced by another table is deleted.
+const foreignKeyViolation pq.ErrorCode = "23503"
+
+// mapError translates Postgres errors the application reacts to into their
+// apperror counterpart and passes anything else through unchanged.
+func mapError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return apperror.ErrDuplicateKey
+	}
+
+	return err
+}
@@ -0,0 +1,19 @@
+package postgres
+
+import "github.com/Masterminds/squirrel"
+
+// nullString maps an empty string to a SQL NULL so optional references such
+// as a parent comment ID are not stored as invalid UUIDs.
+func nullString(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+// translationGroup is the translation group a new row joins: the given one,
+// or a new group of its own when empty.
+func translationGroup(group string) squirrel.Sqlizer {
+	return squirrel.Expr("COALESCE(?::uuid, uuid_generate_v4())", nullString(group))
+}
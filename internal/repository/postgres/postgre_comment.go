@@ -2,12 +2,31 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
 	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
 )
 
+// commentThreadCTE walks the reply tree of a news article starting from its
+// top-level comments, building a slash separated path of ancestor IDs. Only
+// comments in the requested status are followed, so replies to hidden
+// comments are hidden as well.
+const commentThreadCTE = `WITH RECURSIVE thread AS (
+	SELECT id, news_id, parent_id, name, comment, status, depth, created_at, id::text AS path
+	FROM comments
+	WHERE news_id = ? AND parent_id IS NULL AND status = ?
+	UNION ALL
+	SELECT c.id, c.news_id, c.parent_id, c.name, c.comment, c.status, c.depth, c.created_at, t.path || '/' || c.id::text
+	FROM comments c
+	JOIN thread t ON c.parent_id = t.id
+	WHERE c.status = ?
+)`
+
 type CommentRepo struct {
 	*postgres.Postgres
 }
@@ -16,37 +35,363 @@ func NewPostgresCommentRepo(pg *postgres.Postgres) *CommentRepo {
 	return &CommentRepo{pg}
 }
 
-func (c *CommentRepo) Create(ctx context.Context, comment *entity.Comment) error {
+func (c *CommentRepo) Create(ctx context.Context, comment *entity.Comment) (*entity.Comment, error) {
 	// Check if news exists
+	exists, err := c.newsExists(ctx, comment.NewsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, apperror.ErrNotFound
+	}
+
+	// Insert comment
+	query, args, err := c.Builder.Insert("comments").
+		Columns("name, news_id, comment, parent_id, depth, status, fingerprint, edit_token_hash").
+		Values(
+			comment.Name,
+			comment.NewsID,
+			comment.Comment,
+			nullString(comment.ParentID),
+			comment.Depth,
+			comment.Status,
+			comment.Fingerprint,
+			comment.EditTokenHash,
+		).
+		Suffix("RETURNING id, created_at").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	result := *comment
+
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&result.ID, &result.CreatedAt)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &result, nil
+}
+
+// GetByID returns a comment in any moderation status, including its
+// fingerprint and edit token hash.
+func (c *CommentRepo) GetByID(ctx context.Context, id string) (*entity.Comment, error) {
+	query, args, err := c.Builder.
+		Select("id", "news_id", "parent_id", "name", "comment", "status", "fingerprint", "edit_token_hash", "depth", "created_at").
+		From("comments").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		comment  entity.Comment
+		parentID sql.NullString
+	)
+
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(
+		&comment.ID,
+		&comment.NewsID,
+		&parentID,
+		&comment.Name,
+		&comment.Comment,
+		&comment.Status,
+		&comment.Fingerprint,
+		&comment.EditTokenHash,
+		&comment.Depth,
+		&comment.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	comment.ParentID = parentID.String
+
+	return &comment, nil
+}
+
+// GetByNewsID returns the comments of a news article in the given status,
+// parents before their replies, with Path holding the IDs from the top-level
+// comment down.
+func (c *CommentRepo) GetByNewsID(ctx context.Context, newsID, status string) ([]entity.Comment, error) {
+	exists, err := c.newsExists(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, apperror.ErrNotFound
+	}
+
+	query, args, err := c.Builder.
+		Select("id", "news_id", "parent_id", "name", "comment", "status", "depth", "path", "created_at").
+		Prefix(commentThreadCTE, newsID, status, status).
+		From("thread").
+		OrderBy("depth ASC", "created_at ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.queryComments(ctx, query, args, true)
+}
+
+// GetByStatus returns a page of up to limit comments in the given status,
+// oldest first.
+func (c *CommentRepo) GetByStatus(ctx context.Context, status string, limit, offset int) ([]entity.Comment, error) {
+	query, args, err := c.Builder.
+		Select("id", "news_id", "parent_id", "name", "comment", "status", "depth", "created_at").
+		From("comments").
+		Where(squirrel.Eq{"status": status}).
+		OrderBy("created_at ASC", "id ASC").
+		Limit(uint64(max(limit, 0))).
+		Offset(uint64(max(offset, 0))).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.queryComments(ctx, query, args, false)
+}
+
+// UpdateStatus moves the given comments to a new moderation status and
+// returns how many comments were changed.
+func (c *CommentRepo) UpdateStatus(ctx context.Context, ids []string, status string) (int64, error) {
+	query, args, err := c.Builder.
+		Update("comments").
+		Set("status", status).
+		Set("moderated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": ids}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := c.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// HasApprovedByFingerprint reports whether the visitor behind fingerprint
+// already has an approved comment.
+func (c *CommentRepo) HasApprovedByFingerprint(ctx context.Context, fingerprint string) (bool, error) {
 	var exists bool
 
-	checkSQL, _, err := c.Builder.Select("EXISTS(SELECT 1 FROM news WHERE id = ?)").ToSql()
+	query, args, err := c.Builder.
+		Select().
+		Column(squirrel.Expr("EXISTS(SELECT 1 FROM comments WHERE fingerprint = ? AND status = ?)", fingerprint, entity.CommentStatusApproved)).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+// GetByIDs returns the given comments in any moderation status, along with
+// what each one taught the spam filter.
+func (c *CommentRepo) GetByIDs(ctx context.Context, ids []string) ([]entity.Comment, error) {
+	query, args, err := c.Builder.
+		Select("id", "news_id", "parent_id", "name", "comment", "status", "depth", "created_at", "trained_class", "trained_text").
+		From("comments").
+		Where(squirrel.Eq{"id": ids}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]entity.Comment, 0, len(ids))
+
+	for rows.Next() {
+		var (
+			comment  entity.Comment
+			parentID sql.NullString
+		)
+
+		err = rows.Scan(&comment.ID, &comment.NewsID, &parentID, &comment.Name, &comment.Comment, &comment.Status,
+			&comment.Depth, &comment.CreatedAt, &comment.TrainedClass, &comment.TrainedText)
+		if err != nil {
+			return nil, err
+		}
+
+		comment.ParentID = parentID.String
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+// CountRecentByContent counts comments posted since the given time whose
+// text matches content, ignoring case and surrounding whitespace. The
+// comment with the ID excludeID, when set, is left out, so an edit does not
+// match the text it replaces.
+func (c *CommentRepo) CountRecentByContent(ctx context.Context, content string, since time.Time, excludeID string) (int, error) {
+	var count int
+
+	builder := c.Builder.
+		Select("COUNT(*)").
+		From("comments").
+		Where("md5(lower(btrim(comment))) = md5(lower(btrim(?)))", content).
+		Where(squirrel.GtOrEq{"created_at": since})
+
+	if excludeID != "" {
+		builder = builder.Where(squirrel.NotEq{"id": excludeID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Update saves the text and moderation status of a comment.
+func (c *CommentRepo) Update(ctx context.Context, comment *entity.Comment) error {
+	query, args, err := c.Builder.
+		Update("comments").
+		Set("comment", comment.Comment).
+		Set("status", comment.Status).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": comment.ID}).
+		ToSql()
 	if err != nil {
 		return err
 	}
 
-	err = c.DB.QueryRowContext(ctx, checkSQL, comment.NewsID).Scan(&exists)
+	result, err := c.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return mapError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
 
-	if !exists {
+	if rowsAffected == 0 {
 		return apperror.ErrNotFound
 	}
 
-	// Insert comment
-	sql, args, err := c.Builder.Insert("comments").
-		Columns("name, news_id, comment").
-		Values(comment.Name, comment.NewsID, comment.Comment).
+	return nil
+}
+
+// Delete removes a comment together with its replies.
+func (c *CommentRepo) Delete(ctx context.Context, id string) error {
+	query, args, err := c.Builder.
+		Delete("comments").
+		Where(squirrel.Eq{"id": id}).
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	_, err = c.DB.ExecContext(ctx, sql, args...)
+	result, err := c.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
 
+	if rowsAffected == 0 {
+		return apperror.ErrNotFound
+	}
+
 	return nil
 }
+
+func (c *CommentRepo) queryComments(ctx context.Context, query string, args []interface{}, withPath bool) ([]entity.Comment, error) {
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]entity.Comment, 0)
+
+	for rows.Next() {
+		var (
+			comment  entity.Comment
+			parentID sql.NullString
+		)
+
+		dest := []interface{}{
+			&comment.ID,
+			&comment.NewsID,
+			&parentID,
+			&comment.Name,
+			&comment.Comment,
+			&comment.Status,
+			&comment.Depth,
+		}
+
+		if withPath {
+			dest = append(dest, &comment.Path)
+		}
+
+		dest = append(dest, &comment.CreatedAt)
+
+		err = rows.Scan(dest...)
+		if err != nil {
+			return nil, err
+		}
+
+		comment.ParentID = parentID.String
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return comments, nil
+}
+
+func (c *CommentRepo) newsExists(ctx context.Context, newsID string) (bool, error) {
+	var exists bool
+
+	checkSQL, _, err := c.Builder.Select("EXISTS(SELECT 1 FROM news WHERE id = ?)").ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	err = c.DB.QueryRowContext(ctx, checkSQL, newsID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
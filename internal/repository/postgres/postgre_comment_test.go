@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/Masterminds/squirrel"
@@ -15,10 +16,24 @@ import (
 )
 
 const (
-	commentDummyID     = "550e8400-e29b-41d4-a716-446655440000"
-	sqlInsertComment   = `INSERT INTO comments \(name, news_id, comment\) VALUES \(\$1,\$2,\$3\)`
-	commentNewsDummyID = "550e8400-e29b-41d4-a716-44665544125"
-	sqlCheckNewsExists = `SELECT EXISTS\(SELECT 1 FROM news WHERE id = \$1\)`
+	commentDummyID          = "550e8400-e29b-41d4-a716-446655440000"
+	sqlInsertComment        = `INSERT INTO comments \(name, news_id, comment, parent_id, depth, status, fingerprint, edit_token_hash\) VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,\$7,\$8\) RETURNING id, created_at`
+	sqlSelectComment        = `SELECT id, news_id, parent_id, name, comment, status, fingerprint, edit_token_hash, depth, created_at FROM comments WHERE id = \$1`
+	sqlUpdateComment        = `UPDATE comments SET comment = \$1, status = \$2, updated_at = NOW\(\) WHERE id = \$3`
+	sqlDeleteComment        = `DELETE FROM comments WHERE id = \$1`
+	sqlSelectThread         = `(?s)WITH RECURSIVE thread AS .* SELECT id, news_id, parent_id, name, comment, status, depth, path, created_at FROM thread ORDER BY depth ASC, created_at ASC`
+	sqlSelectByStatus       = `SELECT id, news_id, parent_id, name, comment, status, depth, created_at FROM comments WHERE status = \$1 ORDER BY created_at ASC, id ASC LIMIT 50 OFFSET 0`
+	sqlUpdateStatus         = `UPDATE comments SET status = \$1, moderated_at = NOW\(\) WHERE id IN \(\$2,\$3\)`
+	sqlHasApproved          = `SELECT EXISTS\(SELECT 1 FROM comments WHERE fingerprint = \$1 AND status = \$2\)`
+	sqlSelectByIDs          = `SELECT id, news_id, parent_id, name, comment, status, depth, created_at, trained_class, trained_text FROM comments WHERE id IN \(\$1,\$2\)`
+	sqlCountByContent       = `SELECT COUNT\(\*\) FROM comments WHERE md5\(lower\(btrim\(comment\)\)\) = md5\(lower\(btrim\(\$1\)\)\) AND created_at >= \$2`
+	sqlCountOthersByContent = `SELECT COUNT\(\*\) FROM comments WHERE md5\(lower\(btrim\(comment\)\)\) = md5\(lower\(btrim\(\$1\)\)\) ` +
+		`AND created_at >= \$2 AND id <> \$3`
+	commentEditTokenHash = "5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"
+	commentFingerprint   = "6b86b273ff34fce19d6b804eff5a3f5747ada4eaa22f1d49c01e52ddb7875b4b"
+	commentParentID      = "550e8400-e29b-41d4-a716-446655440001"
+	commentNewsDummyID   = "550e8400-e29b-41d4-a716-44665544125"
+	sqlCheckNewsExists   = `SELECT EXISTS\(SELECT 1 FROM news WHERE id = \$1\)`
 )
 
 func setupCommentMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *CommentRepo) {
@@ -43,9 +58,12 @@ func TestCommentRepo_Create(t *testing.T) {
 		defer db.Close()
 
 		comment := &entity.Comment{
-			Name:    "testing comment",
-			NewsID:  commentNewsDummyID,
-			Comment: "testing comment",
+			Name:          "testing comment",
+			NewsID:        commentNewsDummyID,
+			Comment:       "testing comment",
+			Status:        entity.CommentStatusApproved,
+			Fingerprint:   commentFingerprint,
+			EditTokenHash: commentEditTokenHash,
 		}
 
 		// Mock news existence check
@@ -55,13 +73,15 @@ func TestCommentRepo_Create(t *testing.T) {
 			WillReturnRows(rows)
 
 		// Mock insert
-		mock.ExpectExec(sqlInsertComment).
-			WithArgs(comment.Name, comment.NewsID, comment.Comment).
-			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectQuery(sqlInsertComment).
+			WithArgs(comment.Name, comment.NewsID, comment.Comment, nil, 0, entity.CommentStatusApproved, commentFingerprint, commentEditTokenHash).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(commentDummyID, time.Now()))
 
-		err := repo.Create(context.Background(), comment)
+		result, err := repo.Create(context.Background(), comment)
 
 		assert.NoError(t, err)
+		assert.Equal(t, commentDummyID, result.ID)
+		assert.Equal(t, entity.CommentStatusApproved, result.Status)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
@@ -70,9 +90,12 @@ func TestCommentRepo_Create(t *testing.T) {
 		defer db.Close()
 
 		comment := &entity.Comment{
-			Name:    "testing comment",
-			NewsID:  "non-existent-news-id",
-			Comment: "testing comment",
+			Name:          "testing comment",
+			NewsID:        "non-existent-news-id",
+			Comment:       "testing comment",
+			Status:        entity.CommentStatusApproved,
+			Fingerprint:   commentFingerprint,
+			EditTokenHash: commentEditTokenHash,
 		}
 
 		// Mock news existence check - news does not exist
@@ -81,7 +104,7 @@ func TestCommentRepo_Create(t *testing.T) {
 			WithArgs(comment.NewsID).
 			WillReturnRows(rows)
 
-		err := repo.Create(context.Background(), comment)
+		_, err := repo.Create(context.Background(), comment)
 
 		assert.Error(t, err)
 		assert.Equal(t, apperror.ErrNotFound, err)
@@ -93,9 +116,12 @@ func TestCommentRepo_Create(t *testing.T) {
 		defer db.Close()
 
 		comment := &entity.Comment{
-			Name:    "testing comment",
-			NewsID:  commentNewsDummyID,
-			Comment: "testing comment",
+			Name:          "testing comment",
+			NewsID:        commentNewsDummyID,
+			Comment:       "testing comment",
+			Status:        entity.CommentStatusApproved,
+			Fingerprint:   commentFingerprint,
+			EditTokenHash: commentEditTokenHash,
 		}
 
 		// Mock news existence check failure
@@ -103,7 +129,7 @@ func TestCommentRepo_Create(t *testing.T) {
 			WithArgs(comment.NewsID).
 			WillReturnError(apperror.ErrDatabaseConnection)
 
-		err := repo.Create(context.Background(), comment)
+		_, err := repo.Create(context.Background(), comment)
 
 		assert.Error(t, err)
 		assert.Equal(t, apperror.ErrDatabaseConnection, err)
@@ -115,9 +141,12 @@ func TestCommentRepo_Create(t *testing.T) {
 		defer db.Close()
 
 		comment := &entity.Comment{
-			Name:    "tester",
-			NewsID:  commentNewsDummyID,
-			Comment: "fail case",
+			Name:          "tester",
+			NewsID:        commentNewsDummyID,
+			Comment:       "fail case",
+			Status:        entity.CommentStatusApproved,
+			Fingerprint:   commentFingerprint,
+			EditTokenHash: commentEditTokenHash,
 		}
 
 		// Mock news existence check
@@ -127,14 +156,421 @@ func TestCommentRepo_Create(t *testing.T) {
 			WillReturnRows(rows)
 
 		// Mock insert failure
-		mock.ExpectExec(sqlInsertComment).
-			WithArgs(comment.Name, comment.NewsID, comment.Comment).
+		mock.ExpectQuery(sqlInsertComment).
+			WithArgs(comment.Name, comment.NewsID, comment.Comment, nil, 0, entity.CommentStatusApproved, commentFingerprint, commentEditTokenHash).
 			WillReturnError(apperror.ErrDatabaseConnection)
 
-		err := repo.Create(context.Background(), comment)
+		_, err := repo.Create(context.Background(), comment)
 
 		assert.Error(t, err)
 		assert.Equal(t, apperror.ErrDatabaseConnection, err)
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
+
+	t.Run("success - create reply", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		comment := &entity.Comment{
+			Name:     "replier",
+			NewsID:   commentNewsDummyID,
+			ParentID: commentParentID,
+			Comment:  "a reply",
+			Depth:    1,
+			Status:   entity.CommentStatusPending,
+		}
+
+		rows := sqlmock.NewRows([]string{"exists"}).AddRow(true)
+		mock.ExpectQuery(sqlCheckNewsExists).
+			WithArgs(comment.NewsID).
+			WillReturnRows(rows)
+
+		mock.ExpectQuery(sqlInsertComment).
+			WithArgs(comment.Name, comment.NewsID, comment.Comment, commentParentID, 1, entity.CommentStatusPending, "", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(commentDummyID, time.Now()))
+
+		result, err := repo.Create(context.Background(), comment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, commentParentID, result.ParentID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_GetByID(t *testing.T) {
+	t.Run("success - get reply by id", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		rows := sqlmock.NewRows([]string{"id", "news_id", "parent_id", "name", "comment", "status", "fingerprint", "edit_token_hash", "depth", "created_at"}).
+			AddRow(commentDummyID, commentNewsDummyID, commentParentID, "John", "reply", entity.CommentStatusApproved, commentFingerprint, commentEditTokenHash, 1, now)
+
+		mock.ExpectQuery(sqlSelectComment).
+			WithArgs(commentDummyID).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByID(context.Background(), commentDummyID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, commentParentID, result.ParentID)
+		assert.Equal(t, 1, result.Depth)
+		assert.Equal(t, commentEditTokenHash, result.EditTokenHash)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - top level comment has empty parent", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "news_id", "parent_id", "name", "comment", "status", "fingerprint", "edit_token_hash", "depth", "created_at"}).
+			AddRow(commentDummyID, commentNewsDummyID, nil, "John", "root", entity.CommentStatusPending, "", "", 0, time.Now())
+
+		mock.ExpectQuery(sqlSelectComment).
+			WithArgs(commentDummyID).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByID(context.Background(), commentDummyID)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.ParentID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - comment not found", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectComment).
+			WithArgs(commentDummyID).
+			WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.GetByID(context.Background(), commentDummyID)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_GetByNewsID(t *testing.T) {
+	t.Run("success - get thread", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlCheckNewsExists).
+			WithArgs(commentNewsDummyID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		rows := sqlmock.NewRows([]string{"id", "news_id", "parent_id", "name", "comment", "status", "depth", "path", "created_at"}).
+			AddRow(commentParentID, commentNewsDummyID, nil, "John", "root", entity.CommentStatusApproved, 0, commentParentID, now).
+			AddRow(commentDummyID, commentNewsDummyID, commentParentID, "Jane", "reply", entity.CommentStatusApproved, 1, commentParentID+"/"+commentDummyID, now)
+
+		mock.ExpectQuery(sqlSelectThread).
+			WithArgs(commentNewsDummyID, entity.CommentStatusApproved, entity.CommentStatusApproved).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByNewsID(context.Background(), commentNewsDummyID, entity.CommentStatusApproved)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Empty(t, result[0].ParentID)
+		assert.Equal(t, commentParentID, result[1].ParentID)
+		assert.Equal(t, commentParentID+"/"+commentDummyID, result[1].Path)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - news not found", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlCheckNewsExists).
+			WithArgs(commentNewsDummyID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		result, err := repo.GetByNewsID(context.Background(), commentNewsDummyID, entity.CommentStatusApproved)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlCheckNewsExists).
+			WithArgs(commentNewsDummyID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		mock.ExpectQuery(sqlSelectThread).
+			WithArgs(commentNewsDummyID, entity.CommentStatusApproved, entity.CommentStatusApproved).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		result, err := repo.GetByNewsID(context.Background(), commentNewsDummyID, entity.CommentStatusApproved)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_GetByStatus(t *testing.T) {
+	t.Run("success - get pending comments", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "news_id", "parent_id", "name", "comment", "status", "depth", "created_at"}).
+			AddRow(commentDummyID, commentNewsDummyID, nil, "John", "hold me", entity.CommentStatusPending, 0, time.Now())
+
+		mock.ExpectQuery(sqlSelectByStatus).
+			WithArgs(entity.CommentStatusPending).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByStatus(context.Background(), entity.CommentStatusPending, 50, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, entity.CommentStatusPending, result[0].Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectByStatus).
+			WithArgs(entity.CommentStatusPending).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		result, err := repo.GetByStatus(context.Background(), entity.CommentStatusPending, 50, 0)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_UpdateStatus(t *testing.T) {
+	t.Run("success - approve comments", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpdateStatus).
+			WithArgs(entity.CommentStatusApproved, commentDummyID, commentParentID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		updated, err := repo.UpdateStatus(context.Background(), []string{commentDummyID, commentParentID}, entity.CommentStatusApproved)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), updated)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - update fails", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpdateStatus).
+			WithArgs(entity.CommentStatusSpam, commentDummyID, commentParentID).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		_, err := repo.UpdateStatus(context.Background(), []string{commentDummyID, commentParentID}, entity.CommentStatusSpam)
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_HasApprovedByFingerprint(t *testing.T) {
+	t.Run("success - returning commenter", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlHasApproved).
+			WithArgs(commentFingerprint, entity.CommentStatusApproved).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		returning, err := repo.HasApprovedByFingerprint(context.Background(), commentFingerprint)
+
+		assert.NoError(t, err)
+		assert.True(t, returning)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlHasApproved).
+			WithArgs(commentFingerprint, entity.CommentStatusApproved).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		returning, err := repo.HasApprovedByFingerprint(context.Background(), commentFingerprint)
+
+		assert.False(t, returning)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_GetByIDs(t *testing.T) {
+	t.Run("success - get comments by ids", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"id", "news_id", "parent_id", "name", "comment", "status", "depth", "created_at", "trained_class", "trained_text"}).
+			AddRow(commentDummyID, commentNewsDummyID, nil, "John", "first", entity.CommentStatusPending, 0, time.Now(), "", "").
+			AddRow(commentParentID, commentNewsDummyID, nil, "Jane", "second", entity.CommentStatusSpam, 0, time.Now(), entity.SpamClassSpam, "second")
+
+		mock.ExpectQuery(sqlSelectByIDs).
+			WithArgs(commentDummyID, commentParentID).
+			WillReturnRows(rows)
+
+		comments, err := repo.GetByIDs(context.Background(), []string{commentDummyID, commentParentID})
+
+		assert.NoError(t, err)
+		assert.Len(t, comments, 2)
+		assert.Equal(t, entity.CommentStatusSpam, comments[1].Status)
+		assert.Equal(t, entity.SpamClassSpam, comments[1].TrainedClass)
+		assert.Equal(t, "second", comments[1].TrainedText)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_CountRecentByContent(t *testing.T) {
+	t.Run("success - count duplicates", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		since := time.Now().Add(-time.Hour)
+
+		mock.ExpectQuery(sqlCountByContent).
+			WithArgs("buy now", since).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repo.CountRecentByContent(context.Background(), "buy now", since, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - leave out the edited comment", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		since := time.Now().Add(-time.Hour)
+
+		mock.ExpectQuery(sqlCountOthersByContent).
+			WithArgs("buy now", since, commentDummyID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		count, err := repo.CountRecentByContent(context.Background(), "buy now", since, commentDummyID)
+
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		since := time.Now().Add(-time.Hour)
+
+		mock.ExpectQuery(sqlCountByContent).
+			WithArgs("buy now", since).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		count, err := repo.CountRecentByContent(context.Background(), "buy now", since, "")
+
+		assert.Zero(t, count)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_Update(t *testing.T) {
+	t.Run("success - update comment", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpdateComment).
+			WithArgs("fixed typo", entity.CommentStatusApproved, commentDummyID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(context.Background(), &entity.Comment{
+			ID:      commentDummyID,
+			Comment: "fixed typo",
+			Status:  entity.CommentStatusApproved,
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - comment not found", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpdateComment).
+			WithArgs("fixed typo", entity.CommentStatusApproved, commentDummyID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Update(context.Background(), &entity.Comment{
+			ID:      commentDummyID,
+			Comment: "fixed typo",
+			Status:  entity.CommentStatusApproved,
+		})
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentRepo_Delete(t *testing.T) {
+	t.Run("success - delete comment", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteComment).
+			WithArgs(commentDummyID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Delete(context.Background(), commentDummyID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - comment not found", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteComment).
+			WithArgs(commentDummyID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(context.Background(), commentDummyID)
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - database error", func(t *testing.T) {
+		db, mock, repo := setupCommentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteComment).
+			WithArgs(commentDummyID).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		err := repo.Delete(context.Background(), commentDummyID)
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
 }
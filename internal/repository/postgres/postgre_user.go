@@ -29,7 +29,7 @@ func (u *UserRepo) Create(ctx context.Context, user entity.User) error {
 
 	_, err = u.DB.ExecContext(ctx, query, args...)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	return nil
@@ -4,12 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
 	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
 )
 
+// categoryColumns are selected, in scan order, wherever a category is read.
+var categoryColumns = []string{"id", "name", "created_at", "updated_at", "locale", "translation_group"}
+
 type CategoryRepo struct {
 	*postgres.Postgres
 }
@@ -20,32 +24,25 @@ func NewPostgresCategoryRepo(pg *postgres.Postgres) *CategoryRepo {
 
 func (c *CategoryRepo) Create(ctx context.Context, category *entity.Category) (*entity.Category, error) {
 	query, args, err := c.Builder.Insert("categories").
-		Columns("name").
-		Values(category.Name).
-		Suffix("RETURNING id, name, created_at, updated_at").
+		Columns("name", "locale", "translation_group").
+		Values(category.Name, category.Locale, translationGroup(category.TranslationGroup)).
+		Suffix("RETURNING " + strings.Join(categoryColumns, ", ")).
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	var result entity.Category
-
-	err = c.DB.QueryRowContext(ctx, query, args...).Scan(
-		&result.ID,
-		&result.Name,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	result, err := scanCategory(c.DB.QueryRowContext(ctx, query, args...))
 	if err != nil {
-		return nil, err
+		return nil, mapError(err)
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 func (c *CategoryRepo) GetByID(ctx context.Context, id string) (*entity.Category, error) {
 	query, args, err := c.Builder.
-		Select("id", "name", "created_at", "updated_at").
+		Select(categoryColumns...).
 		From("categories").
 		Where("id = ?", id).
 		ToSql()
@@ -53,11 +50,7 @@ func (c *CategoryRepo) GetByID(ctx context.Context, id string) (*entity.Category
 		return nil, err
 	}
 
-	row := c.DB.QueryRowContext(ctx, query, args...)
-
-	var category entity.Category
-
-	err = row.Scan(&category.ID, &category.Name, &category.CreatedAt, &category.UpdatedAt)
+	category, err := scanCategory(c.DB.QueryRowContext(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, apperror.ErrNotFound
@@ -66,12 +59,12 @@ func (c *CategoryRepo) GetByID(ctx context.Context, id string) (*entity.Category
 		return nil, err
 	}
 
-	return &category, nil
+	return category, nil
 }
 
 func (c *CategoryRepo) GetAll(ctx context.Context) ([]entity.Category, error) {
 	query, args, err := c.Builder.
-		Select("id", "name", "created_at", "updated_at").
+		Select(categoryColumns...).
 		From("categories").
 		OrderBy("id ASC").
 		ToSql()
@@ -88,14 +81,12 @@ func (c *CategoryRepo) GetAll(ctx context.Context) ([]entity.Category, error) {
 	categories := make([]entity.Category, 0)
 
 	for rows.Next() {
-		var category entity.Category
-
-		err = rows.Scan(&category.ID, &category.Name, &category.CreatedAt, &category.UpdatedAt)
+		category, err := scanCategory(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		categories = append(categories, category)
+		categories = append(categories, *category)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -106,18 +97,23 @@ func (c *CategoryRepo) GetAll(ctx context.Context) ([]entity.Category, error) {
 }
 
 func (c *CategoryRepo) Update(ctx context.Context, category *entity.Category) error {
-	query, args, err := c.Builder.Update("categories").
+	builder := c.Builder.Update("categories").
 		Set("name", category.Name).
 		Set("updated_at", "NOW()").
-		Where("id = ?", category.ID).
-		ToSql()
+		Where("id = ?", category.ID)
+
+	if category.Locale != "" {
+		builder = builder.Set("locale", category.Locale)
+	}
+
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return err
 	}
 
 	result, err := c.DB.ExecContext(ctx, query, args...)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -156,3 +152,21 @@ func (c *CategoryRepo) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func scanCategory(row rowScanner) (*entity.Category, error) {
+	var category entity.Category
+
+	err := row.Scan(
+		&category.ID,
+		&category.Name,
+		&category.CreatedAt,
+		&category.UpdatedAt,
+		&category.Locale,
+		&category.TranslationGroup,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
@@ -16,18 +16,22 @@ import (
 )
 
 const (
-	sqlInsertCategory      = `INSERT INTO categories \(name\) VALUES \(\$1\) RETURNING id, name, created_at, updated_at`
-	sqlSelectCategory      = `SELECT id, name, created_at, updated_at FROM categories WHERE id = \$1`
-	sqlSelectAllCategories = `SELECT id, name, created_at, updated_at FROM categories ORDER BY id ASC`
+	sqlInsertCategory      = `INSERT INTO categories \(name,locale,translation_group\) VALUES \(\$1,\$2,COALESCE\(\$3::uuid, uuid_generate_v4\(\)\)\) RETURNING id, name, created_at, updated_at, locale, translation_group`
+	sqlSelectCategory      = `SELECT id, name, created_at, updated_at, locale, translation_group FROM categories WHERE id = \$1`
+	sqlSelectAllCategories = `SELECT id, name, created_at, updated_at, locale, translation_group FROM categories ORDER BY id ASC`
 	sqlUpdateCategory      = `UPDATE categories SET name = \$1, updated_at = \$2 WHERE id = \$3`
+	sqlUpdateCategoryLang  = `UPDATE categories SET name = \$1, updated_at = \$2, locale = \$3 WHERE id = \$4`
 	sqlDeleteCategory      = `DELETE FROM categories WHERE id = \$1`
 )
 
 const (
 	dummyID       = "550e8400-e29b-41d4-a716-446655440000"
 	nonExistentID = "550e8400-e29b-41d4-a716-999999999999"
+	testGroupID   = "550e8400-e29b-41d4-a716-446655440077"
 )
 
+var categoryRowColumns = []string{"id", "name", "created_at", "updated_at", "locale", "translation_group"}
+
 func setupCategoryMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *CategoryRepo) {
 	t.Helper()
 
@@ -61,11 +65,11 @@ func TestCategoryRepo_Create(t *testing.T) {
 			UpdatedAt: now,
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "name", "created_at", "updated_at"}).
-			AddRow(expectedCategory.ID, expectedCategory.Name, expectedCategory.CreatedAt, expectedCategory.UpdatedAt)
+		rows := sqlmock.NewRows(categoryRowColumns).
+			AddRow(expectedCategory.ID, expectedCategory.Name, expectedCategory.CreatedAt, expectedCategory.UpdatedAt, "en", testGroupID)
 
 		mock.ExpectQuery(sqlInsertCategory).
-			WithArgs(category.Name).
+			WithArgs(category.Name, "", nil).
 			WillReturnRows(rows)
 
 		result, err := repo.Create(context.Background(), category)
@@ -86,7 +90,7 @@ func TestCategoryRepo_Create(t *testing.T) {
 		}
 
 		mock.ExpectQuery(sqlInsertCategory).
-			WithArgs(category.Name).
+			WithArgs(category.Name, "", nil).
 			WillReturnError(apperror.ErrDatabaseConnection)
 
 		result, err := repo.Create(context.Background(), category)
@@ -107,11 +111,11 @@ func TestCategoryRepo_Create(t *testing.T) {
 
 		now := time.Now()
 
-		rows := sqlmock.NewRows([]string{"id", "name", "created_at", "updated_at"}).
-			AddRow(dummyID, category.Name, now, now)
+		rows := sqlmock.NewRows(categoryRowColumns).
+			AddRow(dummyID, category.Name, now, now, "en", testGroupID)
 
 		mock.ExpectQuery(sqlInsertCategory).
-			WithArgs(category.Name).
+			WithArgs(category.Name, "", nil).
 			WillReturnRows(rows)
 
 		result, err := repo.Create(context.Background(), category)
@@ -136,8 +140,8 @@ func TestCategoryRepo_GetByID(t *testing.T) {
 			UpdatedAt: now,
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "name", "created_at", "updated_at"}).
-			AddRow(expectedCategory.ID, expectedCategory.Name, expectedCategory.CreatedAt, expectedCategory.UpdatedAt)
+		rows := sqlmock.NewRows(categoryRowColumns).
+			AddRow(expectedCategory.ID, expectedCategory.Name, expectedCategory.CreatedAt, expectedCategory.UpdatedAt, "en", testGroupID)
 
 		mock.ExpectQuery(sqlSelectCategory).
 			WithArgs(expectedCategory.ID).
@@ -196,10 +200,10 @@ func TestCategoryRepo_GetAll(t *testing.T) {
 
 		now := time.Now()
 
-		rows := sqlmock.NewRows([]string{"id", "name", "created_at", "updated_at"}).
-			AddRow("550e8400-e29b-41d4-a716-446655440001", "Technology", now, now).
-			AddRow("550e8400-e29b-41d4-a716-446655440002", "Sports", now, now).
-			AddRow("550e8400-e29b-41d4-a716-446655440003", "Entertainment", now, now)
+		rows := sqlmock.NewRows(categoryRowColumns).
+			AddRow("550e8400-e29b-41d4-a716-446655440001", "Technology", now, now, "en", testGroupID).
+			AddRow("550e8400-e29b-41d4-a716-446655440002", "Sports", now, now, "en", testGroupID).
+			AddRow("550e8400-e29b-41d4-a716-446655440003", "Entertainment", now, now, "en", testGroupID)
 
 		mock.ExpectQuery(sqlSelectAllCategories).
 			WillReturnRows(rows)
@@ -219,7 +223,7 @@ func TestCategoryRepo_GetAll(t *testing.T) {
 		db, mock, repo := setupCategoryMockDB(t)
 		defer db.Close()
 
-		rows := sqlmock.NewRows([]string{"id", "name", "created_at", "updated_at"})
+		rows := sqlmock.NewRows(categoryRowColumns)
 
 		mock.ExpectQuery(sqlSelectAllCategories).
 			WillReturnRows(rows)
@@ -268,6 +272,26 @@ func TestCategoryRepo_Update(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("success - update category locale", func(t *testing.T) {
+		db, mock, repo := setupCategoryMockDB(t)
+		defer db.Close()
+
+		category := &entity.Category{
+			ID:     dummyID,
+			Name:   "Teknologi",
+			Locale: "id",
+		}
+
+		mock.ExpectExec(sqlUpdateCategoryLang).
+			WithArgs(category.Name, "NOW()", category.Locale, category.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(context.Background(), category)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("error - category not found", func(t *testing.T) {
 		db, mock, repo := setupCategoryMockDB(t)
 		defer db.Close()
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+type ChallengeRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresChallengeRepo(pg *postgres.Postgres) *ChallengeRepo {
+	return &ChallengeRepo{pg}
+}
+
+// Redeem marks a challenge as used until it expires. Expired redemptions are
+// purged in the same statement.
+func (c *ChallengeRepo) Redeem(ctx context.Context, id string, expiresAt time.Time) error {
+	query, args, err := c.Builder.
+		Insert("pow_redemptions").
+		Prefix("WITH purged AS (DELETE FROM pow_redemptions WHERE expires_at < NOW())").
+		Columns("id", "expires_at").
+		Values(id, expiresAt).
+		Suffix("ON CONFLICT (id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := c.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if inserted == 0 {
+		return apperror.ErrChallengeUsed
+	}
+
+	return nil
+}
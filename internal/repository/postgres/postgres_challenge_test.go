@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	challengeDummyID = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	sqlRedeem        = `WITH purged AS \(DELETE FROM pow_redemptions WHERE expires_at < NOW\(\)\) ` +
+		`INSERT INTO pow_redemptions \(id,expires_at\) VALUES \(\$1,\$2\) ON CONFLICT \(id\) DO NOTHING`
+)
+
+func setupChallengeMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *ChallengeRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresChallengeRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestChallengeRepo_Redeem(t *testing.T) {
+	expiresAt := time.Now().Add(10 * time.Minute)
+
+	t.Run("success - first redemption", func(t *testing.T) {
+		db, mock, repo := setupChallengeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlRedeem).
+			WithArgs(challengeDummyID, expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Redeem(context.Background(), challengeDummyID, expiresAt)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - challenge already used", func(t *testing.T) {
+		db, mock, repo := setupChallengeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlRedeem).
+			WithArgs(challengeDummyID, expiresAt).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Redeem(context.Background(), challengeDummyID, expiresAt)
+
+		assert.Equal(t, apperror.ErrChallengeUsed, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - database error", func(t *testing.T) {
+		db, mock, repo := setupChallengeMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlRedeem).
+			WithArgs(challengeDummyID, expiresAt).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		err := repo.Redeem(context.Background(), challengeDummyID, expiresAt)
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/lib/pq"
+)
+
+type CommentReportRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresCommentReportRepo(pg *postgres.Postgres) *CommentReportRepo {
+	return &CommentReportRepo{pg}
+}
+
+// Add stores a report. A reporter who already reported the comment is
+// ignored, so each reporter counts once.
+func (r *CommentReportRepo) Add(ctx context.Context, report *entity.CommentReport) error {
+	query, args, err := r.Builder.
+		Insert("comment_reports").
+		Columns("comment_id", "reporter", "reason", "note").
+		Values(report.CommentID, report.Reporter, report.Reason, report.Note).
+		Suffix("ON CONFLICT (comment_id, reporter) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// CountByCommentID returns the number of open reports on a comment.
+func (r *CommentReportRepo) CountByCommentID(ctx context.Context, commentID string) (int, error) {
+	query, args, err := r.Builder.
+		Select("COUNT(*)").
+		From("comment_reports").
+		Where(squirrel.Eq{"comment_id": commentID}).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+
+	if err := r.DB.QueryRowContext(ctx, query, args...).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetReported returns a page of up to limit comments with open reports, most
+// reported first.
+func (r *CommentReportRepo) GetReported(ctx context.Context, limit, offset int) ([]entity.ReportedComment, error) {
+	query, args, err := r.Builder.
+		Select(
+			"c.id", "c.news_id", "c.name", "c.comment", "c.status", "c.created_at",
+			"COUNT(*) AS reports",
+			"array_agg(DISTINCT r.reason ORDER BY r.reason)",
+			"MAX(r.created_at) AS last_reported_at",
+		).
+		From("comment_reports r").
+		Join("comments c ON c.id = r.comment_id").
+		GroupBy("c.id").
+		OrderBy("reports DESC", "last_reported_at DESC", "c.id").
+		Limit(uint64(max(limit, 0))).
+		Offset(uint64(max(offset, 0))).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reported := make([]entity.ReportedComment, 0)
+
+	for rows.Next() {
+		var item entity.ReportedComment
+
+		err = rows.Scan(
+			&item.Comment.ID,
+			&item.Comment.NewsID,
+			&item.Comment.Name,
+			&item.Comment.Comment,
+			&item.Comment.Status,
+			&item.Comment.CreatedAt,
+			&item.Reports,
+			pq.Array(&item.Reasons),
+			&item.LastReportedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		reported = append(reported, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reported, nil
+}
+
+// DeleteByCommentIDs closes the reports on the given comments.
+func (r *CommentReportRepo) DeleteByCommentIDs(ctx context.Context, commentIDs []string) error {
+	query, args, err := r.Builder.
+		Delete("comment_reports").
+		Where(squirrel.Eq{"comment_id": commentIDs}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlInsertCommentReport = `INSERT INTO comment_reports \(comment_id,reporter,reason,note\) VALUES \(\$1,\$2,\$3,\$4\) ` +
+		`ON CONFLICT \(comment_id, reporter\) DO NOTHING`
+	sqlCountCommentReports = `SELECT COUNT\(\*\) FROM comment_reports WHERE comment_id = \$1`
+	sqlSelectReported      = `SELECT c.id, c.news_id, c.name, c.comment, c.status, c.created_at, COUNT\(\*\) AS reports, ` +
+		`array_agg\(DISTINCT r.reason ORDER BY r.reason\), MAX\(r.created_at\) AS last_reported_at ` +
+		`FROM comment_reports r JOIN comments c ON c.id = r.comment_id GROUP BY c.id ` +
+		`ORDER BY reports DESC, last_reported_at DESC, c.id LIMIT 20 OFFSET 40`
+	sqlDeleteCommentReports = `DELETE FROM comment_reports WHERE comment_id IN \(\$1,\$2\)`
+	testReportCommentID     = "550e8400-e29b-41d4-a716-446655440010"
+	testReportOtherID       = "550e8400-e29b-41d4-a716-446655440011"
+	testReporter            = "visitor:fingerprint"
+)
+
+func setupCommentReportMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *CommentReportRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresCommentReportRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestCommentReportRepo_Add(t *testing.T) {
+	t.Run("success - add report", func(t *testing.T) {
+		db, mock, repo := setupCommentReportMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlInsertCommentReport).
+			WithArgs(testReportCommentID, testReporter, entity.CommentReportAbuse, "insults other readers").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Add(context.Background(), &entity.CommentReport{
+			CommentID: testReportCommentID,
+			Reporter:  testReporter,
+			Reason:    entity.CommentReportAbuse,
+			Note:      "insults other readers",
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - insert fails", func(t *testing.T) {
+		db, mock, repo := setupCommentReportMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlInsertCommentReport).
+			WithArgs(testReportCommentID, testReporter, entity.CommentReportSpam, "").
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		err := repo.Add(context.Background(), &entity.CommentReport{
+			CommentID: testReportCommentID,
+			Reporter:  testReporter,
+			Reason:    entity.CommentReportSpam,
+		})
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentReportRepo_CountByCommentID(t *testing.T) {
+	t.Run("success - count reports", func(t *testing.T) {
+		db, mock, repo := setupCommentReportMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlCountCommentReports).
+			WithArgs(testReportCommentID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repo.CountByCommentID(context.Background(), testReportCommentID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, count)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentReportRepo_GetReported(t *testing.T) {
+	t.Run("success - get reported comments", func(t *testing.T) {
+		db, mock, repo := setupCommentReportMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		rows := sqlmock.NewRows([]string{
+			"id", "news_id", "name", "comment", "status", "created_at", "reports", "reasons", "last_reported_at",
+		}).
+			AddRow(testReportCommentID, testNewsID, "John Doe", "Buy now", entity.CommentStatusPending, now, 4, "{abuse,spam}", now).
+			AddRow(testReportOtherID, testNewsID, "Jane Doe", "Off topic", entity.CommentStatusApproved, now, 1, "{off_topic}", now)
+
+		mock.ExpectQuery(sqlSelectReported).WillReturnRows(rows)
+
+		reported, err := repo.GetReported(context.Background(), 20, 40)
+
+		assert.NoError(t, err)
+		require.Len(t, reported, 2)
+		assert.Equal(t, testReportCommentID, reported[0].Comment.ID)
+		assert.Equal(t, 4, reported[0].Reports)
+		assert.Equal(t, []string{entity.CommentReportAbuse, entity.CommentReportSpam}, reported[0].Reasons)
+		assert.Equal(t, []string{entity.CommentReportOffTopic}, reported[1].Reasons)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupCommentReportMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectReported).WillReturnError(apperror.ErrDatabaseConnection)
+
+		reported, err := repo.GetReported(context.Background(), 20, 40)
+
+		assert.Nil(t, reported)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentReportRepo_DeleteByCommentIDs(t *testing.T) {
+	t.Run("success - close reports", func(t *testing.T) {
+		db, mock, repo := setupCommentReportMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteCommentReports).
+			WithArgs(testReportCommentID, testReportOtherID).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		err := repo.DeleteByCommentIDs(context.Background(), []string{testReportCommentID, testReportOtherID})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+type CommentVoteRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresCommentVoteRepo(pg *postgres.Postgres) *CommentVoteRepo {
+	return &CommentVoteRepo{pg}
+}
+
+// Vote stores the vote of a voter on a comment, replacing an earlier vote.
+// A value of 0 withdraws the vote.
+func (r *CommentVoteRepo) Vote(ctx context.Context, commentID, voter string, value int) error {
+	var (
+		query string
+		args  []interface{}
+		err   error
+	)
+
+	if value == 0 {
+		query, args, err = r.Builder.
+			Delete("comment_votes").
+			Where(squirrel.Eq{"comment_id": commentID, "voter": voter}).
+			ToSql()
+	} else {
+		query, args, err = r.Builder.
+			Insert("comment_votes").
+			Columns("comment_id", "voter", "value").
+			Values(commentID, voter, value).
+			Suffix("ON CONFLICT (comment_id, voter) DO UPDATE SET value = EXCLUDED.value").
+			ToSql()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// CountByCommentIDs returns the up and down votes per comment. Comments
+// without votes are missing from the result.
+func (r *CommentVoteRepo) CountByCommentIDs(ctx context.Context, commentIDs []string) (map[string]entity.CommentVotes, error) {
+	query, args, err := r.Builder.
+		Select(
+			"comment_id",
+			"COUNT(*) FILTER (WHERE value > 0)",
+			"COUNT(*) FILTER (WHERE value < 0)",
+		).
+		From("comment_votes").
+		Where(squirrel.Eq{"comment_id": commentIDs}).
+		GroupBy("comment_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]entity.CommentVotes, len(commentIDs))
+
+	for rows.Next() {
+		var (
+			commentID string
+			votes     entity.CommentVotes
+		)
+
+		if err := rows.Scan(&commentID, &votes.Upvotes, &votes.Downvotes); err != nil {
+			return nil, err
+		}
+
+		result[commentID] = votes
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlUpsertCommentVote = `INSERT INTO comment_votes \(comment_id,voter,value\) VALUES \(\$1,\$2,\$3\) ` +
+		`ON CONFLICT \(comment_id, voter\) DO UPDATE SET value = EXCLUDED.value`
+	sqlDeleteCommentVote = `DELETE FROM comment_votes WHERE comment_id = \$1 AND voter = \$2`
+	sqlCountCommentVotes = `SELECT comment_id, COUNT\(\*\) FILTER \(WHERE value > 0\), COUNT\(\*\) FILTER \(WHERE value < 0\) ` +
+		`FROM comment_votes WHERE comment_id IN \(\$1,\$2\) GROUP BY comment_id`
+	testVoteCommentID    = "550e8400-e29b-41d4-a716-446655440010"
+	testVoteOtherComment = "550e8400-e29b-41d4-a716-446655440011"
+	testCommentVoterID   = "user:550e8400-e29b-41d4-a716-446655440002"
+)
+
+func setupCommentVoteMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *CommentVoteRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresCommentVoteRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestCommentVoteRepo_Vote(t *testing.T) {
+	t.Run("success - upvote", func(t *testing.T) {
+		db, mock, repo := setupCommentVoteMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpsertCommentVote).
+			WithArgs(testVoteCommentID, testCommentVoterID, 1).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Vote(context.Background(), testVoteCommentID, testCommentVoterID, 1)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - withdraw vote", func(t *testing.T) {
+		db, mock, repo := setupCommentVoteMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteCommentVote).
+			WithArgs(testVoteCommentID, testCommentVoterID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Vote(context.Background(), testVoteCommentID, testCommentVoterID, 0)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - upsert fails", func(t *testing.T) {
+		db, mock, repo := setupCommentVoteMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpsertCommentVote).
+			WithArgs(testVoteCommentID, testCommentVoterID, -1).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		err := repo.Vote(context.Background(), testVoteCommentID, testCommentVoterID, -1)
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCommentVoteRepo_CountByCommentIDs(t *testing.T) {
+	t.Run("success - count votes", func(t *testing.T) {
+		db, mock, repo := setupCommentVoteMockDB(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"comment_id", "upvotes", "downvotes"}).
+			AddRow(testVoteCommentID, 4, 1)
+
+		mock.ExpectQuery(sqlCountCommentVotes).
+			WithArgs(testVoteCommentID, testVoteOtherComment).
+			WillReturnRows(rows)
+
+		votes, err := repo.CountByCommentIDs(context.Background(), []string{testVoteCommentID, testVoteOtherComment})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]entity.CommentVotes{testVoteCommentID: {Upvotes: 4, Downvotes: 1}}, votes)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupCommentVoteMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlCountCommentVotes).
+			WithArgs(testVoteCommentID, testVoteOtherComment).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		votes, err := repo.CountByCommentIDs(context.Background(), []string{testVoteCommentID, testVoteOtherComment})
+
+		assert.Nil(t, votes)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+// contentTables hold each type of rendered content.
+var contentTables = map[string]string{
+	entity.ContentNews: "news",
+	entity.ContentPage: "custom_pages",
+}
+
+// ContentRepo implements repository.ContentRepo interface.
+type ContentRepo struct {
+	*postgres.Postgres
+}
+
+// NewPostgresContentRepo creates a new PostgreSQL content repository.
+func NewPostgresContentRepo(pg *postgres.Postgres) *ContentRepo {
+	return &ContentRepo{pg}
+}
+
+// GetRenderState returns apperror.ErrNotFound when stored content was never
+// rendered as a whole.
+func (r *ContentRepo) GetRenderState(ctx context.Context) (*entity.ContentRenderState, error) {
+	query, args, err := r.Builder.
+		Select("sanitized", "updated_at").
+		From("content_render_state").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var state entity.ContentRenderState
+
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&state.Sanitized, &state.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+func (r *ContentRepo) SetRenderState(ctx context.Context, sanitized bool) error {
+	query, args, err := r.Builder.
+		Insert("content_render_state").
+		Columns("sanitized").
+		Values(sanitized).
+		Suffix("ON CONFLICT (id) DO UPDATE SET sanitized = EXCLUDED.sanitized, updated_at = CURRENT_TIMESTAMP").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// GetAfter returns up to limit items of a type of content ordered by ID,
+// starting after afterID, or from the first when it is empty.
+func (r *ContentRepo) GetAfter(ctx context.Context, contentType, afterID string, limit int) ([]entity.StoredContent, error) {
+	table, err := contentTableOf(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := r.Builder.
+		Select("id", "COALESCE(author_id::text, '')", "content", "content_format").
+		From(table).
+		OrderBy("id").
+		Limit(uint64(max(limit, 0)))
+
+	if afterID != "" {
+		builder = builder.Where(squirrel.Gt{"id": afterID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	contents := []entity.StoredContent{}
+
+	for rows.Next() {
+		var content entity.StoredContent
+		if err := rows.Scan(&content.ID, &content.AuthorID, &content.Content, &content.Format); err != nil {
+			return nil, err
+		}
+
+		contents = append(contents, content)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contents, nil
+}
+
+// UpdateRendered stores content rendered again from its source. The item
+// keeps its updated_at, since its content did not change.
+func (r *ContentRepo) UpdateRendered(ctx context.Context, contentType string, content entity.StoredContent) error {
+	table, err := contentTableOf(contentType)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := r.Builder.
+		Update(table).
+		Set("content", content.Content).
+		Set("content_html", content.HTML).
+		Set("content_text", content.Text).
+		Where(squirrel.Eq{"id": content.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+func contentTableOf(contentType string) (string, error) {
+	table, ok := contentTables[contentType]
+	if !ok {
+		return "", fmt.Errorf("unknown content type %q", contentType)
+	}
+
+	return table, nil
+}
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlSelectRenderState   = `SELECT sanitized, updated_at FROM content_render_state`
+	sqlUpsertRenderState   = `INSERT INTO content_render_state \(sanitized\) VALUES \(\$1\) ON CONFLICT \(id\) DO UPDATE SET sanitized = EXCLUDED.sanitized, updated_at = CURRENT_TIMESTAMP`
+	sqlSelectFirstNews     = `SELECT id, COALESCE\(author_id::text, ''\), content, content_format FROM news ORDER BY id LIMIT 2`
+	sqlSelectPagesAfter    = `SELECT id, COALESCE\(author_id::text, ''\), content, content_format FROM custom_pages WHERE id > \$1 ORDER BY id LIMIT 2`
+	sqlUpdateRenderedPage  = `UPDATE custom_pages SET content = \$1, content_html = \$2, content_text = \$3 WHERE id = \$4`
+	testStoredContentID    = "550e8400-e29b-41d4-a716-446655440090"
+	testStoredContentOther = "550e8400-e29b-41d4-a716-446655440091"
+)
+
+var storedContentRowColumns = []string{"id", "author_id", "content", "content_format"}
+
+func setupContentMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *ContentRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresContentRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestContentRepo_GetRenderState(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		updatedAt := time.Now()
+
+		mock.ExpectQuery(sqlSelectRenderState).
+			WillReturnRows(sqlmock.NewRows([]string{"sanitized", "updated_at"}).AddRow(true, updatedAt))
+
+		state, err := repo.GetRenderState(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, &entity.ContentRenderState{Sanitized: true, UpdatedAt: updatedAt}, state)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - never rendered", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectRenderState).
+			WillReturnError(sql.ErrNoRows)
+
+		state, err := repo.GetRenderState(context.Background())
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		assert.Nil(t, state)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - database query fails", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectRenderState).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		state, err := repo.GetRenderState(context.Background())
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.Nil(t, state)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContentRepo_SetRenderState(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpsertRenderState).
+			WithArgs(false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.SetRenderState(context.Background(), false)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContentRepo_GetAfter(t *testing.T) {
+	t.Run("success - first news", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectFirstNews).
+			WillReturnRows(sqlmock.NewRows(storedContentRowColumns).
+				AddRow(testStoredContentID, testPageAuthorID, "<p>Hello</p>", "html").
+				AddRow(testStoredContentOther, "", "# Hello", "markdown"))
+
+		contents, err := repo.GetAfter(context.Background(), entity.ContentNews, "", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.StoredContent{
+			{ID: testStoredContentID, AuthorID: testPageAuthorID, Content: "<p>Hello</p>", Format: "html"},
+			{ID: testStoredContentOther, Content: "# Hello", Format: "markdown"},
+		}, contents)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - pages after an ID", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectPagesAfter).
+			WithArgs(testStoredContentID).
+			WillReturnRows(sqlmock.NewRows(storedContentRowColumns))
+
+		contents, err := repo.GetAfter(context.Background(), entity.ContentPage, testStoredContentID, 2)
+
+		assert.NoError(t, err)
+		assert.Empty(t, contents)
+		assert.NotNil(t, contents)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - unknown type", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		contents, err := repo.GetAfter(context.Background(), "comments", "", 2)
+
+		assert.Error(t, err)
+		assert.Nil(t, contents)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestContentRepo_UpdateRendered(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		content := entity.StoredContent{
+			ID:      testStoredContentID,
+			Content: "<p>Hello</p>",
+			Format:  "html",
+			HTML:    "<p>Hello</p>",
+			Text:    "Hello",
+		}
+
+		mock.ExpectExec(sqlUpdateRenderedPage).
+			WithArgs(content.Content, content.HTML, content.Text, content.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.UpdateRendered(context.Background(), entity.ContentPage, content)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - database exec fails", func(t *testing.T) {
+		db, mock, repo := setupContentMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpdateRenderedPage).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		err := repo.UpdateRendered(context.Background(), entity.ContentPage, entity.StoredContent{ID: testStoredContentID})
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
@@ -11,6 +12,25 @@ import (
 	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
 )
 
+// customPageColumns are selected, in scan order, wherever a full page is read.
+var customPageColumns = []string{
+	"id", "COALESCE(parent_id::text, '')", "position", "slug", "custom_url", "content", "content_format", "content_html",
+	"content_text", "author_id", "created_at", "updated_at", "locale", "translation_group",
+}
+
+// pageAncestorsCTE walks up from a page's parent to the root, counting the
+// distance so the result can be ordered from the root down.
+const pageAncestorsCTE = `WITH RECURSIVE ancestors AS (
+	SELECT p.id, p.parent_id, p.custom_url, 1 AS distance
+	FROM custom_pages p
+	JOIN custom_pages c ON c.parent_id = p.id
+	WHERE c.id = ?
+	UNION ALL
+	SELECT p.id, p.parent_id, p.custom_url, a.distance + 1
+	FROM custom_pages p
+	JOIN ancestors a ON a.parent_id = p.id
+)`
+
 type CustomPageRepo struct {
 	*postgres.Postgres
 }
@@ -22,53 +42,50 @@ func NewPostgresCustomPageRepo(pg *postgres.Postgres) *CustomPageRepo {
 func (r *CustomPageRepo) Create(ctx context.Context, page *entity.CustomPage) (*entity.CustomPage, error) {
 	query := r.Builder.
 		Insert("custom_pages").
-		Columns("custom_url", "content", "author_id").
-		Values(page.CustomURL, page.Content, page.AuthorID).
-		Suffix("RETURNING id, custom_url, content, author_id, created_at, updated_at")
+		Columns(
+			"parent_id", "position", "slug", "custom_url", "content", "content_format", "content_html", "content_text",
+			"author_id", "locale", "translation_group",
+		).
+		Values(
+			nullString(page.ParentID), page.Position, page.Slug, page.CustomURL,
+			page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.AuthorID,
+			page.Locale, translationGroup(page.TranslationGroup),
+		).
+		Suffix("RETURNING " + strings.Join(customPageColumns, ", "))
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	var result entity.CustomPage
-
-	err = r.DB.QueryRowContext(ctx, sqlQuery, args...).Scan(
-		&result.ID,
-		&result.CustomURL,
-		&result.Content,
-		&result.AuthorID,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	result, err := scanCustomPage(r.DB.QueryRowContext(ctx, sqlQuery, args...))
 	if err != nil {
-		return nil, err
+		return nil, mapError(err)
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 func (r *CustomPageRepo) GetByID(ctx context.Context, id string) (*entity.CustomPage, error) {
+	return r.getOne(ctx, squirrel.Eq{"id": id})
+}
+
+func (r *CustomPageRepo) GetByURL(ctx context.Context, customURL string) (*entity.CustomPage, error) {
+	return r.getOne(ctx, squirrel.Eq{"custom_url": customURL})
+}
+
+func (r *CustomPageRepo) getOne(ctx context.Context, where squirrel.Eq) (*entity.CustomPage, error) {
 	query := r.Builder.
-		Select("id", "custom_url", "content", "author_id", "created_at", "updated_at").
+		Select(customPageColumns...).
 		From("custom_pages").
-		Where(squirrel.Eq{"id": id})
+		Where(where)
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	var page entity.CustomPage
-
-	err = r.DB.QueryRowContext(ctx, sqlQuery, args...).Scan(
-		&page.ID,
-		&page.CustomURL,
-		&page.Content,
-		&page.AuthorID,
-		&page.CreatedAt,
-		&page.UpdatedAt,
-	)
+	page, err := scanCustomPage(r.DB.QueryRowContext(ctx, sqlQuery, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, apperror.ErrNotFound
@@ -77,15 +94,30 @@ func (r *CustomPageRepo) GetByID(ctx context.Context, id string) (*entity.Custom
 		return nil, err
 	}
 
-	return &page, nil
+	return page, nil
 }
 
 func (r *CustomPageRepo) GetAll(ctx context.Context) ([]entity.CustomPage, error) {
 	query := r.Builder.
-		Select("id", "custom_url", "content", "author_id", "created_at", "updated_at").
+		Select(customPageColumns...).
 		From("custom_pages").
 		OrderBy("created_at DESC")
 
+	return r.getMany(ctx, query)
+}
+
+// GetChildren returns the direct children of a page in position order.
+func (r *CustomPageRepo) GetChildren(ctx context.Context, parentID string) ([]entity.CustomPage, error) {
+	query := r.Builder.
+		Select(customPageColumns...).
+		From("custom_pages").
+		Where(squirrel.Eq{"parent_id": parentID}).
+		OrderBy("position", "custom_url")
+
+	return r.getMany(ctx, query)
+}
+
+func (r *CustomPageRepo) getMany(ctx context.Context, query squirrel.SelectBuilder) ([]entity.CustomPage, error) {
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
@@ -100,21 +132,12 @@ func (r *CustomPageRepo) GetAll(ctx context.Context) ([]entity.CustomPage, error
 	var pages []entity.CustomPage
 
 	for rows.Next() {
-		var page entity.CustomPage
-
-		err := rows.Scan(
-			&page.ID,
-			&page.CustomURL,
-			&page.Content,
-			&page.AuthorID,
-			&page.CreatedAt,
-			&page.UpdatedAt,
-		)
+		page, err := scanCustomPage(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		pages = append(pages, page)
+		pages = append(pages, *page)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -128,24 +151,115 @@ func (r *CustomPageRepo) GetAll(ctx context.Context) ([]entity.CustomPage, error
 	return pages, nil
 }
 
+// GetAncestors returns the ID and custom URL of every ancestor of a page,
+// starting from the root.
+func (r *CustomPageRepo) GetAncestors(ctx context.Context, id string) ([]entity.CustomPage, error) {
+	sqlQuery, args, err := r.Builder.
+		Select("id", "custom_url").
+		Prefix(pageAncestorsCTE, id).
+		From("ancestors").
+		OrderBy("distance DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ancestors := []entity.CustomPage{}
+
+	for rows.Next() {
+		var page entity.CustomPage
+
+		if err := rows.Scan(&page.ID, &page.CustomURL); err != nil {
+			return nil, err
+		}
+
+		ancestors = append(ancestors, page)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ancestors, nil
+}
+
 func (r *CustomPageRepo) Update(ctx context.Context, page *entity.CustomPage) error {
+	return r.exec(ctx, r.DB, r.updateQuery(page))
+}
+
+func (r *CustomPageRepo) updateQuery(page *entity.CustomPage) squirrel.UpdateBuilder {
 	query := r.Builder.
 		Update("custom_pages").
+		Set("parent_id", nullString(page.ParentID)).
+		Set("position", page.Position).
+		Set("slug", page.Slug).
 		Set("custom_url", page.CustomURL).
 		Set("content", page.Content).
+		Set("content_format", page.ContentFormat).
+		Set("content_html", page.ContentHTML).
+		Set("content_text", page.ContentText).
 		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
 		Where(squirrel.Eq{"id": page.ID})
 
-	sqlQuery, args, err := query.ToSql()
+	if page.Locale != "" {
+		query = query.Set("locale", page.Locale)
+	}
+
+	return query
+}
+
+// Move saves page and moves each page in moves, page itself included, to its
+// new custom URL, leaving the rest of it as is. The old URL and every
+// redirect to it lead to the new one. Either every page moves or none does.
+func (r *CustomPageRepo) Move(ctx context.Context, page *entity.CustomPage, moves []entity.PageMove) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
 
-	result, err := r.DB.ExecContext(ctx, sqlQuery, args...)
+	if err = r.exec(ctx, tx, r.updateQuery(page)); err != nil {
+		return err
+	}
+
+	for _, move := range moves {
+		if move.ID != page.ID {
+			query := r.Builder.
+				Update("custom_pages").
+				Set("custom_url", move.To).
+				Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+				Where(squirrel.Eq{"id": move.ID})
+
+			if err = r.exec(ctx, tx, query); err != nil {
+				return err
+			}
+		}
+
+		if err = redirectMoved(ctx, tx, r.Builder, move.From, move.To); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *CustomPageRepo) exec(ctx context.Context, db execer, query squirrel.UpdateBuilder) error {
+	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return err
 	}
 
+	result, err := db.ExecContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return mapError(err)
+	}
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return err
@@ -184,3 +298,29 @@ func (r *CustomPageRepo) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func scanCustomPage(row rowScanner) (*entity.CustomPage, error) {
+	var page entity.CustomPage
+
+	err := row.Scan(
+		&page.ID,
+		&page.ParentID,
+		&page.Position,
+		&page.Slug,
+		&page.CustomURL,
+		&page.Content,
+		&page.ContentFormat,
+		&page.ContentHTML,
+		&page.ContentText,
+		&page.AuthorID,
+		&page.CreatedAt,
+		&page.UpdatedAt,
+		&page.Locale,
+		&page.TranslationGroup,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
@@ -11,23 +11,39 @@ import (
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
 	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 const (
-	sqlInsertPage        = `INSERT INTO custom_pages \(custom_url,content,author_id\) VALUES \(\$1,\$2,\$3\) RETURNING id, custom_url, content, author_id, created_at, updated_at`
-	sqlSelectPage        = `SELECT id, custom_url, content, author_id, created_at, updated_at FROM custom_pages WHERE id = \$1`
-	sqlSelectAllPages    = `SELECT id, custom_url, content, author_id, created_at, updated_at FROM custom_pages ORDER BY created_at DESC`
-	sqlUpdatePage        = `UPDATE custom_pages SET custom_url = \$1, content = \$2, updated_at = CURRENT_TIMESTAMP WHERE id = \$3`
-	sqlDeletePage        = `DELETE FROM custom_pages WHERE id = \$1`
-	testPageID           = "550e8400-e29b-41d4-a716-446655440000"
-	testPageAuthorID     = "550e8400-e29b-41d4-a716-446655440001"
-	nonExistentPageID    = "550e8400-e29b-41d4-a716-999999999999"
-	testCustomURL        = "/about-us"
-	testCustomURLUpdated = "/about-company"
+	sqlSelectPages = `SELECT id, COALESCE\(parent_id::text, ''\), position, slug, custom_url, content, content_format, content_html, content_text, author_id, created_at, updated_at, locale, translation_group FROM custom_pages`
+	sqlInsertPage  = `INSERT INTO custom_pages \(parent_id,position,slug,custom_url,content,content_format,content_html,content_text,` +
+		`author_id,locale,translation_group\) VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,\$7,\$8,\$9,\$10,COALESCE\(\$11::uuid, uuid_generate_v4\(\)\)\) RETURNING id, COALESCE\(parent_id::text, ''\), position, slug, custom_url, content, ` +
+		`content_format, content_html, content_text, author_id, created_at, updated_at, locale, translation_group`
+	sqlSelectPage         = sqlSelectPages + ` WHERE id = \$1`
+	sqlSelectPageByURL    = sqlSelectPages + ` WHERE custom_url = \$1`
+	sqlSelectAllPages     = sqlSelectPages + ` ORDER BY created_at DESC`
+	sqlSelectPageChildren = sqlSelectPages + ` WHERE parent_id = \$1 ORDER BY position, custom_url`
+	sqlSelectAncestors    = `WITH RECURSIVE ancestors AS \(.*\) SELECT id, custom_url FROM ancestors ORDER BY distance DESC`
+	sqlUpdatePage         = `UPDATE custom_pages SET parent_id = \$1, position = \$2, slug = \$3, custom_url = \$4, content = \$5, ` +
+		`content_format = \$6, content_html = \$7, content_text = \$8, updated_at = CURRENT_TIMESTAMP WHERE id = \$9`
+	sqlUpdatePageURL          = `UPDATE custom_pages SET custom_url = \$1, updated_at = CURRENT_TIMESTAMP WHERE id = \$2`
+	sqlDeleteRedirectBySource = `DELETE FROM redirects WHERE source_path = \$1`
+	sqlDeletePage             = `DELETE FROM custom_pages WHERE id = \$1`
+	testPageID                = "550e8400-e29b-41d4-a716-446655440000"
+	testPageAuthorID          = "550e8400-e29b-41d4-a716-446655440001"
+	nonExistentPageID         = "550e8400-e29b-41d4-a716-999999999999"
+	testCustomURL             = "/about-us"
+	testCustomURLUpdated      = "/about-company"
+	testPageGroupID           = "550e8400-e29b-41d4-a716-446655440078"
 )
 
+var pageRowColumns = []string{
+	"id", "parent_id", "position", "slug", "custom_url", "content", "content_format", "content_html", "content_text",
+	"author_id", "created_at", "updated_at", "locale", "translation_group",
+}
+
 func setupPageMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *CustomPageRepo) {
 	t.Helper()
 
@@ -65,11 +81,11 @@ func TestCustomPageRepo_Create(t *testing.T) {
 			UpdatedAt: now,
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "custom_url", "content", "author_id", "created_at", "updated_at"}).
-			AddRow(expectedPage.ID, expectedPage.CustomURL, expectedPage.Content, expectedPage.AuthorID, expectedPage.CreatedAt, expectedPage.UpdatedAt)
+		rows := sqlmock.NewRows(pageRowColumns).
+			AddRow(expectedPage.ID, "", 0, "", expectedPage.CustomURL, expectedPage.Content, "html", expectedPage.Content, expectedPage.Content, expectedPage.AuthorID, expectedPage.CreatedAt, expectedPage.UpdatedAt, "en", testPageGroupID)
 
 		mock.ExpectQuery(sqlInsertPage).
-			WithArgs(page.CustomURL, page.Content, page.AuthorID).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.AuthorID, "", nil).
 			WillReturnRows(rows)
 
 		result, err := repo.Create(context.Background(), page)
@@ -93,7 +109,7 @@ func TestCustomPageRepo_Create(t *testing.T) {
 		}
 
 		mock.ExpectQuery(sqlInsertPage).
-			WithArgs(page.CustomURL, page.Content, page.AuthorID).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.AuthorID, "", nil).
 			WillReturnError(apperror.ErrDatabaseConnection)
 
 		result, err := repo.Create(context.Background(), page)
@@ -104,6 +120,27 @@ func TestCustomPageRepo_Create(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("error - custom url already exists", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		page := &entity.CustomPage{
+			CustomURL: testCustomURL,
+			Content:   "This is the about us page content",
+			AuthorID:  testPageAuthorID,
+		}
+
+		mock.ExpectQuery(sqlInsertPage).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.AuthorID, "", nil).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		result, err := repo.Create(context.Background(), page)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrDuplicateKey, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("success - create custom page with long content", func(t *testing.T) {
 		db, mock, repo := setupPageMockDB(t)
 		defer db.Close()
@@ -117,11 +154,11 @@ func TestCustomPageRepo_Create(t *testing.T) {
 
 		now := time.Now()
 
-		rows := sqlmock.NewRows([]string{"id", "custom_url", "content", "author_id", "created_at", "updated_at"}).
-			AddRow(testPageID, testCustomURL, longContent, testPageAuthorID, now, now)
+		rows := sqlmock.NewRows(pageRowColumns).
+			AddRow(testPageID, "", 0, "", testCustomURL, longContent, "html", longContent, longContent, testPageAuthorID, now, now, "en", testPageGroupID)
 
 		mock.ExpectQuery(sqlInsertPage).
-			WithArgs(page.CustomURL, page.Content, page.AuthorID).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.AuthorID, "", nil).
 			WillReturnRows(rows)
 
 		result, err := repo.Create(context.Background(), page)
@@ -148,8 +185,8 @@ func TestCustomPageRepo_GetByID(t *testing.T) {
 			UpdatedAt: now,
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "custom_url", "content", "author_id", "created_at", "updated_at"}).
-			AddRow(expectedPage.ID, expectedPage.CustomURL, expectedPage.Content, expectedPage.AuthorID, expectedPage.CreatedAt, expectedPage.UpdatedAt)
+		rows := sqlmock.NewRows(pageRowColumns).
+			AddRow(expectedPage.ID, "", 0, "", expectedPage.CustomURL, expectedPage.Content, "html", expectedPage.Content, expectedPage.Content, expectedPage.AuthorID, expectedPage.CreatedAt, expectedPage.UpdatedAt, "en", testPageGroupID)
 
 		mock.ExpectQuery(sqlSelectPage).
 			WithArgs(expectedPage.ID).
@@ -197,6 +234,45 @@ func TestCustomPageRepo_GetByID(t *testing.T) {
 	})
 }
 
+func TestCustomPageRepo_GetByURL(t *testing.T) {
+	t.Run("success - get custom page by url", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		rows := sqlmock.NewRows(pageRowColumns).
+			AddRow(testPageID, "", 0, "", testCustomURL, "This is the about us page content", "html", "This is the about us page content", "This is the about us page content", testPageAuthorID, now, now, "en", testPageGroupID)
+
+		mock.ExpectQuery(sqlSelectPageByURL).
+			WithArgs(testCustomURL).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByURL(context.Background(), testCustomURL)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, testPageID, result.ID)
+		assert.Equal(t, testCustomURL, result.CustomURL)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - custom page not found", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectPageByURL).
+			WithArgs(testCustomURLUpdated).
+			WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.GetByURL(context.Background(), testCustomURLUpdated)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestCustomPageRepo_GetAll(t *testing.T) {
 	t.Run("success - get all custom pages", func(t *testing.T) {
 		db, mock, repo := setupPageMockDB(t)
@@ -204,10 +280,10 @@ func TestCustomPageRepo_GetAll(t *testing.T) {
 
 		now := time.Now()
 
-		rows := sqlmock.NewRows([]string{"id", "custom_url", "content", "author_id", "created_at", "updated_at"}).
-			AddRow("550e8400-e29b-41d4-a716-446655440001", "/about-us", "About content", testPageAuthorID, now, now).
-			AddRow("550e8400-e29b-41d4-a716-446655440002", "/contact", "Contact content", testPageAuthorID, now, now).
-			AddRow("550e8400-e29b-41d4-a716-446655440003", "/privacy-policy", "Privacy content", testPageAuthorID, now, now)
+		rows := sqlmock.NewRows(pageRowColumns).
+			AddRow("550e8400-e29b-41d4-a716-446655440001", "", 0, "", "/about-us", "About content", "html", "About content", "About content", testPageAuthorID, now, now, "en", testPageGroupID).
+			AddRow("550e8400-e29b-41d4-a716-446655440002", "", 0, "", "/contact", "Contact content", "html", "Contact content", "Contact content", testPageAuthorID, now, now, "en", testPageGroupID).
+			AddRow("550e8400-e29b-41d4-a716-446655440003", "", 0, "", "/privacy-policy", "Privacy content", "html", "Privacy content", "Privacy content", testPageAuthorID, now, now, "en", testPageGroupID)
 
 		mock.ExpectQuery(sqlSelectAllPages).
 			WillReturnRows(rows)
@@ -227,7 +303,7 @@ func TestCustomPageRepo_GetAll(t *testing.T) {
 		db, mock, repo := setupPageMockDB(t)
 		defer db.Close()
 
-		rows := sqlmock.NewRows([]string{"id", "custom_url", "content", "author_id", "created_at", "updated_at"})
+		rows := sqlmock.NewRows(pageRowColumns)
 
 		mock.ExpectQuery(sqlSelectAllPages).
 			WillReturnRows(rows)
@@ -268,7 +344,7 @@ func TestCustomPageRepo_Update(t *testing.T) {
 		}
 
 		mock.ExpectExec(sqlUpdatePage).
-			WithArgs(page.CustomURL, page.Content, page.ID).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.ID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
 		err := repo.Update(context.Background(), page)
@@ -277,6 +353,26 @@ func TestCustomPageRepo_Update(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("error - custom url already exists", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		page := &entity.CustomPage{
+			ID:        testPageID,
+			CustomURL: testCustomURLUpdated,
+			Content:   "Updated content",
+		}
+
+		mock.ExpectExec(sqlUpdatePage).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.ID).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		err := repo.Update(context.Background(), page)
+
+		assert.Equal(t, apperror.ErrDuplicateKey, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("error - custom page not found", func(t *testing.T) {
 		db, mock, repo := setupPageMockDB(t)
 		defer db.Close()
@@ -288,7 +384,7 @@ func TestCustomPageRepo_Update(t *testing.T) {
 		}
 
 		mock.ExpectExec(sqlUpdatePage).
-			WithArgs(page.CustomURL, page.Content, page.ID).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.ID).
 			WillReturnResult(sqlmock.NewResult(0, 0))
 
 		err := repo.Update(context.Background(), page)
@@ -309,7 +405,7 @@ func TestCustomPageRepo_Update(t *testing.T) {
 		}
 
 		mock.ExpectExec(sqlUpdatePage).
-			WithArgs(page.CustomURL, page.Content, page.ID).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.ID).
 			WillReturnError(apperror.ErrDatabaseConnection)
 
 		err := repo.Update(context.Background(), page)
@@ -365,3 +461,108 @@ func TestCustomPageRepo_Delete(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestCustomPageRepo_GetChildren(t *testing.T) {
+	t.Run("success - children in position order", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectPageChildren).
+			WithArgs(testPageID).
+			WillReturnRows(sqlmock.NewRows(pageRowColumns).
+				AddRow(nonExistentPageID, testPageID, 0, "team", "/about-us/team", "Team", "html", "Team", "Team", testPageAuthorID, now, now, "en", testPageGroupID))
+
+		result, err := repo.GetChildren(context.Background(), testPageID)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, testPageID, result[0].ParentID)
+		assert.Equal(t, "team", result[0].Slug)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCustomPageRepo_GetAncestors(t *testing.T) {
+	t.Run("success - ancestors from the root", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectAncestors).
+			WithArgs(nonExistentPageID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "custom_url"}).
+				AddRow(testPageAuthorID, "/help").
+				AddRow(testPageID, "/help/billing"))
+
+		result, err := repo.GetAncestors(context.Background(), nonExistentPageID)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "/help", result[0].CustomURL)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestCustomPageRepo_Move(t *testing.T) {
+	const childID = "550e8400-e29b-41d4-a716-446655440002"
+
+	page := &entity.CustomPage{ID: testPageID, CustomURL: "/support", Content: "Updated content"}
+	moves := []entity.PageMove{
+		{ID: testPageID, From: "/help", To: "/support"},
+		{ID: childID, From: "/help/billing", To: "/support/billing"},
+	}
+
+	expectRedirect := func(mock sqlmock.Sqlmock, from, to string) {
+		mock.ExpectExec(sqlDeleteRedirectBySource).
+			WithArgs(to).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(sqlUpsertRedirect).
+			WithArgs(from, to, 301, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(sqlRetargetRedirects).
+			WithArgs(to, from, to).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+	}
+
+	t.Run("success - move page and descendants", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlUpdatePage).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectRedirect(mock, "/help", "/support")
+		mock.ExpectExec(sqlUpdatePageURL).
+			WithArgs("/support/billing", childID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectRedirect(mock, "/help/billing", "/support/billing")
+		mock.ExpectCommit()
+
+		err := repo.Move(context.Background(), page, moves)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - descendant url already exists rolls back", func(t *testing.T) {
+		db, mock, repo := setupPageMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlUpdatePage).
+			WithArgs(nil, 0, "", page.CustomURL, page.Content, page.ContentFormat, page.ContentHTML, page.ContentText, page.ID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		expectRedirect(mock, "/help", "/support")
+		mock.ExpectExec(sqlUpdatePageURL).
+			WithArgs("/support/billing", childID).
+			WillReturnError(&pq.Error{Code: "23505"})
+		mock.ExpectRollback()
+
+		err := repo.Move(context.Background(), page, moves)
+
+		assert.Equal(t, apperror.ErrDuplicateKey, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/lib/pq"
+)
+
+// mediaColumns are selected, in scan order, wherever a media file is read.
+var mediaColumns = []string{
+	"id", "storage_key", "filename", "mime_type", "size", "width", "height", "alt_text",
+	"focal_x", "focal_y", "COALESCE(uploader_id::text, '')",
+	"(SELECT COUNT(*) FROM media_references r WHERE r.media_id = media.id)",
+	"created_at", "updated_at",
+}
+
+// mediaOwnerColumns hold the ID of each kind of content referencing media.
+var mediaOwnerColumns = map[string]string{
+	entity.MediaOwnerNews: "news_id",
+	entity.MediaOwnerPage: "page_id",
+}
+
+type MediaRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresMediaRepo(pg *postgres.Postgres) *MediaRepo {
+	return &MediaRepo{pg}
+}
+
+func (r *MediaRepo) Create(ctx context.Context, media *entity.Media) (*entity.Media, error) {
+	query, args, err := r.Builder.
+		Insert("media").
+		Columns(
+			"storage_key", "filename", "mime_type", "size", "width", "height", "alt_text",
+			"focal_x", "focal_y", "uploader_id",
+		).
+		Values(
+			media.StorageKey, media.Filename, media.MIMEType, media.Size, media.Width, media.Height,
+			media.AltText, media.FocalX, media.FocalY, nullString(media.UploaderID),
+		).
+		Suffix("RETURNING " + strings.Join(mediaColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := scanMedia(r.DB.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return result, nil
+}
+
+func (r *MediaRepo) GetByID(ctx context.Context, id string) (*entity.Media, error) {
+	return r.getOne(ctx, squirrel.Eq{"id": id})
+}
+
+func (r *MediaRepo) GetByKey(ctx context.Context, storageKey string) (*entity.Media, error) {
+	return r.getOne(ctx, squirrel.Eq{"storage_key": storageKey})
+}
+
+func (r *MediaRepo) getOne(ctx context.Context, where squirrel.Eq) (*entity.Media, error) {
+	query, args, err := r.Builder.
+		Select(mediaColumns...).
+		From("media").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := scanMedia(r.DB.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetAll returns every media file, newest first.
+func (r *MediaRepo) GetAll(ctx context.Context) ([]entity.Media, error) {
+	query, args, err := r.Builder.
+		Select(mediaColumns...).
+		From("media").
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	mediaList := []entity.Media{}
+
+	for rows.Next() {
+		media, err := scanMedia(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		mediaList = append(mediaList, *media)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mediaList, nil
+}
+
+// Update saves the metadata of a media file: its alt text and focal point.
+func (r *MediaRepo) Update(ctx context.Context, media *entity.Media) error {
+	query, args, err := r.Builder.
+		Update("media").
+		Set("alt_text", media.AltText).
+		Set("focal_x", media.FocalX).
+		Set("focal_y", media.FocalY).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": media.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	return r.execOne(ctx, query, args)
+}
+
+// Delete removes a media file's row. Files still referenced by content are
+// not deleted and fail with apperror.ErrMediaInUse.
+func (r *MediaRepo) Delete(ctx context.Context, id string) error {
+	query, args, err := r.Builder.
+		Delete("media").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	err = r.execOne(ctx, query, args)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation {
+		return apperror.ErrMediaInUse
+	}
+
+	return err
+}
+
+func (r *MediaRepo) execOne(ctx context.Context, query string, args []interface{}) error {
+	result, err := r.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetReferences lists the content embedding a media file, news first.
+func (r *MediaRepo) GetReferences(ctx context.Context, id string) ([]entity.MediaReference, error) {
+	query, args, err := r.Builder.
+		Select(
+			"CASE WHEN news_id IS NOT NULL THEN '"+entity.MediaOwnerNews+"' ELSE '"+entity.MediaOwnerPage+"' END AS owner_type",
+			"COALESCE(news_id, page_id)::text",
+		).
+		From("media_references").
+		Where(squirrel.Eq{"media_id": id}).
+		OrderBy("owner_type", "2").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	references := []entity.MediaReference{}
+
+	for rows.Next() {
+		var reference entity.MediaReference
+		if err := rows.Scan(&reference.OwnerType, &reference.OwnerID); err != nil {
+			return nil, err
+		}
+
+		references = append(references, reference)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return references, nil
+}
+
+// SetReferences replaces the media referenced by a news article or custom
+// page with the files stored under storageKeys, in one transaction. Keys of
+// files not in the library are ignored.
+func (r *MediaRepo) SetReferences(ctx context.Context, ownerType, ownerID string, storageKeys []string) error {
+	column, ok := mediaOwnerColumns[ownerType]
+	if !ok {
+		return apperror.ErrNotFound
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	deleteSQL, deleteArgs, err := r.Builder.
+		Delete("media_references").
+		Where(squirrel.Eq{column: ownerID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteSQL, deleteArgs...); err != nil {
+		return err
+	}
+
+	if len(storageKeys) > 0 {
+		insertSQL, insertArgs, err := r.Builder.
+			Insert("media_references").
+			Columns("media_id", column).
+			Select(squirrel.
+				Select("id").
+				Column(squirrel.Expr("?::uuid", ownerID)).
+				From("media").
+				Where(squirrel.Eq{"storage_key": storageKeys})).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if _, err = tx.ExecContext(ctx, insertSQL, insertArgs...); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func scanMedia(row rowScanner) (*entity.Media, error) {
+	var media entity.Media
+
+	err := row.Scan(
+		&media.ID,
+		&media.StorageKey,
+		&media.Filename,
+		&media.MIMEType,
+		&media.Size,
+		&media.Width,
+		&media.Height,
+		&media.AltText,
+		&media.FocalX,
+		&media.FocalY,
+		&media.UploaderID,
+		&media.ReferenceCount,
+		&media.CreatedAt,
+		&media.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &media, nil
+}
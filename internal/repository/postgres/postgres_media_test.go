@@ -0,0 +1,299 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlMediaColumns = `id, storage_key, filename, mime_type, size, width, height, alt_text, focal_x, focal_y, ` +
+		`COALESCE\(uploader_id::text, ''\), ` +
+		`\(SELECT COUNT\(\*\) FROM media_references r WHERE r.media_id = media.id\), created_at, updated_at`
+	sqlInsertMedia = `INSERT INTO media \(storage_key,filename,mime_type,size,width,height,alt_text,focal_x,focal_y,uploader_id\) ` +
+		`VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,\$7,\$8,\$9,\$10\) RETURNING ` + sqlMediaColumns
+	sqlSelectMediaByKey = `SELECT ` + sqlMediaColumns + ` FROM media WHERE storage_key = \$1`
+	sqlSelectAllMedia   = `SELECT ` + sqlMediaColumns + ` FROM media ORDER BY created_at DESC`
+	sqlUpdateMedia      = `UPDATE media SET alt_text = \$1, focal_x = \$2, focal_y = \$3, ` +
+		`updated_at = CURRENT_TIMESTAMP WHERE id = \$4`
+	sqlDeleteMedia     = `DELETE FROM media WHERE id = \$1`
+	sqlSelectMediaRefs = `SELECT CASE WHEN news_id IS NOT NULL THEN 'news' ELSE 'page' END AS owner_type, ` +
+		`COALESCE\(news_id, page_id\)::text FROM media_references WHERE media_id = \$1 ORDER BY owner_type, 2`
+	sqlDeleteNewsMediaRefs = `DELETE FROM media_references WHERE news_id = \$1`
+	sqlInsertNewsMediaRefs = `INSERT INTO media_references \(media_id,news_id\) ` +
+		`SELECT id, \$1::uuid FROM media WHERE storage_key IN \(\$2,\$3\)`
+	testMediaID         = "550e8400-e29b-41d4-a716-446655440030"
+	testMediaKey        = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.png"
+	testMediaOtherKey   = "60303ae22b998861bce3b28f33eec1be758a213c86c93c076dbe9f558c11c752.pdf"
+	testMediaUploaderID = "550e8400-e29b-41d4-a716-446655440031"
+	testMediaNewsID     = "550e8400-e29b-41d4-a716-446655440032"
+)
+
+var mediaRowColumns = []string{
+	"id", "storage_key", "filename", "mime_type", "size", "width", "height", "alt_text",
+	"focal_x", "focal_y", "uploader_id", "reference_count", "created_at", "updated_at",
+}
+
+func setupMediaMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *MediaRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresMediaRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestMediaRepo_Create(t *testing.T) {
+	t.Run("success - create media", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		media := &entity.Media{
+			StorageKey: testMediaKey,
+			Filename:   "photo.png",
+			MIMEType:   "image/png",
+			Size:       2048,
+			Width:      640,
+			Height:     480,
+			FocalX:     0.5,
+			FocalY:     0.5,
+			UploaderID: testMediaUploaderID,
+		}
+
+		mock.ExpectQuery(sqlInsertMedia).
+			WithArgs(testMediaKey, "photo.png", "image/png", int64(2048), 640, 480, "", 0.5, 0.5, testMediaUploaderID).
+			WillReturnRows(sqlmock.NewRows(mediaRowColumns).
+				AddRow(testMediaID, testMediaKey, "photo.png", "image/png", 2048, 640, 480, "", 0.5, 0.5, testMediaUploaderID, 0, now, now))
+
+		result, err := repo.Create(context.Background(), media)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testMediaID, result.ID)
+		assert.Equal(t, 640, result.Width)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - file already stored", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlInsertMedia).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		result, err := repo.Create(context.Background(), &entity.Media{StorageKey: testMediaKey})
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrDuplicateKey, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMediaRepo_GetByKey(t *testing.T) {
+	t.Run("success - get media by storage key", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectMediaByKey).
+			WithArgs(testMediaKey).
+			WillReturnRows(sqlmock.NewRows(mediaRowColumns).
+				AddRow(testMediaID, testMediaKey, "photo.png", "image/png", 2048, 640, 480, "A photo", 0.5, 0.25, "", 2, now, now))
+
+		result, err := repo.GetByKey(context.Background(), testMediaKey)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testMediaID, result.ID)
+		assert.Equal(t, 2, result.ReferenceCount)
+		assert.Equal(t, 0.25, result.FocalY)
+		assert.Empty(t, result.UploaderID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectMediaByKey).
+			WithArgs(testMediaKey).
+			WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.GetByKey(context.Background(), testMediaKey)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMediaRepo_GetAll(t *testing.T) {
+	t.Run("success - newest first", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectAllMedia).
+			WillReturnRows(sqlmock.NewRows(mediaRowColumns).
+				AddRow(testMediaID, testMediaKey, "photo.png", "image/png", 2048, 640, 480, "", 0.5, 0.5, "", 0, now, now))
+
+		result, err := repo.GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMediaRepo_Update(t *testing.T) {
+	t.Run("success - update alt text and focal point", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpdateMedia).
+			WithArgs("A photo", 0.25, 0.75, testMediaID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(context.Background(), &entity.Media{ID: testMediaID, AltText: "A photo", FocalX: 0.25, FocalY: 0.75})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpdateMedia).
+			WithArgs("A photo", 0.5, 0.5, testMediaID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Update(context.Background(), &entity.Media{ID: testMediaID, AltText: "A photo", FocalX: 0.5, FocalY: 0.5})
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMediaRepo_Delete(t *testing.T) {
+	t.Run("success - delete media", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteMedia).
+			WithArgs(testMediaID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Delete(context.Background(), testMediaID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - media still referenced", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteMedia).
+			WithArgs(testMediaID).
+			WillReturnError(&pq.Error{Code: "23503"})
+
+		err := repo.Delete(context.Background(), testMediaID)
+
+		assert.Equal(t, apperror.ErrMediaInUse, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMediaRepo_GetReferences(t *testing.T) {
+	t.Run("success - list references", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectMediaRefs).
+			WithArgs(testMediaID).
+			WillReturnRows(sqlmock.NewRows([]string{"owner_type", "owner_id"}).
+				AddRow(entity.MediaOwnerNews, testMediaNewsID))
+
+		result, err := repo.GetReferences(context.Background(), testMediaID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.MediaReference{{OwnerType: entity.MediaOwnerNews, OwnerID: testMediaNewsID}}, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMediaRepo_SetReferences(t *testing.T) {
+	t.Run("success - replace references of a news article", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlDeleteNewsMediaRefs).
+			WithArgs(testMediaNewsID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(sqlInsertNewsMediaRefs).
+			WithArgs(testMediaNewsID, testMediaKey, testMediaOtherKey).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.SetReferences(context.Background(), entity.MediaOwnerNews, testMediaNewsID,
+			[]string{testMediaKey, testMediaOtherKey})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - no media clears references", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlDeleteNewsMediaRefs).
+			WithArgs(testMediaNewsID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.SetReferences(context.Background(), entity.MediaOwnerNews, testMediaNewsID, nil)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - insert fails rolls back", func(t *testing.T) {
+		db, mock, repo := setupMediaMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlDeleteNewsMediaRefs).
+			WithArgs(testMediaNewsID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(sqlInsertNewsMediaRefs).
+			WillReturnError(apperror.ErrDatabaseConnection)
+		mock.ExpectRollback()
+
+		err := repo.SetReferences(context.Background(), entity.MediaOwnerNews, testMediaNewsID,
+			[]string{testMediaKey, testMediaOtherKey})
+
+		assert.ErrorIs(t, err, apperror.ErrDatabaseConnection)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
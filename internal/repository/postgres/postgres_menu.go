@@ -0,0 +1,254 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+type MenuRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresMenuRepo(pg *postgres.Postgres) *MenuRepo {
+	return &MenuRepo{pg}
+}
+
+// GetAll returns every menu without its items.
+func (r *MenuRepo) GetAll(ctx context.Context) ([]entity.Menu, error) {
+	query, args, err := r.Builder.
+		Select("id", "name", "created_at", "updated_at").
+		From("menus").
+		OrderBy("name").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	menus := []entity.Menu{}
+
+	for rows.Next() {
+		var menu entity.Menu
+
+		if err := rows.Scan(&menu.ID, &menu.Name, &menu.CreatedAt, &menu.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		menus = append(menus, menu)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return menus, nil
+}
+
+// GetByName returns a menu with its items as a flat list, each resolved
+// against the page, category or news article it references.
+func (r *MenuRepo) GetByName(ctx context.Context, name string) (*entity.Menu, error) {
+	query, args, err := r.Builder.
+		Select("id", "name", "created_at", "updated_at").
+		From("menus").
+		Where(squirrel.Eq{"name": name}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var menu entity.Menu
+
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&menu.ID, &menu.Name, &menu.CreatedAt, &menu.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	menu.Items, err = r.getItems(ctx, menu.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &menu, nil
+}
+
+func (r *MenuRepo) getItems(ctx context.Context, menuID string) ([]entity.MenuItem, error) {
+	query, args, err := r.Builder.
+		Select(
+			"mi.id",
+			"COALESCE(mi.parent_id::text, '')",
+			"mi.position",
+			"mi.item_type",
+			"COALESCE(mi.target_id::text, '')",
+			"mi.url",
+			"mi.title",
+			"COALESCE(cp.id, c.id, n.id) IS NOT NULL",
+			"COALESCE(c.name, n.title, '')",
+			"COALESCE(cp.custom_url, '')",
+		).
+		From("menu_items mi").
+		LeftJoin("custom_pages cp ON mi.item_type = 'page' AND cp.id = mi.target_id").
+		LeftJoin("categories c ON mi.item_type = 'category' AND c.id = mi.target_id").
+		LeftJoin("news n ON mi.item_type = 'news' AND n.id = mi.target_id").
+		Where(squirrel.Eq{"mi.menu_id": menuID}).
+		OrderBy("mi.position").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []entity.MenuItem{}
+
+	for rows.Next() {
+		var item entity.MenuItem
+
+		err := rows.Scan(
+			&item.ID,
+			&item.ParentID,
+			&item.Position,
+			&item.Type,
+			&item.TargetID,
+			&item.URL,
+			&item.Title,
+			&item.TargetExists,
+			&item.TargetTitle,
+			&item.TargetPath,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// Save creates the menu if needed and replaces its items with the tree in
+// menu.Items, in one transaction.
+func (r *MenuRepo) Save(ctx context.Context, menu *entity.Menu) (*entity.Menu, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	upsertSQL, upsertArgs, err := r.Builder.
+		Insert("menus").
+		Columns("name").
+		Values(menu.Name).
+		Suffix("ON CONFLICT (name) DO UPDATE SET updated_at = CURRENT_TIMESTAMP " +
+			"RETURNING id, name, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	result := entity.Menu{Items: menu.Items}
+
+	err = tx.QueryRowContext(ctx, upsertSQL, upsertArgs...).Scan(&result.ID, &result.Name, &result.CreatedAt, &result.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteSQL, deleteArgs, err := r.Builder.
+		Delete("menu_items").
+		Where(squirrel.Eq{"menu_id": result.ID}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteSQL, deleteArgs...); err != nil {
+		return nil, err
+	}
+
+	if err = r.insertItems(ctx, tx, result.ID, "", result.Items); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// insertItems inserts items under parentID in order, then their children.
+func (r *MenuRepo) insertItems(ctx context.Context, tx *sql.Tx, menuID, parentID string, items []entity.MenuItem) error {
+	for i := range items {
+		item := &items[i]
+		item.ParentID = parentID
+		item.Position = i
+
+		query, args, err := r.Builder.
+			Insert("menu_items").
+			Columns("menu_id", "parent_id", "position", "item_type", "target_id", "url", "title").
+			Values(menuID, nullString(parentID), i, item.Type, nullString(item.TargetID), item.URL, item.Title).
+			Suffix("RETURNING id").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if err = tx.QueryRowContext(ctx, query, args...).Scan(&item.ID); err != nil {
+			return err
+		}
+
+		if err = r.insertItems(ctx, tx, menuID, item.ID, item.Children); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *MenuRepo) Delete(ctx context.Context, name string) error {
+	query, args, err := r.Builder.
+		Delete("menus").
+		Where(squirrel.Eq{"name": name}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
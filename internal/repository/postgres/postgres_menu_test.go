@@ -0,0 +1,229 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlSelectAllMenus   = `SELECT id, name, created_at, updated_at FROM menus ORDER BY name`
+	sqlSelectMenuByName = `SELECT id, name, created_at, updated_at FROM menus WHERE name = \$1`
+	sqlSelectMenuItems  = `SELECT mi.id, .* FROM menu_items mi ` +
+		`LEFT JOIN custom_pages cp ON mi.item_type = 'page' AND cp.id = mi.target_id ` +
+		`LEFT JOIN categories c ON mi.item_type = 'category' AND c.id = mi.target_id ` +
+		`LEFT JOIN news n ON mi.item_type = 'news' AND n.id = mi.target_id ` +
+		`WHERE mi.menu_id = \$1 ORDER BY mi.position`
+	sqlUpsertMenu = `INSERT INTO menus \(name\) VALUES \(\$1\) ON CONFLICT \(name\) DO UPDATE SET updated_at = CURRENT_TIMESTAMP ` +
+		`RETURNING id, name, created_at, updated_at`
+	sqlDeleteMenuItems = `DELETE FROM menu_items WHERE menu_id = \$1`
+	sqlInsertMenuItem  = `INSERT INTO menu_items \(menu_id,parent_id,position,item_type,target_id,url,title\) ` +
+		`VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,\$7\) RETURNING id`
+	sqlDeleteMenu = `DELETE FROM menus WHERE name = \$1`
+
+	testMenuID     = "550e8400-e29b-41d4-a716-446655440030"
+	testMenuItemID = "550e8400-e29b-41d4-a716-446655440031"
+	testChildID    = "550e8400-e29b-41d4-a716-446655440032"
+	testTargetID   = "550e8400-e29b-41d4-a716-446655440033"
+)
+
+var (
+	menuRowColumns     = []string{"id", "name", "created_at", "updated_at"}
+	menuItemRowColumns = []string{
+		"id", "parent_id", "position", "item_type", "target_id", "url", "title",
+		"target_exists", "target_title", "target_path",
+	}
+)
+
+func setupMenuMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *MenuRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresMenuRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestMenuRepo_GetAll(t *testing.T) {
+	t.Run("success - list menus", func(t *testing.T) {
+		db, mock, repo := setupMenuMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectAllMenus).
+			WillReturnRows(sqlmock.NewRows(menuRowColumns).
+				AddRow(testMenuID, "footer", now, now).
+				AddRow("550e8400-e29b-41d4-a716-446655440039", "main", now, now))
+
+		result, err := repo.GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "footer", result[0].Name)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMenuRepo_GetByName(t *testing.T) {
+	t.Run("success - items resolved against their targets", func(t *testing.T) {
+		db, mock, repo := setupMenuMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectMenuByName).
+			WithArgs("main").
+			WillReturnRows(sqlmock.NewRows(menuRowColumns).AddRow(testMenuID, "main", now, now))
+		mock.ExpectQuery(sqlSelectMenuItems).
+			WithArgs(testMenuID).
+			WillReturnRows(sqlmock.NewRows(menuItemRowColumns).
+				AddRow(testMenuItemID, "", 0, "page", testTargetID, "", "", true, "", "/about-us").
+				AddRow(testChildID, testMenuItemID, 0, "category", testTargetID, "", "", false, "", ""))
+
+		result, err := repo.GetByName(context.Background(), "main")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.True(t, result.Items[0].TargetExists)
+		assert.Equal(t, "/about-us", result.Items[0].TargetPath)
+		assert.Equal(t, testMenuItemID, result.Items[1].ParentID)
+		assert.False(t, result.Items[1].TargetExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - menu not found", func(t *testing.T) {
+		db, mock, repo := setupMenuMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectMenuByName).
+			WithArgs("missing").
+			WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.GetByName(context.Background(), "missing")
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		assert.Nil(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMenuRepo_Save(t *testing.T) {
+	t.Run("success - replace items with nested tree", func(t *testing.T) {
+		db, mock, repo := setupMenuMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		menu := &entity.Menu{
+			Name: "main",
+			Items: []entity.MenuItem{
+				{
+					Type:     entity.MenuItemPage,
+					TargetID: testTargetID,
+					Children: []entity.MenuItem{
+						{Type: entity.MenuItemURL, URL: "https://example.com", Title: "Example"},
+					},
+				},
+			},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlUpsertMenu).
+			WithArgs("main").
+			WillReturnRows(sqlmock.NewRows(menuRowColumns).AddRow(testMenuID, "main", now, now))
+		mock.ExpectExec(sqlDeleteMenuItems).
+			WithArgs(testMenuID).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectQuery(sqlInsertMenuItem).
+			WithArgs(testMenuID, nil, 0, "page", testTargetID, "", "").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testMenuItemID))
+		mock.ExpectQuery(sqlInsertMenuItem).
+			WithArgs(testMenuID, testMenuItemID, 0, "url", nil, "https://example.com", "Example").
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(testChildID))
+		mock.ExpectCommit()
+
+		result, err := repo.Save(context.Background(), menu)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testMenuID, result.ID)
+		assert.Equal(t, testMenuItemID, result.Items[0].ID)
+		assert.Equal(t, testChildID, result.Items[0].Children[0].ID)
+		assert.Equal(t, testMenuItemID, result.Items[0].Children[0].ParentID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - insert fails rolls back", func(t *testing.T) {
+		db, mock, repo := setupMenuMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		menu := &entity.Menu{
+			Name:  "main",
+			Items: []entity.MenuItem{{Type: entity.MenuItemNews, TargetID: testTargetID}},
+		}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlUpsertMenu).
+			WithArgs("main").
+			WillReturnRows(sqlmock.NewRows(menuRowColumns).AddRow(testMenuID, "main", now, now))
+		mock.ExpectExec(sqlDeleteMenuItems).
+			WithArgs(testMenuID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(sqlInsertMenuItem).
+			WithArgs(testMenuID, nil, 0, "news", testTargetID, "", "").
+			WillReturnError(apperror.ErrDatabaseConnection)
+		mock.ExpectRollback()
+
+		result, err := repo.Save(context.Background(), menu)
+
+		assert.ErrorIs(t, err, apperror.ErrDatabaseConnection)
+		assert.Nil(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestMenuRepo_Delete(t *testing.T) {
+	t.Run("success - delete menu", func(t *testing.T) {
+		db, mock, repo := setupMenuMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteMenu).
+			WithArgs("main").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Delete(context.Background(), "main")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - menu not found", func(t *testing.T) {
+		db, mock, repo := setupMenuMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteMenu).
+			WithArgs("missing").
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(context.Background(), "missing")
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -4,13 +4,26 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
 	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/lib/pq"
 )
 
+// newsColumns are selected, in scan order, wherever a full article is read,
+// along with the storage key of its featured image.
+var newsColumns = []string{
+	"id", "category_id", "author_id", "title", "content", "content_format", "content_html", "content_text",
+	"created_at", "updated_at", "comments_enabled", "comments_close_at",
+	"featured_media_id", "excerpt", "meta_title", "meta_description", "canonical_url",
+	"og_title", "og_description", "og_image", "noindex",
+	"COALESCE((SELECT m.storage_key FROM media m WHERE m.id = news.featured_media_id), '')",
+	"locale", "translation_group", "tags",
+}
+
 // NewsRepo implements repository.NewsRepo interface.
 type NewsRepo struct {
 	*postgres.Postgres
@@ -24,36 +37,38 @@ func NewPostgresNewsRepo(pg *postgres.Postgres) *NewsRepo {
 func (r *NewsRepo) Create(ctx context.Context, news *entity.News) (*entity.News, error) {
 	query := r.Builder.
 		Insert("news").
-		Columns("category_id", "author_id", "title", "content").
-		Values(news.CategoryID, news.AuthorID, news.Title, news.Content).
-		Suffix("RETURNING id, category_id, author_id, title, content, created_at, updated_at")
+		Columns(
+			"category_id", "author_id", "title", "content", "content_format", "content_html", "content_text",
+			"comments_enabled", "comments_close_at",
+			"featured_media_id", "excerpt", "meta_title", "meta_description", "canonical_url",
+			"og_title", "og_description", "og_image", "noindex",
+			"locale", "translation_group", "tags",
+		).
+		Values(
+			news.CategoryID, news.AuthorID, news.Title, news.Content, news.ContentFormat, news.ContentHTML, news.ContentText,
+			news.CommentsEnabled, news.CommentsCloseAt,
+			news.FeaturedMediaID, news.Excerpt, news.MetaTitle, news.MetaDescription, news.CanonicalURL,
+			news.OGTitle, news.OGDescription, news.OGImage, news.NoIndex,
+			news.Locale, translationGroup(news.TranslationGroup), tagsArray(news.Tags),
+		).
+		Suffix("RETURNING " + strings.Join(newsColumns, ", "))
 
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	var result entity.News
-
-	err = r.DB.QueryRowContext(ctx, sqlQuery, args...).Scan(
-		&result.ID,
-		&result.CategoryID,
-		&result.AuthorID,
-		&result.Title,
-		&result.Content,
-		&result.CreatedAt,
-		&result.UpdatedAt,
-	)
+	result, err := scanNews(r.DB.QueryRowContext(ctx, sqlQuery, args...))
 	if err != nil {
-		return nil, err
+		return nil, mapError(err)
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 func (r *NewsRepo) GetByID(ctx context.Context, id string) (*entity.News, error) {
 	query := r.Builder.
-		Select("id", "category_id", "author_id", "title", "content", "created_at", "updated_at").
+		Select(newsColumns...).
 		From("news").
 		Where(squirrel.Eq{"id": id})
 
@@ -62,17 +77,7 @@ func (r *NewsRepo) GetByID(ctx context.Context, id string) (*entity.News, error)
 		return nil, err
 	}
 
-	var news entity.News
-
-	err = r.DB.QueryRowContext(ctx, sqlQuery, args...).Scan(
-		&news.ID,
-		&news.CategoryID,
-		&news.AuthorID,
-		&news.Title,
-		&news.Content,
-		&news.CreatedAt,
-		&news.UpdatedAt,
-	)
+	news, err := scanNews(r.DB.QueryRowContext(ctx, sqlQuery, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, apperror.ErrNotFound
@@ -81,15 +86,51 @@ func (r *NewsRepo) GetByID(ctx context.Context, id string) (*entity.News, error)
 		return nil, err
 	}
 
-	return &news, nil
+	return news, nil
 }
 
 func (r *NewsRepo) GetAll(ctx context.Context) ([]entity.News, error) {
 	query := r.Builder.
-		Select("id", "category_id", "author_id", "title", "content", "created_at", "updated_at").
+		Select(newsColumns...).
+		From("news").
+		OrderBy("created_at DESC")
+
+	return r.getMany(ctx, query)
+}
+
+// GetByCategory returns the news of a category, newest first.
+func (r *NewsRepo) GetByCategory(ctx context.Context, categoryID string) ([]entity.News, error) {
+	query := r.Builder.
+		Select(newsColumns...).
 		From("news").
+		Where(squirrel.Eq{"category_id": categoryID}).
 		OrderBy("created_at DESC")
 
+	return r.getMany(ctx, query)
+}
+
+// GetLatest returns the limit most recent news, of a category when
+// categoryID is set and tagged with tag when it is set.
+func (r *NewsRepo) GetLatest(ctx context.Context, categoryID, tag string, limit int) ([]entity.News, error) {
+	query := r.Builder.
+		Select(newsColumns...).
+		From("news").
+		OrderBy("created_at DESC").
+		Limit(uint64(max(limit, 0)))
+
+	if categoryID != "" {
+		query = query.Where(squirrel.Eq{"category_id": categoryID})
+	}
+
+	if tag != "" {
+		// Containment, unlike ANY, can use the GIN index on tags
+		query = query.Where(squirrel.Expr("tags @> ARRAY[?]", tag))
+	}
+
+	return r.getMany(ctx, query)
+}
+
+func (r *NewsRepo) getMany(ctx context.Context, query squirrel.SelectBuilder) ([]entity.News, error) {
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return nil, err
@@ -104,22 +145,12 @@ func (r *NewsRepo) GetAll(ctx context.Context) ([]entity.News, error) {
 	var newsList []entity.News
 
 	for rows.Next() {
-		var news entity.News
-
-		err := rows.Scan(
-			&news.ID,
-			&news.CategoryID,
-			&news.AuthorID,
-			&news.Title,
-			&news.Content,
-			&news.CreatedAt,
-			&news.UpdatedAt,
-		)
+		news, err := scanNews(rows)
 		if err != nil {
 			return nil, err
 		}
 
-		newsList = append(newsList, news)
+		newsList = append(newsList, *news)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -139,9 +170,28 @@ func (r *NewsRepo) Update(ctx context.Context, news *entity.News) error {
 		Set("category_id", news.CategoryID).
 		Set("title", news.Title).
 		Set("content", news.Content).
+		Set("content_format", news.ContentFormat).
+		Set("content_html", news.ContentHTML).
+		Set("content_text", news.ContentText).
+		Set("comments_enabled", news.CommentsEnabled).
+		Set("comments_close_at", news.CommentsCloseAt).
+		Set("featured_media_id", news.FeaturedMediaID).
+		Set("excerpt", news.Excerpt).
+		Set("meta_title", news.MetaTitle).
+		Set("meta_description", news.MetaDescription).
+		Set("canonical_url", news.CanonicalURL).
+		Set("og_title", news.OGTitle).
+		Set("og_description", news.OGDescription).
+		Set("og_image", news.OGImage).
+		Set("noindex", news.NoIndex).
+		Set("tags", tagsArray(news.Tags)).
 		Set("updated_at", squirrel.Expr("NOW()")).
 		Where(squirrel.Eq{"id": news.ID})
 
+	if news.Locale != "" {
+		query = query.Set("locale", news.Locale)
+	}
+
 	sqlQuery, args, err := query.ToSql()
 	if err != nil {
 		return err
@@ -149,7 +199,7 @@ func (r *NewsRepo) Update(ctx context.Context, news *entity.News) error {
 
 	result, err := r.DB.ExecContext(ctx, sqlQuery, args...)
 	if err != nil {
-		return err
+		return mapError(err)
 	}
 
 	rowsAffected, err := result.RowsAffected()
@@ -190,3 +240,50 @@ func (r *NewsRepo) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func scanNews(row rowScanner) (*entity.News, error) {
+	var news entity.News
+
+	err := row.Scan(
+		&news.ID,
+		&news.CategoryID,
+		&news.AuthorID,
+		&news.Title,
+		&news.Content,
+		&news.ContentFormat,
+		&news.ContentHTML,
+		&news.ContentText,
+		&news.CreatedAt,
+		&news.UpdatedAt,
+		&news.CommentsEnabled,
+		&news.CommentsCloseAt,
+		&news.FeaturedMediaID,
+		&news.Excerpt,
+		&news.MetaTitle,
+		&news.MetaDescription,
+		&news.CanonicalURL,
+		&news.OGTitle,
+		&news.OGDescription,
+		&news.OGImage,
+		&news.NoIndex,
+		&news.FeaturedMediaKey,
+		&news.Locale,
+		&news.TranslationGroup,
+		pq.Array(&news.Tags),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &news, nil
+}
+
+// tagsArray converts tags to a Postgres array, empty rather than NULL when
+// there are none.
+func tagsArray(tags []string) interface{} {
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return pq.Array(tags)
+}
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+type NewsReactionRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresNewsReactionRepo(pg *postgres.Postgres) *NewsReactionRepo {
+	return &NewsReactionRepo{pg}
+}
+
+// Add records a reaction of a voter on a news article. Reacting twice with
+// the same reaction is a no-op.
+func (r *NewsReactionRepo) Add(ctx context.Context, newsID, voter, reaction string) error {
+	query, args, err := r.Builder.
+		Insert("news_reactions").
+		Columns("news_id", "voter", "reaction").
+		Values(newsID, voter, reaction).
+		Suffix("ON CONFLICT (news_id, voter, reaction) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// Remove withdraws a reaction of a voter. Removing a missing reaction is a
+// no-op.
+func (r *NewsReactionRepo) Remove(ctx context.Context, newsID, voter, reaction string) error {
+	query, args, err := r.Builder.
+		Delete("news_reactions").
+		Where(squirrel.Eq{"news_id": newsID, "voter": voter, "reaction": reaction}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// CountByNewsIDs returns the number of each reaction per news article. News
+// without reactions are missing from the result.
+func (r *NewsReactionRepo) CountByNewsIDs(ctx context.Context, newsIDs []string) (map[string]map[string]int, error) {
+	query, args, err := r.Builder.
+		Select("news_id", "reaction", "COUNT(*)").
+		From("news_reactions").
+		Where(squirrel.Eq{"news_id": newsIDs}).
+		GroupBy("news_id", "reaction").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]map[string]int, len(newsIDs))
+
+	for rows.Next() {
+		var (
+			newsID   string
+			reaction string
+			count    int
+		)
+
+		if err := rows.Scan(&newsID, &reaction, &count); err != nil {
+			return nil, err
+		}
+
+		if result[newsID] == nil {
+			result[newsID] = make(map[string]int)
+		}
+
+		result[newsID][reaction] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
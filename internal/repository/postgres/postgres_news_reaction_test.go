@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlInsertNewsReaction = `INSERT INTO news_reactions \(news_id,voter,reaction\) VALUES \(\$1,\$2,\$3\) ` +
+		`ON CONFLICT \(news_id, voter, reaction\) DO NOTHING`
+	sqlDeleteNewsReaction = `DELETE FROM news_reactions WHERE news_id = \$1 AND reaction = \$2 AND voter = \$3`
+	sqlCountNewsReactions = `SELECT news_id, reaction, COUNT\(\*\) FROM news_reactions WHERE news_id IN \(\$1,\$2\) ` +
+		`GROUP BY news_id, reaction`
+	testReactionVoter = "visitor:fingerprint"
+)
+
+func setupNewsReactionMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *NewsReactionRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresNewsReactionRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestNewsReactionRepo_Add(t *testing.T) {
+	t.Run("success - add reaction", func(t *testing.T) {
+		db, mock, repo := setupNewsReactionMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlInsertNewsReaction).
+			WithArgs(testNewsID, testReactionVoter, "like").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Add(context.Background(), testNewsID, testReactionVoter, "like")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - insert fails", func(t *testing.T) {
+		db, mock, repo := setupNewsReactionMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlInsertNewsReaction).
+			WithArgs(testNewsID, testReactionVoter, "like").
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		err := repo.Add(context.Background(), testNewsID, testReactionVoter, "like")
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsReactionRepo_Remove(t *testing.T) {
+	t.Run("success - remove reaction", func(t *testing.T) {
+		db, mock, repo := setupNewsReactionMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteNewsReaction).
+			WithArgs(testNewsID, "like", testReactionVoter).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Remove(context.Background(), testNewsID, testReactionVoter, "like")
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsReactionRepo_CountByNewsIDs(t *testing.T) {
+	t.Run("success - count reactions", func(t *testing.T) {
+		db, mock, repo := setupNewsReactionMockDB(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"news_id", "reaction", "count"}).
+			AddRow(testNewsID, "like", 3).
+			AddRow(testNewsID, "love", 1)
+
+		mock.ExpectQuery(sqlCountNewsReactions).
+			WithArgs(testNewsID, nonExistentNewsID).
+			WillReturnRows(rows)
+
+		counts, err := repo.CountByNewsIDs(context.Background(), []string{testNewsID, nonExistentNewsID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]map[string]int{testNewsID: {"like": 3, "love": 1}}, counts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupNewsReactionMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlCountNewsReactions).
+			WithArgs(testNewsID, nonExistentNewsID).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		counts, err := repo.CountByNewsIDs(context.Background(), []string{testNewsID, nonExistentNewsID})
+
+		assert.Nil(t, counts)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -16,17 +16,30 @@ import (
 )
 
 const (
-	sqlInsertNews     = `INSERT INTO news \(category_id,author_id,title,content\) VALUES \(\$1,\$2,\$3,\$4\) RETURNING id, category_id, author_id, title, content, created_at, updated_at`
-	sqlSelectNews     = `SELECT id, category_id, author_id, title, content, created_at, updated_at FROM news WHERE id = \$1`
-	sqlSelectAllNews  = `SELECT id, category_id, author_id, title, content, created_at, updated_at FROM news ORDER BY created_at DESC`
-	sqlUpdateNews     = `UPDATE news SET category_id = \$1, title = \$2, content = \$3, updated_at = NOW\(\) WHERE id = \$4`
-	sqlDeleteNews     = `DELETE FROM news WHERE id = \$1`
-	testNewsID        = "550e8400-e29b-41d4-a716-446655440000"
-	testCategoryID    = "550e8400-e29b-41d4-a716-446655440001"
-	testAuthorID      = "550e8400-e29b-41d4-a716-446655440002"
-	nonExistentNewsID = "550e8400-e29b-41d4-a716-999999999999"
+	sqlInsertNews         = `INSERT INTO news \(category_id,author_id,title,content,content_format,content_html,content_text,comments_enabled,comments_close_at,featured_media_id,excerpt,meta_title,meta_description,canonical_url,og_title,og_description,og_image,noindex,locale,translation_group,tags\) VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,\$7,\$8,\$9,\$10,\$11,\$12,\$13,\$14,\$15,\$16,\$17,\$18,\$19,COALESCE\(\$20::uuid, uuid_generate_v4\(\)\),\$21\) RETURNING id, category_id, author_id, title, content, content_format, content_html, content_text, created_at, updated_at, comments_enabled, comments_close_at, featured_media_id, excerpt, meta_title, meta_description, canonical_url, og_title, og_description, og_image, noindex, COALESCE\(\(SELECT m.storage_key FROM media m WHERE m.id = news.featured_media_id\), ''\), locale, translation_group, tags`
+	sqlSelectNews         = `SELECT id, category_id, author_id, title, content, content_format, content_html, content_text, created_at, updated_at, comments_enabled, comments_close_at, featured_media_id, excerpt, meta_title, meta_description, canonical_url, og_title, og_description, og_image, noindex, COALESCE\(\(SELECT m.storage_key FROM media m WHERE m.id = news.featured_media_id\), ''\), locale, translation_group, tags FROM news WHERE id = \$1`
+	sqlSelectAllNews      = `SELECT id, category_id, author_id, title, content, content_format, content_html, content_text, created_at, updated_at, comments_enabled, comments_close_at, featured_media_id, excerpt, meta_title, meta_description, canonical_url, og_title, og_description, og_image, noindex, COALESCE\(\(SELECT m.storage_key FROM media m WHERE m.id = news.featured_media_id\), ''\), locale, translation_group, tags FROM news ORDER BY created_at DESC`
+	sqlSelectCategoryNews = `SELECT id, category_id, author_id, title, content, content_format, content_html, content_text, created_at, updated_at, comments_enabled, comments_close_at, featured_media_id, excerpt, meta_title, meta_description, canonical_url, og_title, og_description, og_image, noindex, COALESCE\(\(SELECT m.storage_key FROM media m WHERE m.id = news.featured_media_id\), ''\), locale, translation_group, tags FROM news WHERE category_id = \$1 ORDER BY created_at DESC`
+	sqlSelectLatestNews   = `SELECT .+ FROM news ORDER BY created_at DESC LIMIT 20`
+	sqlSelectLatestInCat  = `SELECT .+ FROM news WHERE category_id = \$1 ORDER BY created_at DESC LIMIT 5`
+	sqlSelectLatestTagged = `SELECT .+ FROM news WHERE category_id = \$1 AND tags @> ARRAY\[\$2\] ORDER BY created_at DESC LIMIT 5`
+	sqlUpdateNews         = `UPDATE news SET category_id = \$1, title = \$2, content = \$3, content_format = \$4, content_html = \$5, content_text = \$6, comments_enabled = \$7, comments_close_at = \$8, featured_media_id = \$9, excerpt = \$10, meta_title = \$11, meta_description = \$12, canonical_url = \$13, og_title = \$14, og_description = \$15, og_image = \$16, noindex = \$17, tags = \$18, updated_at = NOW\(\) WHERE id = \$19`
+	sqlDeleteNews         = `DELETE FROM news WHERE id = \$1`
+	testNewsID            = "550e8400-e29b-41d4-a716-446655440000"
+	testCategoryID        = "550e8400-e29b-41d4-a716-446655440001"
+	testAuthorID          = "550e8400-e29b-41d4-a716-446655440002"
+	nonExistentNewsID     = "550e8400-e29b-41d4-a716-999999999999"
+	testNewsGroupID       = "550e8400-e29b-41d4-a716-446655440079"
 )
 
+var newsRowColumns = []string{
+	"id", "category_id", "author_id", "title", "content", "content_format", "content_html", "content_text",
+	"created_at", "updated_at", "comments_enabled", "comments_close_at",
+	"featured_media_id", "excerpt", "meta_title", "meta_description", "canonical_url",
+	"og_title", "og_description", "og_image", "noindex", "featured_media_key",
+	"locale", "translation_group", "tags",
+}
+
 func setupNewsMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *NewsRepo) {
 	t.Helper()
 
@@ -66,11 +79,11 @@ func TestNewsRepo_Create(t *testing.T) {
 			UpdatedAt:  now,
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "category_id", "author_id", "title", "content", "created_at", "updated_at"}).
-			AddRow(expectedNews.ID, expectedNews.CategoryID, expectedNews.AuthorID, expectedNews.Title, expectedNews.Content, expectedNews.CreatedAt, expectedNews.UpdatedAt)
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow(expectedNews.ID, expectedNews.CategoryID, expectedNews.AuthorID, expectedNews.Title, expectedNews.Content, "html", expectedNews.Content, expectedNews.Content, expectedNews.CreatedAt, expectedNews.UpdatedAt, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}")
 
 		mock.ExpectQuery(sqlInsertNews).
-			WithArgs(news.CategoryID, news.AuthorID, news.Title, news.Content).
+			WithArgs(news.CategoryID, news.AuthorID, news.Title, news.Content, news.ContentFormat, news.ContentHTML, news.ContentText, news.CommentsEnabled, news.CommentsCloseAt, news.FeaturedMediaID, news.Excerpt, news.MetaTitle, news.MetaDescription, news.CanonicalURL, news.OGTitle, news.OGDescription, news.OGImage, news.NoIndex, "", nil, "{}").
 			WillReturnRows(rows)
 
 		result, err := repo.Create(context.Background(), news)
@@ -95,7 +108,7 @@ func TestNewsRepo_Create(t *testing.T) {
 		}
 
 		mock.ExpectQuery(sqlInsertNews).
-			WithArgs(news.CategoryID, news.AuthorID, news.Title, news.Content).
+			WithArgs(news.CategoryID, news.AuthorID, news.Title, news.Content, news.ContentFormat, news.ContentHTML, news.ContentText, news.CommentsEnabled, news.CommentsCloseAt, news.FeaturedMediaID, news.Excerpt, news.MetaTitle, news.MetaDescription, news.CanonicalURL, news.OGTitle, news.OGDescription, news.OGImage, news.NoIndex, "", nil, "{}").
 			WillReturnError(apperror.ErrDatabaseConnection)
 
 		result, err := repo.Create(context.Background(), news)
@@ -120,11 +133,11 @@ func TestNewsRepo_Create(t *testing.T) {
 
 		now := time.Now()
 
-		rows := sqlmock.NewRows([]string{"id", "category_id", "author_id", "title", "content", "created_at", "updated_at"}).
-			AddRow(testNewsID, testCategoryID, testAuthorID, news.Title, longContent, now, now)
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow(testNewsID, testCategoryID, testAuthorID, news.Title, longContent, "html", longContent, longContent, now, now, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}")
 
 		mock.ExpectQuery(sqlInsertNews).
-			WithArgs(news.CategoryID, news.AuthorID, news.Title, news.Content).
+			WithArgs(news.CategoryID, news.AuthorID, news.Title, news.Content, news.ContentFormat, news.ContentHTML, news.ContentText, news.CommentsEnabled, news.CommentsCloseAt, news.FeaturedMediaID, news.Excerpt, news.MetaTitle, news.MetaDescription, news.CanonicalURL, news.OGTitle, news.OGDescription, news.OGImage, news.NoIndex, "", nil, "{}").
 			WillReturnRows(rows)
 
 		result, err := repo.Create(context.Background(), news)
@@ -152,8 +165,8 @@ func TestNewsRepo_GetByID(t *testing.T) {
 			UpdatedAt:  now,
 		}
 
-		rows := sqlmock.NewRows([]string{"id", "category_id", "author_id", "title", "content", "created_at", "updated_at"}).
-			AddRow(expectedNews.ID, expectedNews.CategoryID, expectedNews.AuthorID, expectedNews.Title, expectedNews.Content, expectedNews.CreatedAt, expectedNews.UpdatedAt)
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow(expectedNews.ID, expectedNews.CategoryID, expectedNews.AuthorID, expectedNews.Title, expectedNews.Content, "html", expectedNews.Content, expectedNews.Content, expectedNews.CreatedAt, expectedNews.UpdatedAt, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}")
 
 		mock.ExpectQuery(sqlSelectNews).
 			WithArgs(expectedNews.ID).
@@ -168,6 +181,61 @@ func TestNewsRepo_GetByID(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("success - get news with comment settings", func(t *testing.T) {
+		db, mock, repo := setupNewsMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		closeAt := now.Add(24 * time.Hour)
+
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow(testNewsID, testCategoryID, testAuthorID, "Breaking News", "This is the news content", "html", "This is the news content", "This is the news content", now, now, false, closeAt, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}")
+
+		mock.ExpectQuery(sqlSelectNews).
+			WithArgs(testNewsID).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByID(context.Background(), testNewsID)
+
+		assert.NoError(t, err)
+		assert.False(t, result.CommentsEnabled)
+		require.NotNil(t, result.CommentsCloseAt)
+		assert.True(t, closeAt.Equal(*result.CommentsCloseAt))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - get news with featured image and SEO metadata", func(t *testing.T) {
+		db, mock, repo := setupNewsMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		mediaID := "550e8400-e29b-41d4-a716-446655440030"
+
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow(testNewsID, testCategoryID, testAuthorID, "Breaking News", "Content", "html", "Content", "Content", now, now, true, nil,
+				mediaID, "Short summary", "Meta title", "Meta description", "https://example.com/news/breaking",
+				"OG title", "OG description", "https://cdn.example.com/og.png", true, "abcd.png", "id", testNewsGroupID, "{technology,ai}")
+
+		mock.ExpectQuery(sqlSelectNews).
+			WithArgs(testNewsID).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByID(context.Background(), testNewsID)
+
+		assert.NoError(t, err)
+		require.NotNil(t, result.FeaturedMediaID)
+		assert.Equal(t, mediaID, *result.FeaturedMediaID)
+		assert.Equal(t, "abcd.png", result.FeaturedMediaKey)
+		assert.Equal(t, "Short summary", result.Excerpt)
+		assert.Equal(t, "https://example.com/news/breaking", result.CanonicalURL)
+		assert.Equal(t, "OG title", result.OGTitle)
+		assert.True(t, result.NoIndex)
+		assert.Equal(t, "id", result.Locale)
+		assert.Equal(t, testNewsGroupID, result.TranslationGroup)
+		assert.Equal(t, []string{"technology", "ai"}, result.Tags)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("error - news not found", func(t *testing.T) {
 		db, mock, repo := setupNewsMockDB(t)
 		defer db.Close()
@@ -208,10 +276,10 @@ func TestNewsRepo_GetAll(t *testing.T) {
 
 		now := time.Now()
 
-		rows := sqlmock.NewRows([]string{"id", "category_id", "author_id", "title", "content", "created_at", "updated_at"}).
-			AddRow("550e8400-e29b-41d4-a716-446655440001", testCategoryID, testAuthorID, "News 1", "Content 1", now, now).
-			AddRow("550e8400-e29b-41d4-a716-446655440002", testCategoryID, testAuthorID, "News 2", "Content 2", now, now).
-			AddRow("550e8400-e29b-41d4-a716-446655440003", testCategoryID, testAuthorID, "News 3", "Content 3", now, now)
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow("550e8400-e29b-41d4-a716-446655440001", testCategoryID, testAuthorID, "News 1", "Content 1", "html", "Content 1", "Content 1", now, now, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}").
+			AddRow("550e8400-e29b-41d4-a716-446655440002", testCategoryID, testAuthorID, "News 2", "Content 2", "html", "Content 2", "Content 2", now, now, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}").
+			AddRow("550e8400-e29b-41d4-a716-446655440003", testCategoryID, testAuthorID, "News 3", "Content 3", "html", "Content 3", "Content 3", now, now, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}")
 
 		mock.ExpectQuery(sqlSelectAllNews).
 			WillReturnRows(rows)
@@ -231,7 +299,7 @@ func TestNewsRepo_GetAll(t *testing.T) {
 		db, mock, repo := setupNewsMockDB(t)
 		defer db.Close()
 
-		rows := sqlmock.NewRows([]string{"id", "category_id", "author_id", "title", "content", "created_at", "updated_at"})
+		rows := sqlmock.NewRows(newsRowColumns)
 
 		mock.ExpectQuery(sqlSelectAllNews).
 			WillReturnRows(rows)
@@ -260,6 +328,95 @@ func TestNewsRepo_GetAll(t *testing.T) {
 	})
 }
 
+func TestNewsRepo_GetByCategory(t *testing.T) {
+	t.Run("success - news of a category", func(t *testing.T) {
+		db, mock, repo := setupNewsMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow(testNewsID, testCategoryID, testAuthorID, "News 1", "Content 1", "html", "Content 1", "Content 1", now, now, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}")
+
+		mock.ExpectQuery(sqlSelectCategoryNews).
+			WithArgs(testCategoryID).
+			WillReturnRows(rows)
+
+		result, err := repo.GetByCategory(context.Background(), testCategoryID)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, testCategoryID, result[0].CategoryID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - database query fails", func(t *testing.T) {
+		db, mock, repo := setupNewsMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectCategoryNews).
+			WithArgs(testCategoryID).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		result, err := repo.GetByCategory(context.Background(), testCategoryID)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestNewsRepo_GetLatest(t *testing.T) {
+	t.Run("success - latest news of every category", func(t *testing.T) {
+		db, mock, repo := setupNewsMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		rows := sqlmock.NewRows(newsRowColumns).
+			AddRow(testNewsID, testCategoryID, testAuthorID, "News 1", "Content 1", "html", "Content 1", "Content 1", now, now, true, nil, nil, "", "", "", "", "", "", "", false, "", "en", testNewsGroupID, "{}")
+
+		mock.ExpectQuery(sqlSelectLatestNews).
+			WillReturnRows(rows)
+
+		result, err := repo.GetLatest(context.Background(), "", "", 20)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - latest news of a category", func(t *testing.T) {
+		db, mock, repo := setupNewsMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectLatestInCat).
+			WithArgs(testCategoryID).
+			WillReturnRows(sqlmock.NewRows(newsRowColumns))
+
+		result, err := repo.GetLatest(context.Background(), testCategoryID, "", 5)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - latest news of a category with a tag", func(t *testing.T) {
+		db, mock, repo := setupNewsMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectLatestTagged).
+			WithArgs(testCategoryID, "technology").
+			WillReturnRows(sqlmock.NewRows(newsRowColumns))
+
+		result, err := repo.GetLatest(context.Background(), testCategoryID, "technology", 5)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestNewsRepo_Update(t *testing.T) {
 	t.Run("success - update news", func(t *testing.T) {
 		db, mock, repo := setupNewsMockDB(t)
@@ -273,7 +430,7 @@ func TestNewsRepo_Update(t *testing.T) {
 		}
 
 		mock.ExpectExec(sqlUpdateNews).
-			WithArgs(news.CategoryID, news.Title, news.Content, news.ID).
+			WithArgs(news.CategoryID, news.Title, news.Content, news.ContentFormat, news.ContentHTML, news.ContentText, news.CommentsEnabled, news.CommentsCloseAt, news.FeaturedMediaID, news.Excerpt, news.MetaTitle, news.MetaDescription, news.CanonicalURL, news.OGTitle, news.OGDescription, news.OGImage, news.NoIndex, "{}", news.ID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
 		err := repo.Update(context.Background(), news)
@@ -294,7 +451,7 @@ func TestNewsRepo_Update(t *testing.T) {
 		}
 
 		mock.ExpectExec(sqlUpdateNews).
-			WithArgs(news.CategoryID, news.Title, news.Content, news.ID).
+			WithArgs(news.CategoryID, news.Title, news.Content, news.ContentFormat, news.ContentHTML, news.ContentText, news.CommentsEnabled, news.CommentsCloseAt, news.FeaturedMediaID, news.Excerpt, news.MetaTitle, news.MetaDescription, news.CanonicalURL, news.OGTitle, news.OGDescription, news.OGImage, news.NoIndex, "{}", news.ID).
 			WillReturnResult(sqlmock.NewResult(0, 0))
 
 		err := repo.Update(context.Background(), news)
@@ -316,7 +473,7 @@ func TestNewsRepo_Update(t *testing.T) {
 		}
 
 		mock.ExpectExec(sqlUpdateNews).
-			WithArgs(news.CategoryID, news.Title, news.Content, news.ID).
+			WithArgs(news.CategoryID, news.Title, news.Content, news.ContentFormat, news.ContentHTML, news.ContentText, news.CommentsEnabled, news.CommentsCloseAt, news.FeaturedMediaID, news.Excerpt, news.MetaTitle, news.MetaDescription, news.CanonicalURL, news.OGTitle, news.OGDescription, news.OGImage, news.NoIndex, "{}", news.ID).
 			WillReturnError(apperror.ErrDatabaseConnection)
 
 		err := repo.Update(context.Background(), news)
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/RizqiSugiarto/coding-test/pkg/ratelimit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	rateLimitKey  = "login:ip:203.0.113.7"
+	sqlLockBucket = `INSERT INTO rate_limit_buckets \(key,tokens,updated_at,full_at\) VALUES \(\$1,\$2,clock_timestamp\(\),clock_timestamp\(\)\) ` +
+		`ON CONFLICT \(key\) DO UPDATE SET tokens = rate_limit_buckets.tokens RETURNING tokens, updated_at, clock_timestamp\(\)`
+	sqlPurgeBuckets = `DELETE FROM rate_limit_buckets WHERE key IN \(SELECT key FROM rate_limit_buckets ` +
+		`WHERE full_at < clock_timestamp\(\) LIMIT \$1 FOR UPDATE SKIP LOCKED\)`
+	sqlUpdateBucket     = `UPDATE rate_limit_buckets SET tokens = \$1, updated_at = \$2, full_at = \$3 WHERE key = \$4`
+	rateLimitTestPeriod = time.Minute
+)
+
+func setupRateLimitMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *RateLimitRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresRateLimitRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestRateLimitRepo_Take(t *testing.T) {
+	policy := ratelimit.Policy{Name: "login", Limit: 5, Period: rateLimitTestPeriod}
+
+	t.Run("success - take token from refilled bucket", func(t *testing.T) {
+		db, mock, repo := setupRateLimitMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlLockBucket).
+			WithArgs(rateLimitKey, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens", "updated_at", "clock_timestamp"}).
+				AddRow(0.5, now.Add(-12*time.Second), now))
+		mock.ExpectExec(sqlUpdateBucket).
+			WithArgs(0.5, now, now.Add(54*time.Second), rateLimitKey).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		result, err := repo.Take(context.Background(), rateLimitKey, policy)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 0, result.Remaining)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - empty bucket denies request", func(t *testing.T) {
+		db, mock, repo := setupRateLimitMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlLockBucket).
+			WithArgs(rateLimitKey, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens", "updated_at", "clock_timestamp"}).
+				AddRow(0.0, now, now))
+		mock.ExpectExec(sqlUpdateBucket).
+			WithArgs(0.0, now, now.Add(time.Minute), rateLimitKey).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		result, err := repo.Take(context.Background(), rateLimitKey, policy)
+
+		assert.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 12*time.Second, result.RetryAfter)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - create a full bucket", func(t *testing.T) {
+		db, mock, repo := setupRateLimitMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlLockBucket).
+			WithArgs(rateLimitKey, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens", "updated_at", "clock_timestamp"}).
+				AddRow(5.0, now, now))
+		mock.ExpectExec(sqlUpdateBucket).
+			WithArgs(4.0, now, now.Add(12*time.Second), rateLimitKey).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		result, err := repo.Take(context.Background(), rateLimitKey, policy)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 4, result.Remaining)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - lock fails", func(t *testing.T) {
+		db, mock, repo := setupRateLimitMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(sqlLockBucket).
+			WithArgs(rateLimitKey, 5).
+			WillReturnError(apperror.ErrDatabaseConnection)
+		mock.ExpectRollback()
+
+		result, err := repo.Take(context.Background(), rateLimitKey, policy)
+
+		assert.Equal(t, ratelimit.Result{}, result)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRateLimitRepo_Purge(t *testing.T) {
+	t.Run("success - purge refilled buckets", func(t *testing.T) {
+		db, mock, repo := setupRateLimitMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlPurgeBuckets).
+			WithArgs(500).
+			WillReturnResult(sqlmock.NewResult(0, 42))
+
+		purged, err := repo.Purge(context.Background(), 500)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 42, purged)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - delete fails", func(t *testing.T) {
+		db, mock, repo := setupRateLimitMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlPurgeBuckets).
+			WithArgs(500).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		purged, err := repo.Purge(context.Background(), 500)
+
+		assert.Zero(t, purged)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
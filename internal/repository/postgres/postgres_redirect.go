@@ -0,0 +1,294 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+var redirectColumns = []string{
+	"id", "source_path", "target", "status_code", "hits", "expires_at", "created_at", "updated_at",
+}
+
+type RedirectRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresRedirectRepo(pg *postgres.Postgres) *RedirectRepo {
+	return &RedirectRepo{pg}
+}
+
+func (r *RedirectRepo) Create(ctx context.Context, redirect *entity.Redirect) (*entity.Redirect, error) {
+	query, args, err := r.Builder.
+		Insert("redirects").
+		Columns("source_path", "target", "status_code", "expires_at").
+		Values(redirect.SourcePath, redirect.Target, redirect.StatusCode, redirect.ExpiresAt).
+		Suffix("RETURNING id, source_path, target, status_code, hits, expires_at, created_at, updated_at").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := scanRedirect(r.DB.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return result, nil
+}
+
+func (r *RedirectRepo) GetByID(ctx context.Context, id string) (*entity.Redirect, error) {
+	return r.getOne(ctx, squirrel.Eq{"id": id})
+}
+
+func (r *RedirectRepo) GetBySource(ctx context.Context, sourcePath string) (*entity.Redirect, error) {
+	return r.getOne(ctx, squirrel.Eq{"source_path": sourcePath})
+}
+
+func (r *RedirectRepo) getOne(ctx context.Context, where squirrel.Eq) (*entity.Redirect, error) {
+	query, args, err := r.Builder.
+		Select(redirectColumns...).
+		From("redirects").
+		Where(where).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := scanRedirect(r.DB.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *RedirectRepo) GetAll(ctx context.Context) ([]entity.Redirect, error) {
+	query, args, err := r.Builder.
+		Select(redirectColumns...).
+		From("redirects").
+		OrderBy("source_path").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	redirects := []entity.Redirect{}
+
+	for rows.Next() {
+		redirect, err := scanRedirect(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		redirects = append(redirects, *redirect)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return redirects, nil
+}
+
+func (r *RedirectRepo) Update(ctx context.Context, redirect *entity.Redirect) error {
+	query, args, err := r.Builder.
+		Update("redirects").
+		Set("source_path", redirect.SourcePath).
+		Set("target", redirect.Target).
+		Set("status_code", redirect.StatusCode).
+		Set("expires_at", redirect.ExpiresAt).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": redirect.ID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return mapError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
+
+// Upsert creates a redirect, or replaces the target, status and expiry of the
+// redirect already registered for its source path.
+func (r *RedirectRepo) Upsert(ctx context.Context, redirect *entity.Redirect) error {
+	return upsertRedirect(ctx, r.DB, r.Builder, redirect)
+}
+
+func upsertRedirect(ctx context.Context, db execer, builder squirrel.StatementBuilderType, redirect *entity.Redirect) error {
+	query, args, err := builder.
+		Insert("redirects").
+		Columns("source_path", "target", "status_code", "expires_at").
+		Values(redirect.SourcePath, redirect.Target, redirect.StatusCode, redirect.ExpiresAt).
+		Suffix("ON CONFLICT (source_path) DO UPDATE SET " +
+			"target = EXCLUDED.target, status_code = EXCLUDED.status_code, " +
+			"expires_at = EXCLUDED.expires_at, updated_at = CURRENT_TIMESTAMP").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// Retarget points every redirect aimed at from to to instead, so moving a
+// destination does not leave a chain of redirects behind.
+func (r *RedirectRepo) Retarget(ctx context.Context, from, to string) error {
+	return retargetRedirects(ctx, r.DB, r.Builder, from, to)
+}
+
+func retargetRedirects(ctx context.Context, db execer, builder squirrel.StatementBuilderType, from, to string) error {
+	query, args, err := builder.
+		Update("redirects").
+		Set("target", to).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"target": from}).
+		Where(squirrel.NotEq{"source_path": to}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+func (r *RedirectRepo) RecordHit(ctx context.Context, id string) error {
+	query, args, err := r.Builder.
+		Update("redirects").
+		Set("hits", squirrel.Expr("hits + 1")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.DB.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+func (r *RedirectRepo) Delete(ctx context.Context, id string) error {
+	query, args, err := r.Builder.
+		Delete("redirects").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return apperror.ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteBySource removes the redirect registered for a source path, if any.
+func (r *RedirectRepo) DeleteBySource(ctx context.Context, sourcePath string) error {
+	return deleteRedirectBySource(ctx, r.DB, r.Builder, sourcePath)
+}
+
+func deleteRedirectBySource(ctx context.Context, db execer, builder squirrel.StatementBuilderType, sourcePath string) error {
+	query, args, err := builder.
+		Delete("redirects").
+		Where(squirrel.Eq{"source_path": sourcePath}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// redirectMoved keeps links to a page working after its URL changed: the old
+// URL and every redirect to it now lead to the new one.
+func redirectMoved(ctx context.Context, db execer, builder squirrel.StatementBuilderType, from, to string) error {
+	if err := deleteRedirectBySource(ctx, db, builder, to); err != nil {
+		return err
+	}
+
+	err := upsertRedirect(ctx, db, builder, &entity.Redirect{
+		SourcePath: from,
+		Target:     to,
+		StatusCode: http.StatusMovedPermanently,
+	})
+	if err != nil {
+		return err
+	}
+
+	return retargetRedirects(ctx, db, builder, from, to)
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// execer runs statements on the database or in a transaction.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func scanRedirect(row rowScanner) (*entity.Redirect, error) {
+	var redirect entity.Redirect
+
+	err := row.Scan(
+		&redirect.ID,
+		&redirect.SourcePath,
+		&redirect.Target,
+		&redirect.StatusCode,
+		&redirect.Hits,
+		&redirect.ExpiresAt,
+		&redirect.CreatedAt,
+		&redirect.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redirect, nil
+}
@@ -0,0 +1,253 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlInsertRedirect = `INSERT INTO redirects \(source_path,target,status_code,expires_at\) VALUES \(\$1,\$2,\$3,\$4\) ` +
+		`RETURNING id, source_path, target, status_code, hits, expires_at, created_at, updated_at`
+	sqlSelectRedirectBySource = `SELECT id, source_path, target, status_code, hits, expires_at, created_at, updated_at ` +
+		`FROM redirects WHERE source_path = \$1`
+	sqlSelectAllRedirects = `SELECT id, source_path, target, status_code, hits, expires_at, created_at, updated_at ` +
+		`FROM redirects ORDER BY source_path`
+	sqlUpdateRedirect = `UPDATE redirects SET source_path = \$1, target = \$2, status_code = \$3, expires_at = \$4, ` +
+		`updated_at = CURRENT_TIMESTAMP WHERE id = \$5`
+	sqlUpsertRedirect = `INSERT INTO redirects \(source_path,target,status_code,expires_at\) VALUES \(\$1,\$2,\$3,\$4\) ` +
+		`ON CONFLICT \(source_path\) DO UPDATE SET target = EXCLUDED.target`
+	sqlRetargetRedirects = `UPDATE redirects SET target = \$1, updated_at = CURRENT_TIMESTAMP WHERE target = \$2 AND source_path <> \$3`
+	sqlRecordRedirectHit = `UPDATE redirects SET hits = hits \+ 1 WHERE id = \$1`
+	sqlDeleteRedirect    = `DELETE FROM redirects WHERE id = \$1`
+	testRedirectID       = "550e8400-e29b-41d4-a716-446655440020"
+	testRedirectSource   = "/about-company"
+	testRedirectTarget   = "/about-us"
+)
+
+var redirectRowColumns = []string{
+	"id", "source_path", "target", "status_code", "hits", "expires_at", "created_at", "updated_at",
+}
+
+func setupRedirectMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *RedirectRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresRedirectRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestRedirectRepo_Create(t *testing.T) {
+	t.Run("success - create redirect", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		redirect := &entity.Redirect{SourcePath: testRedirectSource, Target: testRedirectTarget, StatusCode: 301}
+
+		mock.ExpectQuery(sqlInsertRedirect).
+			WithArgs(testRedirectSource, testRedirectTarget, 301, nil).
+			WillReturnRows(sqlmock.NewRows(redirectRowColumns).
+				AddRow(testRedirectID, testRedirectSource, testRedirectTarget, 301, 0, nil, now, now))
+
+		result, err := repo.Create(context.Background(), redirect)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testRedirectID, result.ID)
+		assert.Nil(t, result.ExpiresAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - source path already redirected", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		redirect := &entity.Redirect{SourcePath: testRedirectSource, Target: testRedirectTarget, StatusCode: 301}
+
+		mock.ExpectQuery(sqlInsertRedirect).
+			WithArgs(testRedirectSource, testRedirectTarget, 301, nil).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		result, err := repo.Create(context.Background(), redirect)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrDuplicateKey, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRedirectRepo_GetBySource(t *testing.T) {
+	t.Run("success - get redirect by source path", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+		expiresAt := now.Add(time.Hour)
+
+		mock.ExpectQuery(sqlSelectRedirectBySource).
+			WithArgs(testRedirectSource).
+			WillReturnRows(sqlmock.NewRows(redirectRowColumns).
+				AddRow(testRedirectID, testRedirectSource, testRedirectTarget, 308, 12, expiresAt, now, now))
+
+		result, err := repo.GetBySource(context.Background(), testRedirectSource)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 308, result.StatusCode)
+		assert.Equal(t, int64(12), result.Hits)
+		require.NotNil(t, result.ExpiresAt)
+		assert.True(t, expiresAt.Equal(*result.ExpiresAt))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - redirect not found", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectRedirectBySource).
+			WithArgs(testRedirectSource).
+			WillReturnError(sql.ErrNoRows)
+
+		result, err := repo.GetBySource(context.Background(), testRedirectSource)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRedirectRepo_GetAll(t *testing.T) {
+	t.Run("success - empty list", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectAllRedirects).
+			WillReturnRows(sqlmock.NewRows(redirectRowColumns))
+
+		result, err := repo.GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRedirectRepo_Update(t *testing.T) {
+	t.Run("success - update redirect", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		redirect := &entity.Redirect{ID: testRedirectID, SourcePath: testRedirectSource, Target: testRedirectTarget, StatusCode: 302}
+
+		mock.ExpectExec(sqlUpdateRedirect).
+			WithArgs(testRedirectSource, testRedirectTarget, 302, nil, testRedirectID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Update(context.Background(), redirect)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - redirect not found", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		redirect := &entity.Redirect{ID: testRedirectID, SourcePath: testRedirectSource, Target: testRedirectTarget, StatusCode: 302}
+
+		mock.ExpectExec(sqlUpdateRedirect).
+			WithArgs(testRedirectSource, testRedirectTarget, 302, nil, testRedirectID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Update(context.Background(), redirect)
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRedirectRepo_Upsert(t *testing.T) {
+	t.Run("success - upsert redirect", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlUpsertRedirect).
+			WithArgs(testRedirectSource, testRedirectTarget, 301, nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.Upsert(context.Background(), &entity.Redirect{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: 301,
+		})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRedirectRepo_Retarget(t *testing.T) {
+	t.Run("success - retarget redirects", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlRetargetRedirects).
+			WithArgs(testRedirectTarget, testRedirectSource, testRedirectTarget).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		err := repo.Retarget(context.Background(), testRedirectSource, testRedirectTarget)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRedirectRepo_RecordHit(t *testing.T) {
+	t.Run("success - record hit", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlRecordRedirectHit).
+			WithArgs(testRedirectID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repo.RecordHit(context.Background(), testRedirectID)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRedirectRepo_Delete(t *testing.T) {
+	t.Run("error - redirect not found", func(t *testing.T) {
+		db, mock, repo := setupRedirectMockDB(t)
+		defer db.Close()
+
+		mock.ExpectExec(sqlDeleteRedirect).
+			WithArgs(testRedirectID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		err := repo.Delete(context.Background(), testRedirectID)
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,177 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+// sitemapSource is the table listed in a sitemap section, with the columns
+// read, in scan order, into an entity.SitemapEntry.
+type sitemapSource struct {
+	table   string
+	columns []string
+	where   squirrel.Sqlizer
+}
+
+// sitemapSources are the pages of each sitemap section. Entries are listed
+// oldest first, so a file keeps its entries as new ones are added. Articles
+// marked noindex are left out.
+var sitemapSources = map[string]sitemapSource{
+	entity.SitemapNews: {
+		table:   "news",
+		columns: []string{"id", "''", "title", "created_at", "updated_at"},
+		where:   squirrel.Eq{"noindex": false},
+	},
+	entity.SitemapCategories: {
+		table:   "categories",
+		columns: []string{"id", "''", "name", "created_at", "updated_at"},
+	},
+	entity.SitemapPages: {
+		table:   "custom_pages",
+		columns: []string{"id", "custom_url", "''", "created_at", "updated_at"},
+	},
+}
+
+// SitemapRepo implements repository.SitemapRepo interface.
+type SitemapRepo struct {
+	*postgres.Postgres
+}
+
+// NewPostgresSitemapRepo creates a new PostgreSQL sitemap repository.
+func NewPostgresSitemapRepo(pg *postgres.Postgres) *SitemapRepo {
+	return &SitemapRepo{pg}
+}
+
+// GetFiles splits the entries of a section into files of perFile entries
+// and returns, for each file in order, when its latest entry changed.
+func (r *SitemapRepo) GetFiles(ctx context.Context, section string, perFile int) ([]time.Time, error) {
+	source, err := sitemapSourceOf(section)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := r.Builder.
+		Select("updated_at").
+		Column(squirrel.Expr("(ROW_NUMBER() OVER (ORDER BY created_at, id) - 1) / ? AS file", max(perFile, 1))).
+		From(source.table)
+
+	if source.where != nil {
+		entries = entries.Where(source.where)
+	}
+
+	query := r.Builder.
+		Select("MAX(updated_at)").
+		FromSelect(entries, "entries").
+		GroupBy("file").
+		OrderBy("file")
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	files := []time.Time{}
+
+	for rows.Next() {
+		var updatedAt time.Time
+		if err := rows.Scan(&updatedAt); err != nil {
+			return nil, err
+		}
+
+		files = append(files, updatedAt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// GetEntries returns limit entries of a section from offset, in the order
+// they are split into files.
+func (r *SitemapRepo) GetEntries(ctx context.Context, section string, offset, limit int) ([]entity.SitemapEntry, error) {
+	source, err := sitemapSourceOf(section)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.Builder.
+		Select(source.columns...).
+		From(source.table).
+		OrderBy("created_at", "id").
+		Offset(uint64(max(offset, 0))).
+		Limit(uint64(max(limit, 0)))
+
+	if source.where != nil {
+		query = query.Where(source.where)
+	}
+
+	return r.getMany(ctx, query)
+}
+
+// GetNewsSince returns at most limit articles created after since, newest
+// first. Articles marked noindex are left out.
+func (r *SitemapRepo) GetNewsSince(ctx context.Context, since time.Time, limit int) ([]entity.SitemapEntry, error) {
+	source := sitemapSources[entity.SitemapNews]
+
+	query := r.Builder.
+		Select(source.columns...).
+		From(source.table).
+		Where(source.where).
+		Where(squirrel.Gt{"created_at": since}).
+		OrderBy("created_at DESC").
+		Limit(uint64(max(limit, 0)))
+
+	return r.getMany(ctx, query)
+}
+
+func (r *SitemapRepo) getMany(ctx context.Context, query squirrel.SelectBuilder) ([]entity.SitemapEntry, error) {
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []entity.SitemapEntry{}
+
+	for rows.Next() {
+		var entry entity.SitemapEntry
+		if err := rows.Scan(&entry.ID, &entry.URL, &entry.Title, &entry.CreatedAt, &entry.UpdatedAt); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func sitemapSourceOf(section string) (sitemapSource, error) {
+	source, ok := sitemapSources[section]
+	if !ok {
+		return sitemapSource{}, fmt.Errorf("unknown sitemap section %q", section)
+	}
+
+	return source, nil
+}
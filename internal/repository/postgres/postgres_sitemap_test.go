@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlSelectNewsSitemapFiles  = `SELECT MAX\(updated_at\) FROM \(SELECT updated_at, \(ROW_NUMBER\(\) OVER \(ORDER BY created_at, id\) - 1\) / \$1 AS file FROM news WHERE noindex = \$2\) AS entries GROUP BY file ORDER BY file`
+	sqlSelectPageSitemapFiles  = `SELECT MAX\(updated_at\) FROM \(SELECT updated_at, \(ROW_NUMBER\(\) OVER \(ORDER BY created_at, id\) - 1\) / \$1 AS file FROM custom_pages\) AS entries GROUP BY file ORDER BY file`
+	sqlSelectCategoryEntries   = `SELECT id, '', name, created_at, updated_at FROM categories ORDER BY created_at, id LIMIT 2 OFFSET 4`
+	sqlSelectPageEntries       = `SELECT id, custom_url, '', created_at, updated_at FROM custom_pages ORDER BY created_at, id LIMIT 10 OFFSET 0`
+	sqlSelectRecentNewsEntries = `SELECT id, '', title, created_at, updated_at FROM news WHERE noindex = \$1 AND created_at > \$2 ORDER BY created_at DESC LIMIT 1000`
+)
+
+var sitemapEntryRowColumns = []string{"id", "url", "title", "created_at", "updated_at"}
+
+func setupSitemapMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *SitemapRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresSitemapRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestSitemapRepo_GetFiles(t *testing.T) {
+	t.Run("success - indexable news split into files", func(t *testing.T) {
+		db, mock, repo := setupSitemapMockDB(t)
+		defer db.Close()
+
+		first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		second := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+		mock.ExpectQuery(sqlSelectNewsSitemapFiles).
+			WithArgs(50000, false).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(first).AddRow(second))
+
+		files, err := repo.GetFiles(context.Background(), entity.SitemapNews, 50000)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{first, second}, files)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - empty section", func(t *testing.T) {
+		db, mock, repo := setupSitemapMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectPageSitemapFiles).
+			WithArgs(100).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}))
+
+		files, err := repo.GetFiles(context.Background(), entity.SitemapPages, 100)
+
+		assert.NoError(t, err)
+		assert.Empty(t, files)
+		assert.NotNil(t, files)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - unknown section", func(t *testing.T) {
+		db, mock, repo := setupSitemapMockDB(t)
+		defer db.Close()
+
+		files, err := repo.GetFiles(context.Background(), "tags", 100)
+
+		assert.Error(t, err)
+		assert.Nil(t, files)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSitemapRepo_GetEntries(t *testing.T) {
+	t.Run("success - page of categories", func(t *testing.T) {
+		db, mock, repo := setupSitemapMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectCategoryEntries).
+			WillReturnRows(sqlmock.NewRows(sitemapEntryRowColumns).
+				AddRow(testCategoryID, "", "Technology", now, now))
+
+		entries, err := repo.GetEntries(context.Background(), entity.SitemapCategories, 4, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.SitemapEntry{
+			{ID: testCategoryID, Title: "Technology", CreatedAt: now, UpdatedAt: now},
+		}, entries)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - custom pages by URL", func(t *testing.T) {
+		db, mock, repo := setupSitemapMockDB(t)
+		defer db.Close()
+
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectPageEntries).
+			WillReturnRows(sqlmock.NewRows(sitemapEntryRowColumns).
+				AddRow(testNewsID, "/about-us", "", now, now))
+
+		entries, err := repo.GetEntries(context.Background(), entity.SitemapPages, 0, 10)
+
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "/about-us", entries[0].URL)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - database failure", func(t *testing.T) {
+		db, mock, repo := setupSitemapMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectPageEntries).WillReturnError(sql.ErrConnDone)
+
+		entries, err := repo.GetEntries(context.Background(), entity.SitemapPages, 0, 10)
+
+		assert.ErrorIs(t, err, sql.ErrConnDone)
+		assert.Nil(t, entries)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSitemapRepo_GetNewsSince(t *testing.T) {
+	t.Run("success - recent indexable news", func(t *testing.T) {
+		db, mock, repo := setupSitemapMockDB(t)
+		defer db.Close()
+
+		since := time.Now().Add(-48 * time.Hour)
+		now := time.Now()
+
+		mock.ExpectQuery(sqlSelectRecentNewsEntries).
+			WithArgs(false, since).
+			WillReturnRows(sqlmock.NewRows(sitemapEntryRowColumns).
+				AddRow(testNewsID, "", "Breaking News", now, now))
+
+		entries, err := repo.GetNewsSince(context.Background(), since, 1000)
+
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+		assert.Equal(t, "Breaking News", entries[0].Title)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
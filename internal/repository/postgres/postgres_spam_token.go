@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+type SpamTokenRepo struct {
+	*postgres.Postgres
+}
+
+func NewPostgresSpamTokenRepo(pg *postgres.Postgres) *SpamTokenRepo {
+	return &SpamTokenRepo{pg}
+}
+
+// GetByTokens returns the learned counts of the given tokens. Tokens that
+// were never trained are missing from the result.
+func (s *SpamTokenRepo) GetByTokens(ctx context.Context, tokens []string) (map[string]entity.SpamToken, error) {
+	query, args, err := s.Builder.
+		Select("token", "spam_count", "ham_count").
+		From("spam_tokens").
+		Where(squirrel.Eq{"token": tokens}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]entity.SpamToken, len(tokens))
+
+	for rows.Next() {
+		var token entity.SpamToken
+
+		err = rows.Scan(&token.Token, &token.SpamCount, &token.HamCount)
+		if err != nil {
+			return nil, err
+		}
+
+		result[token.Token] = token
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Retrain moves a comment's training from one class to another in a single
+// transaction: the tokens it was counted with leave their old class, its
+// current tokens join the new one, and the comment records the new training.
+// Nothing changes when the comment's recorded class no longer matches from,
+// as another decision retrained it in the meantime.
+func (s *SpamTokenRepo) Retrain(ctx context.Context, commentID string, from, to entity.SpamTraining) error {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck // no-op after commit
+
+	query, args, err := s.Builder.
+		Update("comments").
+		Set("trained_class", to.Class).
+		Set("trained_text", to.Text).
+		Where(squirrel.Eq{"id": commentID, "trained_class": from.Class}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		return nil
+	}
+
+	spamDelta, hamDelta := spamClassDeltas(from.Class, -1)
+	if err := s.adjust(ctx, tx, from.Tokens, spamDelta, hamDelta); err != nil {
+		return err
+	}
+
+	spamDelta, hamDelta = spamClassDeltas(to.Class, 1)
+	if err := s.adjust(ctx, tx, to.Tokens, spamDelta, hamDelta); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// adjust adds the deltas to the counts of every token in a single upsert,
+// never letting a count drop below zero.
+func (s *SpamTokenRepo) adjust(ctx context.Context, db execer, tokens []string, spamDelta, hamDelta int) error {
+	if len(tokens) == 0 || (spamDelta == 0 && hamDelta == 0) {
+		return nil
+	}
+
+	builder := s.Builder.Insert("spam_tokens").Columns("token", "spam_count", "ham_count")
+
+	for _, token := range tokens {
+		builder = builder.Values(
+			token,
+			squirrel.Expr("GREATEST(?::int, 0)", spamDelta),
+			squirrel.Expr("GREATEST(?::int, 0)", hamDelta),
+		)
+	}
+
+	query, args, err := builder.
+		Suffix("ON CONFLICT (token) DO UPDATE SET "+
+			"spam_count = GREATEST(spam_tokens.spam_count + ?::int, 0), "+
+			"ham_count = GREATEST(spam_tokens.ham_count + ?::int, 0)", spamDelta, hamDelta).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = db.ExecContext(ctx, query, args...)
+
+	return err
+}
+
+// spamClassDeltas returns the change to the spam and ham counts of adding
+// delta to a class.
+func spamClassDeltas(class string, delta int) (spamDelta, hamDelta int) {
+	switch class {
+	case entity.SpamClassSpam:
+		return delta, 0
+	case entity.SpamClassHam:
+		return 0, delta
+	default:
+		return 0, 0
+	}
+}
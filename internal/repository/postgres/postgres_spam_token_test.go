@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlSelectSpamTokens = `SELECT token, spam_count, ham_count FROM spam_tokens WHERE token IN \(\$1,\$2\)`
+	sqlAdjustSpamTokens = `INSERT INTO spam_tokens \(token,spam_count,ham_count\) ` +
+		`VALUES \(\$1,GREATEST\(\$2::int, 0\),GREATEST\(\$3::int, 0\)\),\(\$4,GREATEST\(\$5::int, 0\),GREATEST\(\$6::int, 0\)\) ` +
+		`ON CONFLICT \(token\) DO UPDATE SET spam_count = GREATEST\(spam_tokens.spam_count \+ \$7::int, 0\), ` +
+		`ham_count = GREATEST\(spam_tokens.ham_count \+ \$8::int, 0\)`
+	sqlUpdateTrainedClass = `UPDATE comments SET trained_class = \$1, trained_text = \$2 WHERE id = \$3 AND trained_class = \$4`
+	testSpamCommentID     = "550e8400-e29b-41d4-a716-446655440020"
+)
+
+func setupSpamTokenMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *SpamTokenRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresSpamTokenRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestSpamTokenRepo_GetByTokens(t *testing.T) {
+	t.Run("success - get token counts", func(t *testing.T) {
+		db, mock, repo := setupSpamTokenMockDB(t)
+		defer db.Close()
+
+		rows := sqlmock.NewRows([]string{"token", "spam_count", "ham_count"}).
+			AddRow("casino", 12, 1)
+
+		mock.ExpectQuery(sqlSelectSpamTokens).
+			WithArgs("casino", "article").
+			WillReturnRows(rows)
+
+		tokens, err := repo.GetByTokens(context.Background(), []string{"casino", "article"})
+
+		assert.NoError(t, err)
+		assert.Len(t, tokens, 1)
+		assert.Equal(t, entity.SpamToken{Token: "casino", SpamCount: 12, HamCount: 1}, tokens["casino"])
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - query fails", func(t *testing.T) {
+		db, mock, repo := setupSpamTokenMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectSpamTokens).
+			WithArgs("casino", "article").
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		tokens, err := repo.GetByTokens(context.Background(), []string{"casino", "article"})
+
+		assert.Nil(t, tokens)
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestSpamTokenRepo_Retrain(t *testing.T) {
+	spam := entity.SpamTraining{
+		Class:  entity.SpamClassSpam,
+		Text:   "Casino bonus",
+		Tokens: []string{"casino", entity.SpamTokenDocuments},
+	}
+	ham := entity.SpamTraining{
+		Class:  entity.SpamClassHam,
+		Text:   "Nice article",
+		Tokens: []string{"article", entity.SpamTokenDocuments},
+	}
+
+	t.Run("success - move from spam to ham", func(t *testing.T) {
+		db, mock, repo := setupSpamTokenMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlUpdateTrainedClass).
+			WithArgs(entity.SpamClassHam, "Nice article", testSpamCommentID, entity.SpamClassSpam).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(sqlAdjustSpamTokens).
+			WithArgs("casino", -1, 0, entity.SpamTokenDocuments, -1, 0, -1, 0).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(sqlAdjustSpamTokens).
+			WithArgs("article", 0, 1, entity.SpamTokenDocuments, 0, 1, 0, 1).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.Retrain(context.Background(), testSpamCommentID, spam, ham)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - first training only adds", func(t *testing.T) {
+		db, mock, repo := setupSpamTokenMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlUpdateTrainedClass).
+			WithArgs(entity.SpamClassSpam, "Casino bonus", testSpamCommentID, "").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(sqlAdjustSpamTokens).
+			WithArgs("casino", 1, 0, entity.SpamTokenDocuments, 1, 0, 1, 0).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.Retrain(context.Background(), testSpamCommentID, entity.SpamTraining{}, spam)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - untraining only removes", func(t *testing.T) {
+		db, mock, repo := setupSpamTokenMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlUpdateTrainedClass).
+			WithArgs("", "", testSpamCommentID, entity.SpamClassHam).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(sqlAdjustSpamTokens).
+			WithArgs("article", 0, -1, entity.SpamTokenDocuments, 0, -1, 0, -1).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		err := repo.Retrain(context.Background(), testSpamCommentID, ham, entity.SpamTraining{})
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - retrained in the meantime", func(t *testing.T) {
+		db, mock, repo := setupSpamTokenMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlUpdateTrainedClass).
+			WithArgs(entity.SpamClassHam, "Nice article", testSpamCommentID, entity.SpamClassSpam).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		err := repo.Retrain(context.Background(), testSpamCommentID, spam, ham)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - adjust fails", func(t *testing.T) {
+		db, mock, repo := setupSpamTokenMockDB(t)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectExec(sqlUpdateTrainedClass).
+			WithArgs(entity.SpamClassHam, "Nice article", testSpamCommentID, entity.SpamClassSpam).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(sqlAdjustSpamTokens).
+			WillReturnError(apperror.ErrDatabaseConnection)
+		mock.ExpectRollback()
+
+		err := repo.Retrain(context.Background(), testSpamCommentID, spam, ham)
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+)
+
+// translationSource is the table holding a type of translated content, with
+// the column naming a variant in listings.
+type translationSource struct {
+	table string
+	title string
+}
+
+var translationSources = map[string]translationSource{
+	entity.TranslationNews:     {table: "news", title: "title"},
+	entity.TranslationCategory: {table: "categories", title: "name"},
+	entity.TranslationPage:     {table: "custom_pages", title: "custom_url"},
+}
+
+// TranslationRepo implements repository.TranslationRepo interface.
+type TranslationRepo struct {
+	*postgres.Postgres
+}
+
+// NewPostgresTranslationRepo creates a new PostgreSQL translation repository.
+func NewPostgresTranslationRepo(pg *postgres.Postgres) *TranslationRepo {
+	return &TranslationRepo{pg}
+}
+
+// GetByType returns every variant of a type of content, oldest first.
+func (r *TranslationRepo) GetByType(ctx context.Context, contentType string) ([]entity.Translation, error) {
+	source, err := translationSourceOf(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.Builder.
+		Select("id", "locale", "translation_group", source.title).
+		From(source.table).
+		OrderBy("created_at", "id")
+
+	return r.getMany(ctx, query)
+}
+
+// GetGroup returns the variants in the translation group of the content
+// with the given ID, itself included, oldest first. It is empty when no
+// such content exists.
+func (r *TranslationRepo) GetGroup(ctx context.Context, contentType, id string) ([]entity.Translation, error) {
+	source, err := translationSourceOf(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	group := squirrel.
+		Select("translation_group").
+		From(source.table).
+		Where("id = ?", id)
+
+	query := r.Builder.
+		Select("id", "locale", "translation_group", source.title).
+		From(source.table).
+		Where(squirrel.Expr("translation_group = (?)", group)).
+		OrderBy("created_at", "id")
+
+	return r.getMany(ctx, query)
+}
+
+func (r *TranslationRepo) getMany(ctx context.Context, query squirrel.SelectBuilder) ([]entity.Translation, error) {
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	translations := []entity.Translation{}
+
+	for rows.Next() {
+		var translation entity.Translation
+		if err := rows.Scan(&translation.ID, &translation.Locale, &translation.Group, &translation.Title); err != nil {
+			return nil, err
+		}
+
+		translations = append(translations, translation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return translations, nil
+}
+
+func translationSourceOf(contentType string) (translationSource, error) {
+	source, ok := translationSources[contentType]
+	if !ok {
+		return translationSource{}, fmt.Errorf("unknown translation type %q", contentType)
+	}
+
+	return source, nil
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/Masterminds/squirrel"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sqlSelectNewsTranslations = `SELECT id, locale, translation_group, title FROM news ORDER BY created_at, id`
+	sqlSelectPageTranslations = `SELECT id, locale, translation_group, custom_url FROM custom_pages ORDER BY created_at, id`
+	sqlSelectCategoryVariants = `SELECT id, locale, translation_group, name FROM categories WHERE translation_group = \(SELECT translation_group FROM categories WHERE id = \$1\) ORDER BY created_at, id`
+	testTranslationGroupID    = "550e8400-e29b-41d4-a716-446655440080"
+	testTranslationVariantID  = "550e8400-e29b-41d4-a716-446655440081"
+	testTranslationOtherID    = "550e8400-e29b-41d4-a716-446655440082"
+)
+
+var translationRowColumns = []string{"id", "locale", "translation_group", "title"}
+
+func setupTranslationMockDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *TranslationRepo) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	pg := &postgres.Postgres{
+		DB:      db,
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+
+	repo := NewPostgresTranslationRepo(pg)
+
+	return db, mock, repo
+}
+
+func TestTranslationRepo_GetByType(t *testing.T) {
+	t.Run("success - news variants", func(t *testing.T) {
+		db, mock, repo := setupTranslationMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectNewsTranslations).
+			WillReturnRows(sqlmock.NewRows(translationRowColumns).
+				AddRow(testTranslationVariantID, "en", testTranslationGroupID, "Elections").
+				AddRow(testTranslationOtherID, "id", testTranslationGroupID, "Pemilu"))
+
+		translations, err := repo.GetByType(context.Background(), entity.TranslationNews)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []entity.Translation{
+			{ID: testTranslationVariantID, Locale: "en", Group: testTranslationGroupID, Title: "Elections"},
+			{ID: testTranslationOtherID, Locale: "id", Group: testTranslationGroupID, Title: "Pemilu"},
+		}, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - pages named by their URL", func(t *testing.T) {
+		db, mock, repo := setupTranslationMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectPageTranslations).
+			WillReturnRows(sqlmock.NewRows(translationRowColumns))
+
+		translations, err := repo.GetByType(context.Background(), entity.TranslationPage)
+
+		assert.NoError(t, err)
+		assert.Empty(t, translations)
+		assert.NotNil(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - unknown type", func(t *testing.T) {
+		db, mock, repo := setupTranslationMockDB(t)
+		defer db.Close()
+
+		translations, err := repo.GetByType(context.Background(), "tags")
+
+		assert.Error(t, err)
+		assert.Nil(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - database query fails", func(t *testing.T) {
+		db, mock, repo := setupTranslationMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectNewsTranslations).
+			WillReturnError(apperror.ErrDatabaseConnection)
+
+		translations, err := repo.GetByType(context.Background(), entity.TranslationNews)
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.Nil(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestTranslationRepo_GetGroup(t *testing.T) {
+	t.Run("success - variants of a category", func(t *testing.T) {
+		db, mock, repo := setupTranslationMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectCategoryVariants).
+			WithArgs(testTranslationOtherID).
+			WillReturnRows(sqlmock.NewRows(translationRowColumns).
+				AddRow(testTranslationVariantID, "en", testTranslationGroupID, "Politics").
+				AddRow(testTranslationOtherID, "id", testTranslationGroupID, "Politik"))
+
+		translations, err := repo.GetGroup(context.Background(), entity.TranslationCategory, testTranslationOtherID)
+
+		assert.NoError(t, err)
+		require.Len(t, translations, 2)
+		assert.Equal(t, "en", translations[0].Locale)
+		assert.Equal(t, "Politik", translations[1].Title)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("success - unknown content has no variants", func(t *testing.T) {
+		db, mock, repo := setupTranslationMockDB(t)
+		defer db.Close()
+
+		mock.ExpectQuery(sqlSelectCategoryVariants).
+			WithArgs(nonExistentID).
+			WillReturnRows(sqlmock.NewRows(translationRowColumns))
+
+		translations, err := repo.GetGroup(context.Background(), entity.TranslationCategory, nonExistentID)
+
+		assert.NoError(t, err)
+		assert.Empty(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("error - unknown type", func(t *testing.T) {
+		db, mock, repo := setupTranslationMockDB(t)
+		defer db.Close()
+
+		translations, err := repo.GetGroup(context.Background(), "tags", testTranslationVariantID)
+
+		assert.Error(t, err)
+		assert.Nil(t, translations)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,108 @@
+// Package storage implements repository.Storage on the local disk and on
+// S3-compatible object stores.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+)
+
+const (
+	_dirMode  = 0o755
+	_fileMode = 0o644
+)
+
+// LocalStorage keeps files below a directory, spread over subdirectories
+// named after the first characters of their key.
+type LocalStorage struct {
+	dir string
+}
+
+// NewLocalStorage stores files below dir, creating it if needed.
+func NewLocalStorage(dir string) (*LocalStorage, error) {
+	if err := os.MkdirAll(dir, _dirMode); err != nil {
+		return nil, fmt.Errorf("storage - NewLocalStorage - os.MkdirAll: %w", err)
+	}
+
+	return &LocalStorage{dir: dir}, nil
+}
+
+// Put writes data to a temporary file first and renames it into place, so
+// readers never see a partial file.
+func (s *LocalStorage) Put(_ context.Context, key string, data []byte, _ string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), _dirMode); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck // already renamed on success
+
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck,gosec // the write error is the one reported
+
+		return err
+	}
+
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	if err = os.Chmod(tmp.Name(), _fileMode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *LocalStorage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, apperror.ErrNotFound
+	}
+
+	return file, err
+}
+
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err = os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// path maps a key to its file, e.g. "ab/cd/abcd…", refusing keys that could
+// escape the directory.
+func (s *LocalStorage) path(key string) (string, error) {
+	if len(key) < 4 || strings.ContainsAny(key, `/\`) || strings.HasPrefix(key, ".") {
+		return "", apperror.ErrNotFound
+	}
+
+	return filepath.Join(s.dir, key[:2], key[2:4], key), nil
+}
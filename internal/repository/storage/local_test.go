@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKey = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08.png"
+
+func setupLocalStorage(t *testing.T) (*LocalStorage, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	store, err := NewLocalStorage(dir)
+	require.NoError(t, err)
+
+	return store, dir
+}
+
+func TestLocalStorage_PutGet(t *testing.T) {
+	t.Run("success - stores a file below its key prefix", func(t *testing.T) {
+		store, dir := setupLocalStorage(t)
+		ctx := context.Background()
+
+		err := store.Put(ctx, testKey, []byte("image"), "image/png")
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(dir, "9f", "86", testKey))
+		require.NoError(t, err)
+
+		file, err := store.Get(ctx, testKey)
+		require.NoError(t, err)
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "image", string(data))
+	})
+
+	t.Run("success - overwrites an existing file", func(t *testing.T) {
+		store, _ := setupLocalStorage(t)
+		ctx := context.Background()
+
+		require.NoError(t, store.Put(ctx, testKey, []byte("old"), "image/png"))
+		require.NoError(t, store.Put(ctx, testKey, []byte("new"), "image/png"))
+
+		file, err := store.Get(ctx, testKey)
+		require.NoError(t, err)
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "new", string(data))
+	})
+
+	t.Run("error - unknown key", func(t *testing.T) {
+		store, _ := setupLocalStorage(t)
+
+		file, err := store.Get(context.Background(), testKey)
+
+		assert.Nil(t, file)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+
+	t.Run("error - keys escaping the directory are refused", func(t *testing.T) {
+		store, _ := setupLocalStorage(t)
+		ctx := context.Background()
+
+		for _, key := range []string{"../../etc/passwd", `..\secret`, ".hidden", "abc", ""} {
+			assert.ErrorIs(t, store.Put(ctx, key, []byte("x"), "text/plain"), apperror.ErrNotFound, key)
+
+			_, err := store.Get(ctx, key)
+			assert.ErrorIs(t, err, apperror.ErrNotFound, key)
+		}
+	})
+}
+
+func TestLocalStorage_Delete(t *testing.T) {
+	t.Run("success - removes the file", func(t *testing.T) {
+		store, _ := setupLocalStorage(t)
+		ctx := context.Background()
+
+		require.NoError(t, store.Put(ctx, testKey, []byte("image"), "image/png"))
+
+		err := store.Delete(ctx, testKey)
+		require.NoError(t, err)
+
+		_, err = store.Get(ctx, testKey)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+
+	t.Run("success - unknown key", func(t *testing.T) {
+		store, _ := setupLocalStorage(t)
+
+		err := store.Delete(context.Background(), testKey)
+
+		assert.NoError(t, err)
+	})
+}
@@ -10,17 +10,31 @@ import (
 
 type CategoryUseCase struct {
 	categoryRepo repository.CategoryRepo
+	localizer    *Localizer
 }
 
-func NewCategoryUseCase(categoryRepo repository.CategoryRepo) *CategoryUseCase {
+func NewCategoryUseCase(categoryRepo repository.CategoryRepo, localizer *Localizer) *CategoryUseCase {
 	return &CategoryUseCase{
 		categoryRepo: categoryRepo,
+		localizer:    localizer,
 	}
 }
 
 func (cu *CategoryUseCase) Create(ctx context.Context, req *dto.CreateCategoryRequestDTO) (*dto.CategoryResponseDTO, error) {
+	locale, err := cu.localizer.locale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := cu.localizer.join(ctx, entity.TranslationCategory, req.TranslationOf, locale)
+	if err != nil {
+		return nil, err
+	}
+
 	category := &entity.Category{
-		Name: req.Name,
+		Name:             req.Name,
+		Locale:           locale,
+		TranslationGroup: group,
 	}
 
 	result, err := cu.categoryRepo.Create(ctx, category)
@@ -28,55 +42,81 @@ func (cu *CategoryUseCase) Create(ctx context.Context, req *dto.CreateCategoryRe
 		return nil, err
 	}
 
-	return &dto.CategoryResponseDTO{
-		ID:        result.ID,
-		Name:      result.Name,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, nil
+	response := categoryResponse(result)
+
+	return &response, nil
 }
 
-func (cu *CategoryUseCase) GetByID(ctx context.Context, id string) (*dto.CategoryResponseDTO, error) {
+// GetByID returns a category, or its translation ranked best for a reader
+// preferring locales.
+func (cu *CategoryUseCase) GetByID(ctx context.Context, id string, locales []string) (*dto.CategoryResponseDTO, error) {
 	category, err := cu.categoryRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.CategoryResponseDTO{
-		ID:        category.ID,
-		Name:      category.Name,
-		CreatedAt: category.CreatedAt,
-		UpdatedAt: category.UpdatedAt,
-	}, nil
+	best, err := cu.localizer.pick(ctx, entity.TranslationCategory, id, cu.localizer.chain(locales))
+	if err != nil {
+		return nil, err
+	}
+
+	if best != id {
+		category, err = cu.categoryRepo.GetByID(ctx, best)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := categoryResponse(category)
+
+	return &response, nil
 }
 
-func (cu *CategoryUseCase) GetAll(ctx context.Context) ([]dto.CategoryResponseDTO, error) {
+// GetAll lists the categories, one translation of each for a reader
+// preferring locales, or all of them when locales is empty.
+func (cu *CategoryUseCase) GetAll(ctx context.Context, locales []string) ([]dto.CategoryResponseDTO, error) {
 	categories, err := cu.categoryRepo.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	categories = localize(categories, cu.localizer.chain(locales), func(c *entity.Category) (string, string) {
+		return c.TranslationGroup, c.Locale
+	})
+
 	result := make([]dto.CategoryResponseDTO, 0, len(categories))
 
-	for _, category := range categories {
-		result = append(result, dto.CategoryResponseDTO{
-			ID:        category.ID,
-			Name:      category.Name,
-			CreatedAt: category.CreatedAt,
-			UpdatedAt: category.UpdatedAt,
-		})
+	for i := range categories {
+		result = append(result, categoryResponse(&categories[i]))
 	}
 
 	return result, nil
 }
 
+func categoryResponse(category *entity.Category) dto.CategoryResponseDTO {
+	return dto.CategoryResponseDTO{
+		ID:               category.ID,
+		Name:             category.Name,
+		Locale:           category.Locale,
+		TranslationGroup: category.TranslationGroup,
+		CreatedAt:        category.CreatedAt,
+		UpdatedAt:        category.UpdatedAt,
+	}
+}
+
 func (cu *CategoryUseCase) Update(ctx context.Context, id string, req *dto.UpdateCategoryRequestDTO) error {
+	locale, err := cu.localizer.relocate(ctx, entity.TranslationCategory, id, req.Locale)
+	if err != nil {
+		return err
+	}
+
 	category := &entity.Category{
-		ID:   id,
-		Name: req.Name,
+		ID:     id,
+		Name:   req.Name,
+		Locale: locale,
 	}
 
-	err := cu.categoryRepo.Update(ctx, category)
+	err = cu.categoryRepo.Update(ctx, category)
 	if err != nil {
 		return err
 	}
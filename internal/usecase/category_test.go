@@ -79,7 +79,7 @@ func (m *MockCategoryRepo) Delete(ctx context.Context, id string) error {
 func TestCategoryUseCase_Create(t *testing.T) {
 	t.Run("success - create category", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		req := &dto.CreateCategoryRequestDTO{
@@ -107,9 +107,40 @@ func TestCategoryUseCase_Create(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("success - create translation of a category", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepo)
+		translationRepo := new(MockTranslationRepo)
+		useCase := NewCategoryUseCase(mockRepo, NewLocalizer(translationRepo, testLocaleConfig))
+
+		ctx := context.Background()
+
+		translationRepo.On("GetGroup", ctx, entity.TranslationCategory, testEnglishID).Return(testVariants()[:1], nil)
+		mockRepo.On("Create", ctx, &entity.Category{Name: "Pemilu", Locale: "id", TranslationGroup: testTranslationGroup}).
+			Return(&entity.Category{ID: testIndonesianID, Name: "Pemilu", Locale: "id", TranslationGroup: testTranslationGroup}, nil)
+
+		result, err := useCase.Create(ctx, &dto.CreateCategoryRequestDTO{Name: "Pemilu", Locale: "id", TranslationOf: testEnglishID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "id", result.Locale)
+		assert.Equal(t, testTranslationGroup, result.TranslationGroup)
+		mockRepo.AssertExpectations(t)
+		translationRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - unsupported locale", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
+
+		result, err := useCase.Create(context.Background(), &dto.CreateCategoryRequestDTO{Name: "Politique", Locale: "fr"})
+
+		assert.Equal(t, apperror.ErrUnsupportedLocale, err)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
 	t.Run("error - repository create fails", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		req := &dto.CreateCategoryRequestDTO{
@@ -130,7 +161,7 @@ func TestCategoryUseCase_Create(t *testing.T) {
 func TestCategoryUseCase_GetByID(t *testing.T) {
 	t.Run("success - get category by id", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := testCategoryID
@@ -145,7 +176,7 @@ func TestCategoryUseCase_GetByID(t *testing.T) {
 
 		mockRepo.On("GetByID", ctx, categoryID).Return(expectedCategory, nil)
 
-		result, err := useCase.GetByID(ctx, categoryID)
+		result, err := useCase.GetByID(ctx, categoryID, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -154,16 +185,34 @@ func TestCategoryUseCase_GetByID(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("success - translation in the preferred locale", func(t *testing.T) {
+		mockRepo := new(MockCategoryRepo)
+		translationRepo := new(MockTranslationRepo)
+		useCase := NewCategoryUseCase(mockRepo, NewLocalizer(translationRepo, testLocaleConfig))
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testEnglishID).Return(&entity.Category{ID: testEnglishID, Name: "Elections", Locale: "en"}, nil)
+		mockRepo.On("GetByID", ctx, testIndonesianID).Return(&entity.Category{ID: testIndonesianID, Name: "Pemilu", Locale: "id"}, nil)
+		translationRepo.On("GetGroup", ctx, entity.TranslationCategory, testEnglishID).Return(testVariants(), nil)
+
+		result, err := useCase.GetByID(ctx, testEnglishID, []string{"id-ID"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Pemilu", result.Name)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("error - category not found", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := nonExistentID
 
 		mockRepo.On("GetByID", ctx, categoryID).Return(nil, apperror.ErrNotFound)
 
-		result, err := useCase.GetByID(ctx, categoryID)
+		result, err := useCase.GetByID(ctx, categoryID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -173,14 +222,14 @@ func TestCategoryUseCase_GetByID(t *testing.T) {
 
 	t.Run("error - repository get fails", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := testCategoryID
 
 		mockRepo.On("GetByID", ctx, categoryID).Return(nil, apperror.ErrDatabaseConnection)
 
-		result, err := useCase.GetByID(ctx, categoryID)
+		result, err := useCase.GetByID(ctx, categoryID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -192,7 +241,7 @@ func TestCategoryUseCase_GetByID(t *testing.T) {
 func TestCategoryUseCase_GetAll(t *testing.T) {
 	t.Run("success - get all categories", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 
@@ -214,7 +263,7 @@ func TestCategoryUseCase_GetAll(t *testing.T) {
 
 		mockRepo.On("GetAll", ctx).Return(categories, nil)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -226,7 +275,7 @@ func TestCategoryUseCase_GetAll(t *testing.T) {
 
 	t.Run("success - get all categories empty result", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 
@@ -234,7 +283,7 @@ func TestCategoryUseCase_GetAll(t *testing.T) {
 
 		mockRepo.On("GetAll", ctx).Return(categories, nil)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -244,13 +293,13 @@ func TestCategoryUseCase_GetAll(t *testing.T) {
 
 	t.Run("error - repository getall fails", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 
 		mockRepo.On("GetAll", ctx).Return(nil, apperror.ErrDatabaseConnection)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -262,7 +311,7 @@ func TestCategoryUseCase_GetAll(t *testing.T) {
 func TestCategoryUseCase_Update(t *testing.T) {
 	t.Run("success - update category", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := testCategoryID
@@ -282,7 +331,7 @@ func TestCategoryUseCase_Update(t *testing.T) {
 
 	t.Run("error - category not found", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := nonExistentID
@@ -301,7 +350,7 @@ func TestCategoryUseCase_Update(t *testing.T) {
 
 	t.Run("error - repository update fails", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := testCategoryID
@@ -322,7 +371,7 @@ func TestCategoryUseCase_Update(t *testing.T) {
 func TestCategoryUseCase_Delete(t *testing.T) {
 	t.Run("success - delete category", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := testCategoryID
@@ -337,7 +386,7 @@ func TestCategoryUseCase_Delete(t *testing.T) {
 
 	t.Run("error - category not found", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := nonExistentID
@@ -353,7 +402,7 @@ func TestCategoryUseCase_Delete(t *testing.T) {
 
 	t.Run("error - repository delete fails", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		ctx := context.Background()
 		categoryID := testCategoryID
@@ -372,7 +421,7 @@ func TestNewCategoryUseCase(t *testing.T) {
 	t.Run("success - create new category usecase", func(t *testing.T) {
 		mockRepo := new(MockCategoryRepo)
 
-		useCase := NewCategoryUseCase(mockRepo)
+		useCase := NewCategoryUseCase(mockRepo, testLocalizer())
 
 		assert.NotNil(t, useCase)
 		assert.NotNil(t, useCase.categoryRepo)
@@ -2,33 +2,683 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"time"
 
+	"github.com/RizqiSugiarto/coding-test/config"
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/pow"
 )
 
+// Comment moderation policies, selected with COMMENT_MODERATION_POLICY.
+const (
+	CommentPolicyAutoApprove      = "auto_approve"
+	CommentPolicyRequireApproval  = "require_approval"
+	CommentPolicyApproveReturning = "approve_returning"
+)
+
+const editTokenSize = 32
+
+// defaultModerationPageSize is how many comments a moderation list holds
+// when no limit is given.
+const defaultModerationPageSize = 50
+
 type CommentUseCase struct {
-	commentRepo repository.CommentRepo
+	commentRepo   repository.CommentRepo
+	newsRepo      repository.NewsRepo
+	voteRepo      repository.CommentVoteRepo
+	reportRepo    repository.CommentReportRepo
+	challengeRepo repository.ChallengeRepo
+	challenges    *pow.Issuer
+	cfg           config.Comment
+	filters       []CommentFilter
 }
 
-func NewCommentUseCase(commentRepo repository.CommentRepo) *CommentUseCase {
+// NewCommentUseCase creates a comment use case. A nil challenge issuer turns
+// off the proof-of-work requirement. New comments pass through the filters in
+// order before the moderation policy decides their status.
+func NewCommentUseCase(
+	commentRepo repository.CommentRepo,
+	newsRepo repository.NewsRepo,
+	voteRepo repository.CommentVoteRepo,
+	reportRepo repository.CommentReportRepo,
+	challengeRepo repository.ChallengeRepo,
+	challenges *pow.Issuer,
+	cfg config.Comment,
+	filters ...CommentFilter,
+) *CommentUseCase {
 	return &CommentUseCase{
-		commentRepo: commentRepo,
+		commentRepo:   commentRepo,
+		newsRepo:      newsRepo,
+		voteRepo:      voteRepo,
+		reportRepo:    reportRepo,
+		challengeRepo: challengeRepo,
+		challenges:    challenges,
+		cfg:           cfg,
+		filters:       filters,
+	}
+}
+
+// IssueChallenge returns a proof-of-work challenge for commenting on a news
+// article, or nil when proof of work is disabled.
+func (co *CommentUseCase) IssueChallenge(_ context.Context, newsID string) (*dto.CommentChallengeDTO, error) {
+	if co.challenges == nil {
+		return nil, nil //nolint:nilnil // no challenge is required
+	}
+
+	challenge, err := co.challenges.Issue(newsID)
+	if err != nil {
+		return nil, err
 	}
+
+	return &dto.CommentChallengeDTO{
+		Challenge:  challenge.Token,
+		Algorithm:  pow.Algorithm,
+		Difficulty: challenge.Difficulty,
+		ExpiresAt:  challenge.ExpiresAt,
+	}, nil
 }
 
-func (co *CommentUseCase) Create(ctx context.Context, req *dto.CreateCommentRequestDTO) error {
+// Create validates a new comment and its parent and runs it through the
+// filters before redeeming its challenge, so a comment that is turned away
+// does not use up the challenge it solved.
+func (co *CommentUseCase) Create(ctx context.Context, req *dto.CreateCommentRequestDTO) (*dto.CommentResponseDTO, error) {
+	if err := co.checkCommentsOpen(ctx, req.NewsID); err != nil {
+		return nil, err
+	}
+
 	comment := &entity.Comment{
-		Name:    req.Name,
-		NewsID:  req.NewsID,
-		Comment: req.Comment,
+		Name:        req.Name,
+		NewsID:      req.NewsID,
+		Comment:     req.Comment,
+		Fingerprint: req.Fingerprint,
+	}
+
+	if req.ParentID != "" {
+		if err := co.attachParent(ctx, comment, req.ParentID); err != nil {
+			return nil, err
+		}
+	}
+
+	status, err := co.moderationStatus(ctx, comment)
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Status = status
+
+	editToken, err := newEditToken()
+	if err != nil {
+		return nil, err
+	}
+
+	comment.EditTokenHash = hashEditToken(editToken)
+
+	if err := co.redeemChallenge(ctx, req); err != nil {
+		return nil, err
+	}
+
+	result, err := co.commentRepo.Create(ctx, comment)
+	if err != nil {
+		return nil, err
 	}
 
-	err := co.commentRepo.Create(ctx, comment)
+	resp := toCommentResponseDTO(result)
+	resp.EditToken = editToken
+
+	return &resp, nil
+}
+
+// Update edits the text of a comment. Authors need the edit token and must
+// edit within the edit window, and their edits pass through the filters and
+// the moderation policy again. Moderators may edit any comment.
+func (co *CommentUseCase) Update(ctx context.Context, id string, req *dto.UpdateCommentRequestDTO) (*dto.CommentResponseDTO, error) {
+	comment, err := co.authorizeEdit(ctx, id, req.EditToken, req.Moderator)
 	if err != nil {
+		return nil, err
+	}
+
+	comment.Comment = req.Comment
+
+	if !req.Moderator {
+		status, err := co.moderationStatus(ctx, comment)
+		if err != nil {
+			return nil, err
+		}
+
+		comment.Status = status
+	}
+
+	if err := co.commentRepo.Update(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	resp := toCommentResponseDTO(comment)
+
+	return &resp, nil
+}
+
+// Delete removes a comment and its replies. Authors need the edit token and
+// must delete within the edit window. Moderators may delete any comment.
+func (co *CommentUseCase) Delete(ctx context.Context, id string, req *dto.DeleteCommentRequestDTO) error {
+	if _, err := co.authorizeEdit(ctx, id, req.EditToken, req.Moderator); err != nil {
 		return err
 	}
 
+	return co.commentRepo.Delete(ctx, id)
+}
+
+// GetByNewsID returns the approved comments of a news article either nested
+// as a tree of replies or as a flat list in thread order with depth and path.
+// Replies to the same comment are ordered oldest first, or by score when top
+// is set.
+func (co *CommentUseCase) GetByNewsID(ctx context.Context, newsID string, tree, top bool) ([]dto.CommentResponseDTO, error) {
+	comments, err := co.commentRepo.GetByNewsID(ctx, newsID, entity.CommentStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := co.attachVotes(ctx, comments); err != nil {
+		return nil, err
+	}
+
+	// Index replies by parent, keeping the repository's chronological order
+	children := make(map[string][]int, len(comments))
+	for i := range comments {
+		children[comments[i].ParentID] = append(children[comments[i].ParentID], i)
+	}
+
+	if top {
+		for _, siblings := range children {
+			sort.SliceStable(siblings, func(a, b int) bool {
+				return comments[siblings[a]].Votes.Score() > comments[siblings[b]].Votes.Score()
+			})
+		}
+	}
+
+	if tree {
+		return buildCommentTree(comments, children, ""), nil
+	}
+
+	result := make([]dto.CommentResponseDTO, 0, len(comments))
+
+	return flattenCommentThread(comments, children, "", result), nil
+}
+
+// Vote stores the up (1) or down (-1) vote of a reader on an approved
+// comment, or withdraws it (0), and returns the updated tally. Each reader
+// has one vote per comment.
+func (co *CommentUseCase) Vote(ctx context.Context, id, voter string, value int) (*dto.CommentVotesDTO, error) {
+	if value < -1 || value > 1 {
+		return nil, apperror.ErrInvalidVote
+	}
+
+	comment, err := co.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Status != entity.CommentStatusApproved {
+		return nil, apperror.ErrNotFound
+	}
+
+	if err := co.voteRepo.Vote(ctx, id, voter, value); err != nil {
+		return nil, err
+	}
+
+	votes, err := co.voteRepo.CountByCommentIDs(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.CommentVotesDTO{
+		Upvotes:   votes[id].Upvotes,
+		Downvotes: votes[id].Downvotes,
+		Score:     votes[id].Score(),
+	}, nil
+}
+
+// GetModerationQueue lists a page of comments in the given moderation status,
+// pending when status is empty.
+func (co *CommentUseCase) GetModerationQueue(ctx context.Context, status string, limit, offset int) ([]dto.CommentResponseDTO, error) {
+	if status == "" {
+		status = entity.CommentStatusPending
+	}
+
+	if !isCommentStatus(status) {
+		return nil, apperror.ErrInvalidCommentStatus
+	}
+
+	comments, err := co.commentRepo.GetByStatus(ctx, status, moderationPageSize(limit), offset)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.CommentResponseDTO, 0, len(comments))
+
+	for i := range comments {
+		result = append(result, toCommentResponseDTO(&comments[i]))
+	}
+
+	return result, nil
+}
+
+// Approve publishes the given comments.
+func (co *CommentUseCase) Approve(ctx context.Context, ids []string) (int64, error) {
+	return co.moderate(ctx, ids, entity.CommentStatusApproved)
+}
+
+// Reject hides the given comments, marking them as spam when requested.
+func (co *CommentUseCase) Reject(ctx context.Context, ids []string, spam bool) (int64, error) {
+	status := entity.CommentStatusRejected
+	if spam {
+		status = entity.CommentStatusSpam
+	}
+
+	return co.moderate(ctx, ids, status)
+}
+
+// attachParent makes comment a reply to the parent comment.
+func (co *CommentUseCase) attachParent(ctx context.Context, comment *entity.Comment, parentID string) error {
+	parent, err := co.commentRepo.GetByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrNotFound) {
+			return apperror.ErrInvalidParentComment
+		}
+
+		return err
+	}
+
+	// Replies must stay within the visible thread of the same article
+	if parent.NewsID != comment.NewsID || parent.Status != entity.CommentStatusApproved {
+		return apperror.ErrInvalidParentComment
+	}
+
+	if parent.Depth+1 > co.cfg.MaxDepth {
+		return apperror.ErrMaxCommentDepth
+	}
+
+	comment.ParentID = parent.ID
+	comment.Depth = parent.Depth + 1
+
 	return nil
 }
+
+// moderationStatus runs the filters on a comment written by a visitor and
+// combines their verdict with the moderation policy.
+func (co *CommentUseCase) moderationStatus(ctx context.Context, comment *entity.Comment) (string, error) {
+	action, err := co.applyFilters(ctx, comment)
+	if err != nil {
+		return "", err
+	}
+
+	if action == CommentFilterReject {
+		return "", apperror.ErrCommentRejected
+	}
+
+	status, err := co.initialStatus(ctx, comment.Fingerprint)
+	if err != nil {
+		return "", err
+	}
+
+	return filteredStatus(status, action), nil
+}
+
+// authorizeEdit loads a comment and checks that the caller may change it:
+// moderators always may, authors only with the edit token, within the edit
+// window, and while the comment has not been rejected.
+func (co *CommentUseCase) authorizeEdit(ctx context.Context, id, editToken string, moderator bool) (*entity.Comment, error) {
+	comment, err := co.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if moderator {
+		return comment, nil
+	}
+
+	if editToken == "" || comment.EditTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(hashEditToken(editToken)), []byte(comment.EditTokenHash)) != 1 {
+		return nil, apperror.ErrInvalidEditToken
+	}
+
+	if time.Since(comment.CreatedAt) > co.cfg.EditWindow ||
+		comment.Status == entity.CommentStatusRejected || comment.Status == entity.CommentStatusSpam {
+		return nil, apperror.ErrCommentNotEditable
+	}
+
+	return comment, nil
+}
+
+// Report records a reader's report of an approved comment. Each reader counts
+// once. When ReportThreshold readers have reported it, the comment is hidden
+// and sent back to the moderation queue.
+func (co *CommentUseCase) Report(ctx context.Context, id, reporter string, req *dto.ReportCommentRequestDTO) error {
+	if !isReportReason(req.Reason) {
+		return apperror.ErrInvalidReportReason
+	}
+
+	comment, err := co.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if comment.Status != entity.CommentStatusApproved {
+		return apperror.ErrNotFound
+	}
+
+	err = co.reportRepo.Add(ctx, &entity.CommentReport{
+		CommentID: id,
+		Reporter:  reporter,
+		Reason:    req.Reason,
+		Note:      req.Note,
+	})
+	if err != nil {
+		return err
+	}
+
+	if co.cfg.ReportThreshold <= 0 {
+		return nil
+	}
+
+	reports, err := co.reportRepo.CountByCommentID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if reports >= co.cfg.ReportThreshold {
+		_, err = co.commentRepo.UpdateStatus(ctx, []string{id}, entity.CommentStatusPending)
+	}
+
+	return err
+}
+
+// GetReported returns a page of the comments with open reports, most reported
+// first.
+func (co *CommentUseCase) GetReported(ctx context.Context, limit, offset int) ([]dto.ReportedCommentDTO, error) {
+	reported, err := co.reportRepo.GetReported(ctx, moderationPageSize(limit), offset)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.ReportedCommentDTO, 0, len(reported))
+
+	for i := range reported {
+		result = append(result, dto.ReportedCommentDTO{
+			Comment:        toCommentResponseDTO(&reported[i].Comment),
+			Reports:        reported[i].Reports,
+			Reasons:        reported[i].Reasons,
+			LastReportedAt: reported[i].LastReportedAt,
+		})
+	}
+
+	return result, nil
+}
+
+func moderationPageSize(limit int) int {
+	if limit <= 0 {
+		return defaultModerationPageSize
+	}
+
+	return limit
+}
+
+// attachVotes loads the vote tally of each comment.
+func (co *CommentUseCase) attachVotes(ctx context.Context, comments []entity.Comment) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(comments))
+	for i := range comments {
+		ids = append(ids, comments[i].ID)
+	}
+
+	votes, err := co.voteRepo.CountByCommentIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range comments {
+		comments[i].Votes = votes[comments[i].ID]
+	}
+
+	return nil
+}
+
+// checkCommentsOpen returns ErrCommentsClosed when a news article has
+// comments turned off or its close time has passed. Articles without a close
+// time of their own close CloseAfterDays after publication.
+func (co *CommentUseCase) checkCommentsOpen(ctx context.Context, newsID string) error {
+	news, err := co.newsRepo.GetByID(ctx, newsID)
+	if err != nil {
+		return err
+	}
+
+	if !news.CommentsEnabled {
+		return apperror.ErrCommentsClosed
+	}
+
+	closeAt := news.CommentsCloseAt
+	if closeAt == nil && co.cfg.CloseAfterDays > 0 {
+		autoClose := news.CreatedAt.AddDate(0, 0, co.cfg.CloseAfterDays)
+		closeAt = &autoClose
+	}
+
+	if closeAt != nil && !time.Now().Before(*closeAt) {
+		return apperror.ErrCommentsClosed
+	}
+
+	return nil
+}
+
+// redeemChallenge verifies the solved proof-of-work challenge of a new
+// comment and marks it as used.
+func (co *CommentUseCase) redeemChallenge(ctx context.Context, req *dto.CreateCommentRequestDTO) error {
+	if co.challenges == nil {
+		return nil
+	}
+
+	if req.Challenge == "" {
+		return apperror.ErrInvalidChallenge
+	}
+
+	challenge, err := co.challenges.Verify(req.Challenge, req.NewsID, req.Nonce)
+	if err != nil {
+		return err
+	}
+
+	return co.challengeRepo.Redeem(ctx, pow.ID(challenge.Token), challenge.ExpiresAt)
+}
+
+// moderate moves comments to a new status and lets trainable filters learn
+// from the decision.
+func (co *CommentUseCase) moderate(ctx context.Context, ids []string, status string) (int64, error) {
+	trainers := make([]CommentFilterTrainer, 0, len(co.filters))
+
+	for _, filter := range co.filters {
+		if trainer, ok := filter.(CommentFilterTrainer); ok {
+			trainers = append(trainers, trainer)
+		}
+	}
+
+	var comments []entity.Comment
+
+	// Load the comments first to know the status each one moves from
+	if len(trainers) > 0 {
+		var err error
+
+		comments, err = co.commentRepo.GetByIDs(ctx, ids)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	updated, err := co.commentRepo.UpdateStatus(ctx, ids, status)
+	if err != nil {
+		return 0, err
+	}
+
+	// A moderator's decision settles the open reports
+	if err := co.reportRepo.DeleteByCommentIDs(ctx, ids); err != nil {
+		return updated, err
+	}
+
+	for i := range comments {
+		for _, trainer := range trainers {
+			if err := trainer.Train(ctx, &comments[i], comments[i].Status, status); err != nil {
+				return updated, err
+			}
+		}
+	}
+
+	return updated, nil
+}
+
+// applyFilters runs the filter chain and returns the most severe verdict,
+// stopping early on rejection.
+func (co *CommentUseCase) applyFilters(ctx context.Context, comment *entity.Comment) (CommentFilterAction, error) {
+	result := CommentFilterAllow
+
+	for _, filter := range co.filters {
+		action, err := filter.Check(ctx, comment)
+		if err != nil {
+			return CommentFilterAllow, err
+		}
+
+		if action == CommentFilterReject {
+			return action, nil
+		}
+
+		result = max(result, action)
+	}
+
+	return result, nil
+}
+
+// filteredStatus lets a filter verdict override the policy status.
+func filteredStatus(status string, action CommentFilterAction) string {
+	switch action {
+	case CommentFilterSpam:
+		return entity.CommentStatusSpam
+	case CommentFilterHold:
+		return entity.CommentStatusPending
+	default:
+		return status
+	}
+}
+
+// initialStatus applies the configured moderation policy to a new comment.
+// Unknown policies fall back to requiring approval.
+func (co *CommentUseCase) initialStatus(ctx context.Context, fingerprint string) (string, error) {
+	switch co.cfg.ModerationPolicy {
+	case CommentPolicyAutoApprove:
+		return entity.CommentStatusApproved, nil
+	case CommentPolicyApproveReturning:
+		if fingerprint == "" {
+			return entity.CommentStatusPending, nil
+		}
+
+		returning, err := co.commentRepo.HasApprovedByFingerprint(ctx, fingerprint)
+		if err != nil {
+			return "", err
+		}
+
+		if returning {
+			return entity.CommentStatusApproved, nil
+		}
+
+		return entity.CommentStatusPending, nil
+	default:
+		return entity.CommentStatusPending, nil
+	}
+}
+
+// newEditToken returns a random token letting the author of a comment edit
+// it. Only its hash is stored.
+func newEditToken() (string, error) {
+	token := make([]byte, editTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+func hashEditToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func isReportReason(reason string) bool {
+	switch reason {
+	case entity.CommentReportSpam, entity.CommentReportAbuse, entity.CommentReportHarassment,
+		entity.CommentReportOffTopic, entity.CommentReportOther:
+		return true
+	default:
+		return false
+	}
+}
+
+func isCommentStatus(status string) bool {
+	switch status {
+	case entity.CommentStatusPending, entity.CommentStatusApproved, entity.CommentStatusRejected, entity.CommentStatusSpam:
+		return true
+	default:
+		return false
+	}
+}
+
+func buildCommentTree(comments []entity.Comment, children map[string][]int, parentID string) []dto.CommentResponseDTO {
+	nodes := make([]dto.CommentResponseDTO, 0, len(children[parentID]))
+
+	for _, i := range children[parentID] {
+		node := toCommentResponseDTO(&comments[i])
+		node.Replies = buildCommentTree(comments, children, comments[i].ID)
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+func flattenCommentThread(
+	comments []entity.Comment,
+	children map[string][]int,
+	parentID string,
+	result []dto.CommentResponseDTO,
+) []dto.CommentResponseDTO {
+	for _, i := range children[parentID] {
+		result = append(result, toCommentResponseDTO(&comments[i]))
+		result = flattenCommentThread(comments, children, comments[i].ID, result)
+	}
+
+	return result
+}
+
+func toCommentResponseDTO(comment *entity.Comment) dto.CommentResponseDTO {
+	return dto.CommentResponseDTO{
+		ID:        comment.ID,
+		NewsID:    comment.NewsID,
+		ParentID:  comment.ParentID,
+		Name:      comment.Name,
+		Comment:   comment.Comment,
+		Status:    comment.Status,
+		Depth:     comment.Depth,
+		Path:      comment.Path,
+		CreatedAt: comment.CreatedAt,
+		Upvotes:   comment.Votes.Upvotes,
+		Downvotes: comment.Votes.Downvotes,
+		Score:     comment.Votes.Score(),
+	}
+}
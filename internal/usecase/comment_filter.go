@@ -0,0 +1,193 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+)
+
+// CommentFilterAction is the verdict of a filter on a new comment, ordered
+// from least to most severe.
+type CommentFilterAction int
+
+const (
+	// CommentFilterAllow leaves the status to the moderation policy.
+	CommentFilterAllow CommentFilterAction = iota
+	// CommentFilterHold sends the comment to the moderation queue.
+	CommentFilterHold
+	// CommentFilterSpam stores the comment as spam.
+	CommentFilterSpam
+	// CommentFilterReject refuses the comment.
+	CommentFilterReject
+)
+
+// Profanity filter actions, selected with COMMENT_PROFANITY_ACTION.
+const (
+	ProfanityActionMask   = "mask"
+	ProfanityActionReject = "reject"
+)
+
+var (
+	errUnknownProfanityAction = errors.New("unknown profanity action")
+	linkPattern               = regexp.MustCompile(`(?i)\bhttps?://|\bwww\.`)
+)
+
+// CommentFilter inspects a comment before it is stored. Filters may rewrite
+// the comment, for example to mask words.
+type CommentFilter interface {
+	Check(ctx context.Context, comment *entity.Comment) (CommentFilterAction, error)
+}
+
+// CommentFilterTrainer is implemented by filters that learn from moderator
+// decisions. Train is called when a comment moves from one status to another.
+type CommentFilterTrainer interface {
+	Train(ctx context.Context, comment *entity.Comment, from, to string) error
+}
+
+// NewCommentFilters builds the filter chain described by the comment config.
+func NewCommentFilters(
+	cfg config.Comment,
+	commentRepo repository.CommentRepo,
+	spamTokenRepo repository.SpamTokenRepo,
+) ([]CommentFilter, error) {
+	filters := make([]CommentFilter, 0)
+
+	if len(cfg.ProfanityWords) > 0 || len(cfg.ProfanityPatterns) > 0 {
+		profanity, err := NewProfanityFilter(cfg.ProfanityWords, cfg.ProfanityPatterns, cfg.ProfanityAction)
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, profanity)
+	}
+
+	if cfg.MaxLinks >= 0 {
+		filters = append(filters, NewLinkFilter(cfg.MaxLinks))
+	}
+
+	if cfg.DuplicateWindow > 0 {
+		filters = append(filters, NewDuplicateFilter(commentRepo, cfg.DuplicateWindow))
+	}
+
+	if cfg.SpamThreshold > 0 {
+		filters = append(filters, NewBayesFilter(spamTokenRepo, cfg.SpamThreshold))
+	}
+
+	return filters, nil
+}
+
+// ProfanityFilter masks or rejects comments matching a word list or regular
+// expressions. Words match case-insensitively on word boundaries.
+type ProfanityFilter struct {
+	patterns []*regexp.Regexp
+	reject   bool
+}
+
+func NewProfanityFilter(words, patterns []string, action string) (*ProfanityFilter, error) {
+	if action != ProfanityActionMask && action != ProfanityActionReject {
+		return nil, fmt.Errorf("%w: %q", errUnknownProfanityAction, action)
+	}
+
+	filter := &ProfanityFilter{reject: action == ProfanityActionReject}
+
+	for _, word := range words {
+		word = strings.TrimSpace(word)
+		if word == "" {
+			continue
+		}
+
+		filter.patterns = append(filter.patterns, regexp.MustCompile(`(?i)\b`+regexp.QuoteMeta(word)+`\b`))
+	}
+
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			continue
+		}
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("compile profanity pattern %q: %w", pattern, err)
+		}
+
+		filter.patterns = append(filter.patterns, re)
+	}
+
+	return filter, nil
+}
+
+func (f *ProfanityFilter) Check(_ context.Context, comment *entity.Comment) (CommentFilterAction, error) {
+	for _, re := range f.patterns {
+		if f.reject {
+			if re.MatchString(comment.Name) || re.MatchString(comment.Comment) {
+				return CommentFilterReject, nil
+			}
+
+			continue
+		}
+
+		comment.Name = re.ReplaceAllStringFunc(comment.Name, maskWord)
+		comment.Comment = re.ReplaceAllStringFunc(comment.Comment, maskWord)
+	}
+
+	return CommentFilterAllow, nil
+}
+
+func maskWord(word string) string {
+	return strings.Repeat("*", utf8.RuneCountInString(word))
+}
+
+// LinkFilter holds comments with more links than allowed for moderation.
+type LinkFilter struct {
+	maxLinks int
+}
+
+func NewLinkFilter(maxLinks int) *LinkFilter {
+	return &LinkFilter{maxLinks: maxLinks}
+}
+
+func (f *LinkFilter) Check(_ context.Context, comment *entity.Comment) (CommentFilterAction, error) {
+	links := len(linkPattern.FindAllStringIndex(comment.Name, -1)) +
+		len(linkPattern.FindAllStringIndex(comment.Comment, -1))
+
+	if links > f.maxLinks {
+		return CommentFilterHold, nil
+	}
+
+	return CommentFilterAllow, nil
+}
+
+// DuplicateFilter marks comments as spam when the same text was already
+// posted within the window, on any news article. An edited comment is not
+// compared with itself.
+type DuplicateFilter struct {
+	commentRepo repository.CommentRepo
+	window      time.Duration
+}
+
+func NewDuplicateFilter(commentRepo repository.CommentRepo, window time.Duration) *DuplicateFilter {
+	return &DuplicateFilter{
+		commentRepo: commentRepo,
+		window:      window,
+	}
+}
+
+func (f *DuplicateFilter) Check(ctx context.Context, comment *entity.Comment) (CommentFilterAction, error) {
+	count, err := f.commentRepo.CountRecentByContent(ctx, comment.Comment, time.Now().Add(-f.window), comment.ID)
+	if err != nil {
+		return CommentFilterAllow, err
+	}
+
+	if count > 0 {
+		return CommentFilterSpam, nil
+	}
+
+	return CommentFilterAllow, nil
+}
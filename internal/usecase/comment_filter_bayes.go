@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+)
+
+const (
+	bayesMinTokenLength = 3
+	bayesMaxTokenLength = 32
+	bayesMaxTokens      = 200
+	bayesClasses        = 2
+)
+
+// BayesFilter scores comments with a naive Bayes classifier trained from
+// moderator decisions: approved comments count as ham, comments rejected as
+// spam count as spam. Comments scoring at or above the threshold are stored
+// as spam. The filter stays silent until both classes have been trained.
+type BayesFilter struct {
+	spamTokenRepo repository.SpamTokenRepo
+	threshold     float64
+}
+
+func NewBayesFilter(spamTokenRepo repository.SpamTokenRepo, threshold float64) *BayesFilter {
+	return &BayesFilter{
+		spamTokenRepo: spamTokenRepo,
+		threshold:     threshold,
+	}
+}
+
+func (f *BayesFilter) Check(ctx context.Context, comment *entity.Comment) (CommentFilterAction, error) {
+	tokens := commentTokens(comment.Comment)
+	if len(tokens) == 0 {
+		return CommentFilterAllow, nil
+	}
+
+	counts, err := f.spamTokenRepo.GetByTokens(ctx, append(tokens, entity.SpamTokenDocuments))
+	if err != nil {
+		return CommentFilterAllow, err
+	}
+
+	if spamProbability(tokens, counts) >= f.threshold {
+		return CommentFilterSpam, nil
+	}
+
+	return CommentFilterAllow, nil
+}
+
+// Train moves the comment out of the class it was trained as, if any, and
+// into the class of its new status: approved comments train as ham and spam
+// as spam, other statuses as nothing. Only training the comment recorded is
+// reversed, so comments the filter itself stored as spam or that were
+// approved without review move into a class without leaving one.
+func (f *BayesFilter) Train(ctx context.Context, comment *entity.Comment, _, to string) error {
+	from := entity.SpamTraining{Class: comment.TrainedClass, Text: comment.TrainedText}
+	target := entity.SpamTraining{Class: bayesClassOf(to)}
+
+	if target.Class != "" {
+		target.Text = comment.Comment
+	}
+
+	if from.Class == target.Class && from.Text == target.Text {
+		return nil
+	}
+
+	if from.Class != "" {
+		from.Tokens = append(commentTokens(from.Text), entity.SpamTokenDocuments)
+	}
+
+	if target.Class != "" {
+		target.Tokens = append(commentTokens(target.Text), entity.SpamTokenDocuments)
+	}
+
+	return f.spamTokenRepo.Retrain(ctx, comment.ID, from, target)
+}
+
+// bayesClassOf returns the class a comment in the given status trains as.
+func bayesClassOf(status string) string {
+	switch status {
+	case entity.CommentStatusSpam:
+		return entity.SpamClassSpam
+	case entity.CommentStatusApproved:
+		return entity.SpamClassHam
+	default:
+		return ""
+	}
+}
+
+// spamProbability combines the per-token likelihoods in log space with
+// Laplace smoothing. Tokens never seen in training are ignored.
+func spamProbability(tokens []string, counts map[string]entity.SpamToken) float64 {
+	documents := counts[entity.SpamTokenDocuments]
+	if documents.SpamCount == 0 || documents.HamCount == 0 {
+		return 0
+	}
+
+	spamDocs := float64(documents.SpamCount)
+	hamDocs := float64(documents.HamCount)
+	logOdds := math.Log(spamDocs / hamDocs)
+
+	for _, token := range tokens {
+		count, ok := counts[token]
+		if !ok {
+			continue
+		}
+
+		spamFreq := (float64(count.SpamCount) + 1) / (spamDocs + bayesClasses)
+		hamFreq := (float64(count.HamCount) + 1) / (hamDocs + bayesClasses)
+		logOdds += math.Log(spamFreq / hamFreq)
+	}
+
+	return 1 / (1 + math.Exp(-logOdds))
+}
+
+// commentTokens splits text into unique lower-case words made of letters and
+// digits.
+func commentTokens(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	seen := make(map[string]struct{}, len(words))
+	tokens := make([]string, 0, len(words))
+
+	for _, word := range words {
+		if len(word) < bayesMinTokenLength || len(word) > bayesMaxTokenLength {
+			continue
+		}
+
+		if _, ok := seen[word]; ok {
+			continue
+		}
+
+		seen[word] = struct{}{}
+		tokens = append(tokens, word)
+
+		if len(tokens) == bayesMaxTokens {
+			break
+		}
+	}
+
+	return tokens
+}
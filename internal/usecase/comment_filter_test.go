@@ -0,0 +1,344 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type MockSpamTokenRepo struct {
+	mock.Mock
+}
+
+func (m *MockSpamTokenRepo) GetByTokens(ctx context.Context, tokens []string) (map[string]entity.SpamToken, error) {
+	args := m.Called(ctx, tokens)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(map[string]entity.SpamToken)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockSpamTokenRepo) Retrain(ctx context.Context, commentID string, from, to entity.SpamTraining) error {
+	args := m.Called(ctx, commentID, from, to)
+
+	return args.Error(0)
+}
+
+func TestNewCommentFilters(t *testing.T) {
+	t.Run("success - build configured filters", func(t *testing.T) {
+		filters, err := NewCommentFilters(config.Comment{
+			ProfanityWords:  []string{"darn"},
+			ProfanityAction: ProfanityActionMask,
+			MaxLinks:        2,
+			DuplicateWindow: time.Hour,
+			SpamThreshold:   0.9,
+		}, new(MockCommentRepo), new(MockSpamTokenRepo))
+
+		require.NoError(t, err)
+		assert.Len(t, filters, 4)
+	})
+
+	t.Run("success - disabled filters are skipped", func(t *testing.T) {
+		filters, err := NewCommentFilters(config.Comment{MaxLinks: -1}, new(MockCommentRepo), new(MockSpamTokenRepo))
+
+		require.NoError(t, err)
+		assert.Empty(t, filters)
+	})
+
+	t.Run("error - unknown profanity action", func(t *testing.T) {
+		filters, err := NewCommentFilters(config.Comment{
+			ProfanityWords:  []string{"darn"},
+			ProfanityAction: "shout",
+		}, new(MockCommentRepo), new(MockSpamTokenRepo))
+
+		assert.Nil(t, filters)
+		assert.ErrorIs(t, err, errUnknownProfanityAction)
+	})
+
+	t.Run("error - invalid profanity pattern", func(t *testing.T) {
+		filters, err := NewCommentFilters(config.Comment{
+			ProfanityPatterns: []string{"(unclosed"},
+			ProfanityAction:   ProfanityActionMask,
+		}, new(MockCommentRepo), new(MockSpamTokenRepo))
+
+		assert.Nil(t, filters)
+		assert.Error(t, err)
+	})
+}
+
+func TestProfanityFilter_Check(t *testing.T) {
+	t.Run("success - mask words and patterns", func(t *testing.T) {
+		filter, err := NewProfanityFilter([]string{"darn"}, []string{`h[e3]ck`}, ProfanityActionMask)
+		require.NoError(t, err)
+
+		comment := &entity.Comment{Name: "Darn Fan", Comment: "What the h3ck, darnation is not darn"}
+
+		action, err := filter.Check(context.Background(), comment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, CommentFilterAllow, action)
+		assert.Equal(t, "**** Fan", comment.Name)
+		assert.Equal(t, "What the ****, darnation is not ****", comment.Comment)
+	})
+
+	t.Run("success - reject on match", func(t *testing.T) {
+		filter, err := NewProfanityFilter([]string{"darn"}, nil, ProfanityActionReject)
+		require.NoError(t, err)
+
+		comment := &entity.Comment{Name: "John", Comment: "DARN it"}
+
+		action, err := filter.Check(context.Background(), comment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, CommentFilterReject, action)
+		assert.Equal(t, "DARN it", comment.Comment)
+	})
+}
+
+func TestLinkFilter_Check(t *testing.T) {
+	filter := NewLinkFilter(1)
+
+	action, err := filter.Check(context.Background(), &entity.Comment{Comment: "see https://example.com"})
+	assert.NoError(t, err)
+	assert.Equal(t, CommentFilterAllow, action)
+
+	action, err = filter.Check(context.Background(), &entity.Comment{Comment: "see http://a.example and www.b.example"})
+	assert.NoError(t, err)
+	assert.Equal(t, CommentFilterHold, action)
+}
+
+func TestDuplicateFilter_Check(t *testing.T) {
+	t.Run("success - duplicate is spam", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		filter := NewDuplicateFilter(mockRepo, time.Hour)
+
+		ctx := context.Background()
+
+		mockRepo.On("CountRecentByContent", ctx, "buy now", mock.AnythingOfType("time.Time"), "").Return(2, nil)
+
+		action, err := filter.Check(ctx, &entity.Comment{Comment: "buy now"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, CommentFilterSpam, action)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - edited comment does not match itself", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		filter := NewDuplicateFilter(mockRepo, time.Hour)
+
+		ctx := context.Background()
+
+		mockRepo.On("CountRecentByContent", ctx, "Great article", mock.AnythingOfType("time.Time"), testCommentID).Return(0, nil)
+
+		action, err := filter.Check(ctx, &entity.Comment{ID: testCommentID, Comment: "Great article"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, CommentFilterAllow, action)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - repository fails", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		filter := NewDuplicateFilter(mockRepo, time.Hour)
+
+		ctx := context.Background()
+
+		mockRepo.On("CountRecentByContent", ctx, "buy now", mock.AnythingOfType("time.Time"), "").Return(0, errDatabaseError)
+
+		_, err := filter.Check(ctx, &entity.Comment{Comment: "buy now"})
+
+		assert.Equal(t, errDatabaseError, err)
+	})
+}
+
+func TestBayesFilter_Check(t *testing.T) {
+	trained := map[string]entity.SpamToken{
+		entity.SpamTokenDocuments: {Token: entity.SpamTokenDocuments, SpamCount: 10, HamCount: 10},
+		"casino":                  {Token: "casino", SpamCount: 9, HamCount: 0},
+		"bonus":                   {Token: "bonus", SpamCount: 8, HamCount: 1},
+		"article":                 {Token: "article", SpamCount: 0, HamCount: 9},
+	}
+
+	testCases := []struct {
+		name     string
+		text     string
+		counts   map[string]entity.SpamToken
+		expected CommentFilterAction
+	}{
+		{name: "spammy tokens", text: "Casino bonus casino!", counts: trained, expected: CommentFilterSpam},
+		{name: "hammy tokens", text: "Great article", counts: trained, expected: CommentFilterAllow},
+		{name: "untrained classifier", text: "Casino bonus", counts: map[string]entity.SpamToken{}, expected: CommentFilterAllow},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockSpamTokenRepo)
+			filter := NewBayesFilter(mockRepo, 0.9)
+
+			mockRepo.On("GetByTokens", mock.Anything, mock.Anything).Return(tc.counts, nil)
+
+			action, err := filter.Check(context.Background(), &entity.Comment{Comment: tc.text})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, action)
+		})
+	}
+
+	t.Run("success - no tokens skips lookup", func(t *testing.T) {
+		mockRepo := new(MockSpamTokenRepo)
+		filter := NewBayesFilter(mockRepo, 0.9)
+
+		action, err := filter.Check(context.Background(), &entity.Comment{Comment: "ok!"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, CommentFilterAllow, action)
+		mockRepo.AssertNotCalled(t, "GetByTokens", mock.Anything, mock.Anything)
+	})
+}
+
+func TestBayesFilter_Train(t *testing.T) {
+	spam := entity.SpamTraining{
+		Class:  entity.SpamClassSpam,
+		Text:   "Cheap pills, cheap!",
+		Tokens: []string{"cheap", "pills", entity.SpamTokenDocuments},
+	}
+	ham := entity.SpamTraining{
+		Class:  entity.SpamClassHam,
+		Text:   "Cheap pills, cheap!",
+		Tokens: []string{"cheap", "pills", entity.SpamTokenDocuments},
+	}
+
+	testCases := []struct {
+		name    string
+		trained entity.SpamTraining
+		from    string
+		to      string
+		retrain [2]entity.SpamTraining
+	}{
+		{
+			name:    "pending to spam",
+			from:    entity.CommentStatusPending,
+			to:      entity.CommentStatusSpam,
+			retrain: [2]entity.SpamTraining{{}, spam},
+		},
+		{
+			name:    "pending to approved",
+			from:    entity.CommentStatusPending,
+			to:      entity.CommentStatusApproved,
+			retrain: [2]entity.SpamTraining{{}, ham},
+		},
+		{
+			name:    "moderated spam to approved",
+			trained: spam,
+			from:    entity.CommentStatusSpam,
+			to:      entity.CommentStatusApproved,
+			retrain: [2]entity.SpamTraining{spam, ham},
+		},
+		{
+			name:    "filter spam to approved",
+			from:    entity.CommentStatusSpam,
+			to:      entity.CommentStatusApproved,
+			retrain: [2]entity.SpamTraining{{}, ham},
+		},
+		{
+			name:    "auto-approved to spam",
+			from:    entity.CommentStatusApproved,
+			to:      entity.CommentStatusSpam,
+			retrain: [2]entity.SpamTraining{{}, spam},
+		},
+		{
+			name:    "moderated spam to rejected",
+			trained: spam,
+			from:    entity.CommentStatusSpam,
+			to:      entity.CommentStatusRejected,
+			retrain: [2]entity.SpamTraining{spam, {}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockSpamTokenRepo)
+			filter := NewBayesFilter(mockRepo, 0.9)
+
+			ctx := context.Background()
+			comment := &entity.Comment{
+				ID:           testCommentID,
+				Comment:      "Cheap pills, cheap!",
+				TrainedClass: tc.trained.Class,
+				TrainedText:  tc.trained.Text,
+			}
+
+			mockRepo.On("Retrain", ctx, testCommentID, tc.retrain[0], tc.retrain[1]).Return(nil)
+
+			err := filter.Train(ctx, comment, tc.from, tc.to)
+
+			assert.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("success - edited text is retrained", func(t *testing.T) {
+		mockRepo := new(MockSpamTokenRepo)
+		filter := NewBayesFilter(mockRepo, 0.9)
+
+		ctx := context.Background()
+		comment := &entity.Comment{
+			ID:           testCommentID,
+			Comment:      "Lovely article",
+			TrainedClass: entity.SpamClassHam,
+			TrainedText:  "Cheap pills, cheap!",
+		}
+
+		mockRepo.On("Retrain", ctx, testCommentID, ham, entity.SpamTraining{
+			Class:  entity.SpamClassHam,
+			Text:   "Lovely article",
+			Tokens: []string{"lovely", "article", entity.SpamTokenDocuments},
+		}).Return(nil)
+
+		err := filter.Train(ctx, comment, entity.CommentStatusPending, entity.CommentStatusApproved)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - already trained", func(t *testing.T) {
+		mockRepo := new(MockSpamTokenRepo)
+		filter := NewBayesFilter(mockRepo, 0.9)
+
+		comment := &entity.Comment{
+			ID:           testCommentID,
+			Comment:      "Cheap pills, cheap!",
+			TrainedClass: entity.SpamClassHam,
+			TrainedText:  "Cheap pills, cheap!",
+		}
+
+		err := filter.Train(context.Background(), comment, entity.CommentStatusApproved, entity.CommentStatusApproved)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Retrain", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - no class change", func(t *testing.T) {
+		mockRepo := new(MockSpamTokenRepo)
+		filter := NewBayesFilter(mockRepo, 0.9)
+
+		err := filter.Train(context.Background(), &entity.Comment{Comment: "hello there"},
+			entity.CommentStatusPending, entity.CommentStatusRejected)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "Retrain", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -2,13 +2,22 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/binary"
 	"errors"
+	"math/bits"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/RizqiSugiarto/coding-test/config"
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/pow"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type MockCommentRepo struct {
@@ -20,20 +29,236 @@ const (
 	testCommentNewsID  = "550e8400-e29b-41d4-a716-446655440000"
 	testCommentName    = "John Doe"
 	testCommentContent = "This is a great article!"
+	testCommentReplyID = "550e8400-e29b-41d4-a716-446655440010"
+	testCommentChildID = "550e8400-e29b-41d4-a716-446655440011"
+	testOtherNewsID    = "550e8400-e29b-41d4-a716-446655440099"
+	testFingerprint    = "visitor-fingerprint"
 )
 
-var errDatabaseError = errors.New("database error")
+var (
+	errDatabaseError  = errors.New("database error")
+	testCommentConfig = config.Comment{MaxDepth: 2, ModerationPolicy: CommentPolicyAutoApprove}
+)
+
+func (m *MockCommentRepo) Create(ctx context.Context, comment *entity.Comment) (*entity.Comment, error) {
+	args := m.Called(ctx, comment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Comment)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentRepo) GetByID(ctx context.Context, id string) (*entity.Comment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Comment)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentRepo) GetByNewsID(ctx context.Context, newsID, status string) ([]entity.Comment, error) {
+	args := m.Called(ctx, newsID, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Comment)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentRepo) GetByStatus(ctx context.Context, status string, limit, offset int) ([]entity.Comment, error) {
+	args := m.Called(ctx, status, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Comment)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentRepo) UpdateStatus(ctx context.Context, ids []string, status string) (int64, error) {
+	args := m.Called(ctx, ids, status)
+
+	updated, ok := args.Get(0).(int64)
+	if !ok {
+		return 0, args.Error(1)
+	}
+
+	return updated, args.Error(1)
+}
+
+func (m *MockCommentRepo) HasApprovedByFingerprint(ctx context.Context, fingerprint string) (bool, error) {
+	args := m.Called(ctx, fingerprint)
+
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockCommentRepo) GetByIDs(ctx context.Context, ids []string) ([]entity.Comment, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Comment)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentRepo) CountRecentByContent(ctx context.Context, content string, since time.Time, excludeID string) (int, error) {
+	args := m.Called(ctx, content, since, excludeID)
+
+	return args.Int(0), args.Error(1)
+}
 
-func (m *MockCommentRepo) Create(ctx context.Context, comment *entity.Comment) error {
+func (m *MockCommentRepo) Update(ctx context.Context, comment *entity.Comment) error {
 	args := m.Called(ctx, comment)
 
 	return args.Error(0)
 }
 
+func (m *MockCommentRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+type MockChallengeRepo struct {
+	mock.Mock
+}
+
+func (m *MockChallengeRepo) Redeem(ctx context.Context, id string, expiresAt time.Time) error {
+	args := m.Called(ctx, id, expiresAt)
+
+	return args.Error(0)
+}
+
+// solveChallenge brute-forces a nonce for a proof-of-work challenge.
+type MockCommentVoteRepo struct {
+	mock.Mock
+}
+
+func (m *MockCommentVoteRepo) Vote(ctx context.Context, commentID, voter string, value int) error {
+	args := m.Called(ctx, commentID, voter, value)
+
+	return args.Error(0)
+}
+
+func (m *MockCommentVoteRepo) CountByCommentIDs(ctx context.Context, commentIDs []string) (map[string]entity.CommentVotes, error) {
+	args := m.Called(ctx, commentIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(map[string]entity.CommentVotes)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+// noVotesRepo returns a vote repository in which no comment has votes.
+func noVotesRepo() *MockCommentVoteRepo {
+	voteRepo := new(MockCommentVoteRepo)
+	voteRepo.On("CountByCommentIDs", mock.Anything, mock.Anything).
+		Return(map[string]entity.CommentVotes{}, nil).
+		Maybe()
+
+	return voteRepo
+}
+
+type MockCommentReportRepo struct {
+	mock.Mock
+}
+
+func (m *MockCommentReportRepo) Add(ctx context.Context, report *entity.CommentReport) error {
+	args := m.Called(ctx, report)
+
+	return args.Error(0)
+}
+
+func (m *MockCommentReportRepo) CountByCommentID(ctx context.Context, commentID string) (int, error) {
+	args := m.Called(ctx, commentID)
+
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockCommentReportRepo) GetReported(ctx context.Context, limit, offset int) ([]entity.ReportedComment, error) {
+	args := m.Called(ctx, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.ReportedComment)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCommentReportRepo) DeleteByCommentIDs(ctx context.Context, commentIDs []string) error {
+	args := m.Called(ctx, commentIDs)
+
+	return args.Error(0)
+}
+
+// noReportsRepo returns a report repository that accepts closing reports.
+func noReportsRepo() *MockCommentReportRepo {
+	reportRepo := new(MockCommentReportRepo)
+	reportRepo.On("DeleteByCommentIDs", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return reportRepo
+}
+
+// openNewsRepo returns a news repository whose articles accept comments.
+func openNewsRepo() *MockNewsRepo {
+	newsRepo := new(MockNewsRepo)
+	newsRepo.On("GetByID", mock.Anything, mock.Anything).
+		Return(&entity.News{ID: testCommentNewsID, CommentsEnabled: true, CreatedAt: time.Now()}, nil).
+		Maybe()
+
+	return newsRepo
+}
+
+func solveChallenge(t *testing.T, challenge string, difficulty int) string {
+	t.Helper()
+
+	for nonce := 0; ; nonce++ {
+		sum := sha256.Sum256([]byte(challenge + ":" + strconv.Itoa(nonce)))
+		if bits.LeadingZeros32(binary.BigEndian.Uint32(sum[:4])) >= difficulty {
+			return strconv.Itoa(nonce)
+		}
+	}
+}
+
 func TestCommentUseCase_Create(t *testing.T) {
 	t.Run("success - create comment", func(t *testing.T) {
 		mockRepo := new(MockCommentRepo)
-		mockUseCase := NewCommentUseCase(mockRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
 
 		ctx := context.Background()
 		req := &dto.CreateCommentRequestDTO{
@@ -46,9 +271,9 @@ func TestCommentUseCase_Create(t *testing.T) {
 			return comment.Name == testCommentName &&
 				comment.Comment == testCommentContent &&
 				comment.NewsID == testCommentNewsID
-		})).Return(nil)
+		})).Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}, nil)
 
-		err := mockUseCase.Create(ctx, req)
+		_, err := mockUseCase.Create(ctx, req)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -56,7 +281,7 @@ func TestCommentUseCase_Create(t *testing.T) {
 
 	t.Run("error - repository create fails", func(t *testing.T) {
 		mockRepo := new(MockCommentRepo)
-		mockUseCase := NewCommentUseCase(mockRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
 
 		ctx := context.Background()
 		req := &dto.CreateCommentRequestDTO{
@@ -69,9 +294,9 @@ func TestCommentUseCase_Create(t *testing.T) {
 			return comment.Name == testCommentName &&
 				comment.Comment == testCommentContent &&
 				comment.NewsID == testCommentNewsID
-		})).Return(errDatabaseError)
+		})).Return(nil, errDatabaseError)
 
-		err := mockUseCase.Create(ctx, req)
+		_, err := mockUseCase.Create(ctx, req)
 
 		assert.Error(t, err)
 		assert.Equal(t, errDatabaseError, err)
@@ -80,7 +305,7 @@ func TestCommentUseCase_Create(t *testing.T) {
 
 	t.Run("success - create comment with empty name", func(t *testing.T) {
 		mockRepo := new(MockCommentRepo)
-		mockUseCase := NewCommentUseCase(mockRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
 
 		ctx := context.Background()
 		req := &dto.CreateCommentRequestDTO{
@@ -93,9 +318,9 @@ func TestCommentUseCase_Create(t *testing.T) {
 			return comment.Name == "" &&
 				comment.Comment == "Anonymous comment" &&
 				comment.NewsID == testCommentNewsID
-		})).Return(nil)
+		})).Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}, nil)
 
-		err := mockUseCase.Create(ctx, req)
+		_, err := mockUseCase.Create(ctx, req)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -103,7 +328,7 @@ func TestCommentUseCase_Create(t *testing.T) {
 
 	t.Run("success - create comment with special characters", func(t *testing.T) {
 		mockRepo := new(MockCommentRepo)
-		mockUseCase := NewCommentUseCase(mockRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
 
 		ctx := context.Background()
 		req := &dto.CreateCommentRequestDTO{
@@ -116,11 +341,1190 @@ func TestCommentUseCase_Create(t *testing.T) {
 			return comment.Name == "Test User <script>" &&
 				comment.Comment == "Comment with special chars: !@#$%^&*()" &&
 				comment.NewsID == testCommentNewsID
-		})).Return(nil)
+		})).Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}, nil)
+
+		_, err := mockUseCase.Create(ctx, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - create reply", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		req := &dto.CreateCommentRequestDTO{
+			Name:     testCommentName,
+			Comment:  testCommentContent,
+			NewsID:   testCommentNewsID,
+			ParentID: testCommentReplyID,
+		}
+
+		mockRepo.On("GetByID", ctx, testCommentReplyID).Return(&entity.Comment{
+			ID:     testCommentReplyID,
+			NewsID: testCommentNewsID,
+			Status: entity.CommentStatusApproved,
+			Depth:  1,
+		}, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(comment *entity.Comment) bool {
+			return comment.ParentID == testCommentReplyID && comment.Depth == 2
+		})).Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}, nil)
+
+		_, err := mockUseCase.Create(ctx, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - parent comment not found", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		req := &dto.CreateCommentRequestDTO{
+			Name:     testCommentName,
+			Comment:  testCommentContent,
+			NewsID:   testCommentNewsID,
+			ParentID: testCommentReplyID,
+		}
+
+		mockRepo.On("GetByID", ctx, testCommentReplyID).Return(nil, apperror.ErrNotFound)
+
+		_, err := mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrInvalidParentComment, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - parent comment belongs to another news", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		req := &dto.CreateCommentRequestDTO{
+			Name:     testCommentName,
+			Comment:  testCommentContent,
+			NewsID:   testCommentNewsID,
+			ParentID: testCommentReplyID,
+		}
+
+		mockRepo.On("GetByID", ctx, testCommentReplyID).Return(&entity.Comment{
+			ID:     testCommentReplyID,
+			NewsID: testOtherNewsID,
+		}, nil)
+
+		_, err := mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrInvalidParentComment, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - maximum depth exceeded", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
 
-		err := mockUseCase.Create(ctx, req)
+		ctx := context.Background()
+		req := &dto.CreateCommentRequestDTO{
+			Name:     testCommentName,
+			Comment:  testCommentContent,
+			NewsID:   testCommentNewsID,
+			ParentID: testCommentReplyID,
+		}
+
+		mockRepo.On("GetByID", ctx, testCommentReplyID).Return(&entity.Comment{
+			ID:     testCommentReplyID,
+			NewsID: testCommentNewsID,
+			Status: entity.CommentStatusApproved,
+			Depth:  testCommentConfig.MaxDepth,
+		}, nil)
+
+		_, err := mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrMaxCommentDepth, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+	t.Run("error - parent comment not approved", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		req := &dto.CreateCommentRequestDTO{
+			Name:     testCommentName,
+			Comment:  testCommentContent,
+			NewsID:   testCommentNewsID,
+			ParentID: testCommentReplyID,
+		}
+
+		mockRepo.On("GetByID", ctx, testCommentReplyID).Return(&entity.Comment{
+			ID:     testCommentReplyID,
+			NewsID: testCommentNewsID,
+			Status: entity.CommentStatusPending,
+		}, nil)
+
+		_, err := mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrInvalidParentComment, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_CreateModerationPolicy(t *testing.T) {
+	testCases := []struct {
+		name      string
+		policy    string
+		returning bool
+		expected  string
+	}{
+		{name: "auto approve", policy: CommentPolicyAutoApprove, expected: entity.CommentStatusApproved},
+		{name: "require approval", policy: CommentPolicyRequireApproval, expected: entity.CommentStatusPending},
+		{name: "returning commenter", policy: CommentPolicyApproveReturning, returning: true, expected: entity.CommentStatusApproved},
+		{name: "new commenter", policy: CommentPolicyApproveReturning, returning: false, expected: entity.CommentStatusPending},
+		{name: "unknown policy", policy: "bogus", expected: entity.CommentStatusPending},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockCommentRepo)
+			mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, config.Comment{MaxDepth: 2, ModerationPolicy: tc.policy})
+
+			ctx := context.Background()
+			req := &dto.CreateCommentRequestDTO{
+				Name:        testCommentName,
+				Comment:     testCommentContent,
+				NewsID:      testCommentNewsID,
+				Fingerprint: testFingerprint,
+			}
+
+			if tc.policy == CommentPolicyApproveReturning {
+				mockRepo.On("HasApprovedByFingerprint", ctx, testFingerprint).Return(tc.returning, nil)
+			}
+
+			mockRepo.On("Create", ctx, mock.MatchedBy(func(comment *entity.Comment) bool {
+				return comment.Status == tc.expected && comment.Fingerprint == testFingerprint
+			})).Return(&entity.Comment{ID: testCommentID, Status: tc.expected}, nil)
+
+			result, err := mockUseCase.Create(ctx, req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCommentUseCase_Moderation(t *testing.T) {
+	t.Run("success - default queue is pending", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByStatus", ctx, entity.CommentStatusPending, defaultModerationPageSize, 0).Return([]entity.Comment{
+			{ID: testCommentID, Status: entity.CommentStatusPending},
+		}, nil)
+
+		result, err := mockUseCase.GetModerationQueue(ctx, "", 0, 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - page of the queue", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByStatus", ctx, entity.CommentStatusSpam, 10, 20).Return([]entity.Comment{}, nil)
+
+		result, err := mockUseCase.GetModerationQueue(ctx, entity.CommentStatusSpam, 10, 20)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid queue status", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		result, err := mockUseCase.GetModerationQueue(context.Background(), "deleted", 0, 0)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrInvalidCommentStatus, err)
+		mockRepo.AssertNotCalled(t, "GetByStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - approve comments", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		ids := []string{testCommentID, testCommentReplyID}
+
+		mockRepo.On("UpdateStatus", ctx, ids, entity.CommentStatusApproved).Return(int64(2), nil)
+
+		updated, err := mockUseCase.Approve(ctx, ids)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), updated)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - reject comments as spam", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		ids := []string{testCommentID}
+
+		mockRepo.On("UpdateStatus", ctx, ids, entity.CommentStatusSpam).Return(int64(1), nil)
+
+		updated, err := mockUseCase.Reject(ctx, ids, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), updated)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - reject comments", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		ids := []string{testCommentID}
+
+		mockRepo.On("UpdateStatus", ctx, ids, entity.CommentStatusRejected).Return(int64(1), nil)
+
+		_, err := mockUseCase.Reject(ctx, ids, false)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCommentUseCase_GetByNewsID(t *testing.T) {
+	now := time.Now()
+	thread := []entity.Comment{
+		{ID: testCommentID, NewsID: testCommentNewsID, Depth: 0, Path: testCommentID, CreatedAt: now},
+		{ID: testCommentReplyID, NewsID: testCommentNewsID, Depth: 0, Path: testCommentReplyID, CreatedAt: now},
+		{
+			ID: testCommentChildID, NewsID: testCommentNewsID, ParentID: testCommentID, Depth: 1,
+			Path: testCommentID + "/" + testCommentChildID, CreatedAt: now,
+		},
+	}
+
+	t.Run("success - tree format", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByNewsID", ctx, testCommentNewsID, entity.CommentStatusApproved).Return(thread, nil)
+
+		result, err := mockUseCase.GetByNewsID(ctx, testCommentNewsID, true, false)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, testCommentID, result[0].ID)
+		assert.Len(t, result[0].Replies, 1)
+		assert.Equal(t, testCommentChildID, result[0].Replies[0].ID)
+		assert.Empty(t, result[1].Replies)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - flat format keeps replies after their parent", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByNewsID", ctx, testCommentNewsID, entity.CommentStatusApproved).Return(thread, nil)
+
+		result, err := mockUseCase.GetByNewsID(ctx, testCommentNewsID, false, false)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 3)
+		assert.Equal(t, testCommentID, result[0].ID)
+		assert.Equal(t, testCommentChildID, result[1].ID)
+		assert.Equal(t, 1, result[1].Depth)
+		assert.Equal(t, testCommentReplyID, result[2].ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - top sort ranks siblings by score", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockVoteRepo := new(MockCommentVoteRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), mockVoteRepo, noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+		comments := append([]entity.Comment(nil), thread...)
+
+		mockRepo.On("GetByNewsID", ctx, testCommentNewsID, entity.CommentStatusApproved).Return(comments, nil)
+		mockVoteRepo.On("CountByCommentIDs", ctx, []string{testCommentID, testCommentReplyID, testCommentChildID}).
+			Return(map[string]entity.CommentVotes{
+				testCommentID:      {Upvotes: 1, Downvotes: 2},
+				testCommentReplyID: {Upvotes: 5},
+			}, nil)
+
+		result, err := mockUseCase.GetByNewsID(ctx, testCommentNewsID, true, true)
 
 		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, testCommentReplyID, result[0].ID)
+		assert.Equal(t, 5, result[0].Score)
+		assert.Equal(t, testCommentID, result[1].ID)
+		assert.Equal(t, -1, result[1].Score)
+		assert.Equal(t, 2, result[1].Downvotes)
+		mockRepo.AssertExpectations(t)
+		mockVoteRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - news not found", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByNewsID", ctx, testCommentNewsID, entity.CommentStatusApproved).Return(nil, apperror.ErrNotFound)
+
+		result, err := mockUseCase.GetByNewsID(ctx, testCommentNewsID, true, false)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+// stubCommentFilter returns a fixed verdict and records the comments it saw.
+type stubCommentFilter struct {
+	action  CommentFilterAction
+	checked int
+	trained []string
+}
+
+func (f *stubCommentFilter) Check(_ context.Context, _ *entity.Comment) (CommentFilterAction, error) {
+	f.checked++
+
+	return f.action, nil
+}
+
+func (f *stubCommentFilter) Train(_ context.Context, comment *entity.Comment, from, to string) error {
+	f.trained = append(f.trained, comment.ID+":"+from+"->"+to)
+
+	return nil
+}
+
+func TestCommentUseCase_CreateFilters(t *testing.T) {
+	testCases := []struct {
+		name     string
+		policy   string
+		actions  []CommentFilterAction
+		expected string
+	}{
+		{name: "allow keeps policy status", policy: CommentPolicyAutoApprove, actions: []CommentFilterAction{CommentFilterAllow}, expected: entity.CommentStatusApproved},
+		{name: "hold queues comment", policy: CommentPolicyAutoApprove, actions: []CommentFilterAction{CommentFilterHold}, expected: entity.CommentStatusPending},
+		{name: "most severe verdict wins", policy: CommentPolicyAutoApprove, actions: []CommentFilterAction{CommentFilterSpam, CommentFilterHold}, expected: entity.CommentStatusSpam},
+		{name: "spam overrides require approval", policy: CommentPolicyRequireApproval, actions: []CommentFilterAction{CommentFilterSpam}, expected: entity.CommentStatusSpam},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockCommentRepo)
+
+			filters := make([]CommentFilter, 0, len(tc.actions))
+			for _, action := range tc.actions {
+				filters = append(filters, &stubCommentFilter{action: action})
+			}
+
+			mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, config.Comment{MaxDepth: 2, ModerationPolicy: tc.policy}, filters...)
+
+			ctx := context.Background()
+			req := &dto.CreateCommentRequestDTO{
+				Name:    testCommentName,
+				Comment: testCommentContent,
+				NewsID:  testCommentNewsID,
+			}
+
+			mockRepo.On("Create", ctx, mock.MatchedBy(func(comment *entity.Comment) bool {
+				return comment.Status == tc.expected
+			})).Return(&entity.Comment{ID: testCommentID, Status: tc.expected}, nil)
+
+			result, err := mockUseCase.Create(ctx, req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("error - rejected stops the chain", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		reject := &stubCommentFilter{action: CommentFilterReject}
+		next := &stubCommentFilter{action: CommentFilterAllow}
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig, reject, next)
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:    testCommentName,
+			Comment: testCommentContent,
+			NewsID:  testCommentNewsID,
+		}
+
+		result, err := mockUseCase.Create(context.Background(), req)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrCommentRejected, err)
+		assert.Zero(t, next.checked)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_ModerationTraining(t *testing.T) {
+	t.Run("success - trainers learn from status change", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		trainer := &stubCommentFilter{}
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig, trainer)
+
+		ctx := context.Background()
+		ids := []string{testCommentID}
+
+		mockRepo.On("GetByIDs", ctx, ids).Return([]entity.Comment{
+			{ID: testCommentID, Status: entity.CommentStatusPending},
+		}, nil)
+		mockRepo.On("UpdateStatus", ctx, ids, entity.CommentStatusSpam).Return(int64(1), nil)
+
+		updated, err := mockUseCase.Reject(ctx, ids, true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), updated)
+		assert.Equal(t, []string{testCommentID + ":pending->spam"}, trainer.trained)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - loading comments fails", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		trainer := &stubCommentFilter{}
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig, trainer)
+
+		ctx := context.Background()
+		ids := []string{testCommentID}
+
+		mockRepo.On("GetByIDs", ctx, ids).Return(nil, errDatabaseError)
+
+		updated, err := mockUseCase.Approve(ctx, ids)
+
+		assert.Zero(t, updated)
+		assert.Equal(t, errDatabaseError, err)
+		assert.Empty(t, trainer.trained)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_ProofOfWork(t *testing.T) {
+	const difficulty = 8
+
+	newUseCase := func() (*CommentUseCase, *MockCommentRepo, *MockChallengeRepo) {
+		mockRepo := new(MockCommentRepo)
+		mockChallengeRepo := new(MockChallengeRepo)
+		issuer := pow.NewIssuer([]byte("test-secret"), difficulty, time.Minute)
+
+		return NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), mockChallengeRepo, issuer, testCommentConfig), mockRepo, mockChallengeRepo
+	}
+
+	t.Run("success - issue challenge", func(t *testing.T) {
+		mockUseCase, _, _ := newUseCase()
+
+		challenge, err := mockUseCase.IssueChallenge(context.Background(), testCommentNewsID)
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, challenge.Challenge)
+		assert.Equal(t, pow.Algorithm, challenge.Algorithm)
+		assert.Equal(t, difficulty, challenge.Difficulty)
+		assert.True(t, challenge.ExpiresAt.After(time.Now()))
+	})
+
+	t.Run("success - disabled proof of work issues no challenge", func(t *testing.T) {
+		mockUseCase := NewCommentUseCase(new(MockCommentRepo), openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		challenge, err := mockUseCase.IssueChallenge(context.Background(), testCommentNewsID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, challenge)
+	})
+
+	t.Run("success - create with solved challenge", func(t *testing.T) {
+		mockUseCase, mockRepo, mockChallengeRepo := newUseCase()
+
+		ctx := context.Background()
+
+		challenge, err := mockUseCase.IssueChallenge(ctx, testCommentNewsID)
+		require.NoError(t, err)
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:      testCommentName,
+			Comment:   testCommentContent,
+			NewsID:    testCommentNewsID,
+			Challenge: challenge.Challenge,
+			Nonce:     solveChallenge(t, challenge.Challenge, difficulty),
+		}
+
+		mockChallengeRepo.On("Redeem", ctx, pow.ID(challenge.Challenge), challenge.ExpiresAt).Return(nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(&entity.Comment{ID: testCommentID}, nil)
+
+		_, err = mockUseCase.Create(ctx, req)
+
+		assert.NoError(t, err)
+		mockChallengeRepo.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - replayed challenge", func(t *testing.T) {
+		mockUseCase, mockRepo, mockChallengeRepo := newUseCase()
+
+		ctx := context.Background()
+
+		challenge, err := mockUseCase.IssueChallenge(ctx, testCommentNewsID)
+		require.NoError(t, err)
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:      testCommentName,
+			Comment:   testCommentContent,
+			NewsID:    testCommentNewsID,
+			Challenge: challenge.Challenge,
+			Nonce:     solveChallenge(t, challenge.Challenge, difficulty),
+		}
+
+		mockChallengeRepo.On("Redeem", ctx, pow.ID(challenge.Challenge), challenge.ExpiresAt).Return(apperror.ErrChallengeUsed)
+
+		_, err = mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrChallengeUsed, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - invalid parent keeps the challenge", func(t *testing.T) {
+		mockUseCase, mockRepo, mockChallengeRepo := newUseCase()
+
+		ctx := context.Background()
+
+		challenge, err := mockUseCase.IssueChallenge(ctx, testCommentNewsID)
+		require.NoError(t, err)
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:      testCommentName,
+			Comment:   testCommentContent,
+			NewsID:    testCommentNewsID,
+			ParentID:  testCommentReplyID,
+			Challenge: challenge.Challenge,
+			Nonce:     solveChallenge(t, challenge.Challenge, difficulty),
+		}
+
+		mockRepo.On("GetByID", ctx, testCommentReplyID).Return(nil, apperror.ErrNotFound)
+
+		_, err = mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrInvalidParentComment, err)
+		mockChallengeRepo.AssertNotCalled(t, "Redeem", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - rejected comment keeps the challenge", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockChallengeRepo := new(MockChallengeRepo)
+		issuer := pow.NewIssuer([]byte("test-secret"), difficulty, time.Minute)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), mockChallengeRepo, issuer,
+			testCommentConfig, &stubCommentFilter{action: CommentFilterReject})
+
+		ctx := context.Background()
+
+		challenge, err := mockUseCase.IssueChallenge(ctx, testCommentNewsID)
+		require.NoError(t, err)
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:      testCommentName,
+			Comment:   testCommentContent,
+			NewsID:    testCommentNewsID,
+			Challenge: challenge.Challenge,
+			Nonce:     solveChallenge(t, challenge.Challenge, difficulty),
+		}
+
+		_, err = mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrCommentRejected, err)
+		mockChallengeRepo.AssertNotCalled(t, "Redeem", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - challenge for another news article", func(t *testing.T) {
+		mockUseCase, mockRepo, mockChallengeRepo := newUseCase()
+
+		ctx := context.Background()
+
+		challenge, err := mockUseCase.IssueChallenge(ctx, testOtherNewsID)
+		require.NoError(t, err)
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:      testCommentName,
+			Comment:   testCommentContent,
+			NewsID:    testCommentNewsID,
+			Challenge: challenge.Challenge,
+			Nonce:     solveChallenge(t, challenge.Challenge, difficulty),
+		}
+
+		_, err = mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrInvalidChallenge, err)
+		mockChallengeRepo.AssertNotCalled(t, "Redeem", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - tampered challenge", func(t *testing.T) {
+		mockUseCase, _, _ := newUseCase()
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:      testCommentName,
+			Comment:   testCommentContent,
+			NewsID:    testCommentNewsID,
+			Challenge: "bm90LWEtY2hhbGxlbmdl.c2lnbmF0dXJl",
+			Nonce:     "1",
+		}
+
+		_, err := mockUseCase.Create(context.Background(), req)
+
+		assert.Equal(t, apperror.ErrInvalidChallenge, err)
+	})
+
+	t.Run("error - missing challenge", func(t *testing.T) {
+		mockUseCase, _, _ := newUseCase()
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:    testCommentName,
+			Comment: testCommentContent,
+			NewsID:  testCommentNewsID,
+		}
+
+		_, err := mockUseCase.Create(context.Background(), req)
+
+		assert.Equal(t, apperror.ErrInvalidChallenge, err)
+	})
+
+	t.Run("error - wrong nonce", func(t *testing.T) {
+		mockUseCase, _, _ := newUseCase()
+
+		ctx := context.Background()
+
+		challenge, err := mockUseCase.IssueChallenge(ctx, testCommentNewsID)
+		require.NoError(t, err)
+
+		nonce := solveChallenge(t, challenge.Challenge, difficulty)
+
+		// Find a nonce that does not solve the challenge
+		wrong := 0
+		for {
+			sum := sha256.Sum256([]byte(challenge.Challenge + ":" + strconv.Itoa(wrong)))
+			if sum[0] != 0 {
+				break
+			}
+
+			wrong++
+		}
+
+		assert.NotEqual(t, nonce, strconv.Itoa(wrong))
+
+		req := &dto.CreateCommentRequestDTO{
+			Name:      testCommentName,
+			Comment:   testCommentContent,
+			NewsID:    testCommentNewsID,
+			Challenge: challenge.Challenge,
+			Nonce:     strconv.Itoa(wrong),
+		}
+
+		_, err = mockUseCase.Create(ctx, req)
+
+		assert.Equal(t, apperror.ErrInvalidProofOfWork, err)
+	})
+}
+
+func TestCommentUseCase_EditToken(t *testing.T) {
+	mockRepo := new(MockCommentRepo)
+	mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+	ctx := context.Background()
+	req := &dto.CreateCommentRequestDTO{
+		Name:    testCommentName,
+		Comment: testCommentContent,
+		NewsID:  testCommentNewsID,
+	}
+
+	var storedHash string
+
+	mockRepo.On("Create", ctx, mock.MatchedBy(func(comment *entity.Comment) bool {
+		storedHash = comment.EditTokenHash
+
+		return comment.EditTokenHash != ""
+	})).Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}, nil)
+
+	result, err := mockUseCase.Create(ctx, req)
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.EditToken)
+	assert.Equal(t, hashEditToken(result.EditToken), storedHash)
+	assert.NotEqual(t, result.EditToken, storedHash)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCommentUseCase_Update(t *testing.T) {
+	const editToken = "secret-edit-token"
+
+	editConfig := config.Comment{MaxDepth: 2, ModerationPolicy: CommentPolicyAutoApprove, EditWindow: 15 * time.Minute}
+
+	newComment := func(createdAt time.Time) *entity.Comment {
+		return &entity.Comment{
+			ID:            testCommentID,
+			NewsID:        testCommentNewsID,
+			Name:          testCommentName,
+			Comment:       testCommentContent,
+			Status:        entity.CommentStatusApproved,
+			EditTokenHash: hashEditToken(editToken),
+			CreatedAt:     createdAt,
+		}
+	}
+
+	t.Run("success - author edits within window", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(newComment(time.Now().Add(-time.Minute)), nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(comment *entity.Comment) bool {
+			return comment.Comment == "Edited comment" && comment.Status == entity.CommentStatusApproved
+		})).Return(nil)
+
+		result, err := mockUseCase.Update(ctx, testCommentID, &dto.UpdateCommentRequestDTO{
+			Comment:   "Edited comment",
+			EditToken: editToken,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Edited comment", result.Comment)
+		assert.Empty(t, result.EditToken)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - author edit does not duplicate the comment it replaces", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		duplicates := NewDuplicateFilter(mockRepo, 24*time.Hour)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig, duplicates)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(newComment(time.Now().Add(-time.Minute)), nil)
+		mockRepo.On("CountRecentByContent", ctx, "  great ARTICLE ", mock.AnythingOfType("time.Time"), testCommentID).Return(0, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(comment *entity.Comment) bool {
+			return comment.Status == entity.CommentStatusApproved
+		})).Return(nil)
+
+		_, err := mockUseCase.Update(ctx, testCommentID, &dto.UpdateCommentRequestDTO{
+			Comment:   "  great ARTICLE ",
+			EditToken: editToken,
+		})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - moderator edits without token after window", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		reject := &stubCommentFilter{action: CommentFilterReject}
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig, reject)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(newComment(time.Now().Add(-time.Hour)), nil)
+		mockRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+		_, err := mockUseCase.Update(ctx, testCommentID, &dto.UpdateCommentRequestDTO{
+			Comment:   "Edited comment",
+			Moderator: true,
+		})
+
+		require.NoError(t, err)
+		assert.Zero(t, reject.checked)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - held edit returns to the queue", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		hold := &stubCommentFilter{action: CommentFilterHold}
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig, hold)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(newComment(time.Now()), nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(comment *entity.Comment) bool {
+			return comment.Status == entity.CommentStatusPending
+		})).Return(nil)
+
+		result, err := mockUseCase.Update(ctx, testCommentID, &dto.UpdateCommentRequestDTO{
+			Comment:   "Visit http://example.com",
+			EditToken: editToken,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.CommentStatusPending, result.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	testCases := []struct {
+		name     string
+		comment  *entity.Comment
+		token    string
+		filters  []CommentFilter
+		expected error
+	}{
+		{name: "wrong token", comment: newComment(time.Now()), token: "wrong-token", expected: apperror.ErrInvalidEditToken},
+		{name: "missing token", comment: newComment(time.Now()), token: "", expected: apperror.ErrInvalidEditToken},
+		{name: "edit window passed", comment: newComment(time.Now().Add(-time.Hour)), token: editToken, expected: apperror.ErrCommentNotEditable},
+		{
+			name:     "rejected by filter",
+			comment:  newComment(time.Now()),
+			token:    editToken,
+			filters:  []CommentFilter{&stubCommentFilter{action: CommentFilterReject}},
+			expected: apperror.ErrCommentRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("error - "+tc.name, func(t *testing.T) {
+			mockRepo := new(MockCommentRepo)
+			mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig, tc.filters...)
+
+			ctx := context.Background()
+
+			mockRepo.On("GetByID", ctx, testCommentID).Return(tc.comment, nil)
+
+			result, err := mockUseCase.Update(ctx, testCommentID, &dto.UpdateCommentRequestDTO{
+				Comment:   "Edited comment",
+				EditToken: tc.token,
+			})
+
+			assert.Nil(t, result)
+			assert.Equal(t, tc.expected, err)
+			mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("error - comment rejected by moderator", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig)
+
+		ctx := context.Background()
+		comment := newComment(time.Now())
+		comment.Status = entity.CommentStatusRejected
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(comment, nil)
+
+		_, err := mockUseCase.Update(ctx, testCommentID, &dto.UpdateCommentRequestDTO{
+			Comment:   "Edited comment",
+			EditToken: editToken,
+		})
+
+		assert.Equal(t, apperror.ErrCommentNotEditable, err)
+	})
+}
+
+func TestCommentUseCase_Delete(t *testing.T) {
+	const editToken = "secret-edit-token"
+
+	editConfig := config.Comment{MaxDepth: 2, ModerationPolicy: CommentPolicyAutoApprove, EditWindow: 15 * time.Minute}
+
+	t.Run("success - author deletes with token", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(&entity.Comment{
+			ID:            testCommentID,
+			Status:        entity.CommentStatusApproved,
+			EditTokenHash: hashEditToken(editToken),
+			CreatedAt:     time.Now(),
+		}, nil)
+		mockRepo.On("Delete", ctx, testCommentID).Return(nil)
+
+		err := mockUseCase.Delete(ctx, testCommentID, &dto.DeleteCommentRequestDTO{EditToken: editToken})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - moderator deletes without token", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(&entity.Comment{ID: testCommentID}, nil)
+		mockRepo.On("Delete", ctx, testCommentID).Return(nil)
+
+		err := mockUseCase.Delete(ctx, testCommentID, &dto.DeleteCommentRequestDTO{Moderator: true})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - comment not found", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), noReportsRepo(), nil, nil, editConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(nil, apperror.ErrNotFound)
+
+		err := mockUseCase.Delete(ctx, testCommentID, &dto.DeleteCommentRequestDTO{EditToken: editToken})
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_CommentsClosed(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	future := time.Now().Add(time.Hour)
+
+	testCases := []struct {
+		name      string
+		news      *entity.News
+		closeDays int
+		expected  error
+	}{
+		{name: "comments disabled", news: &entity.News{CommentsEnabled: false, CreatedAt: time.Now()}, expected: apperror.ErrCommentsClosed},
+		{name: "close time passed", news: &entity.News{CommentsEnabled: true, CommentsCloseAt: &past, CreatedAt: time.Now()}, expected: apperror.ErrCommentsClosed},
+		{name: "close time ahead", news: &entity.News{CommentsEnabled: true, CommentsCloseAt: &future, CreatedAt: time.Now()}},
+		{name: "auto closed after days", news: &entity.News{CommentsEnabled: true, CreatedAt: time.Now().AddDate(0, 0, -8)}, closeDays: 7, expected: apperror.ErrCommentsClosed},
+		{name: "auto close not reached", news: &entity.News{CommentsEnabled: true, CreatedAt: time.Now().AddDate(0, 0, -6)}, closeDays: 7},
+		{name: "own close time overrides auto close", news: &entity.News{CommentsEnabled: true, CommentsCloseAt: &future, CreatedAt: time.Now().AddDate(0, 0, -8)}, closeDays: 7},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(MockCommentRepo)
+			mockNewsRepo := new(MockNewsRepo)
+			cfg := config.Comment{MaxDepth: 2, ModerationPolicy: CommentPolicyAutoApprove, CloseAfterDays: tc.closeDays}
+			mockUseCase := NewCommentUseCase(mockRepo, mockNewsRepo, noVotesRepo(), noReportsRepo(), nil, nil, cfg)
+
+			ctx := context.Background()
+			req := &dto.CreateCommentRequestDTO{
+				Name:    testCommentName,
+				Comment: testCommentContent,
+				NewsID:  testCommentNewsID,
+			}
+
+			mockNewsRepo.On("GetByID", ctx, testCommentNewsID).Return(tc.news, nil)
+
+			if tc.expected == nil {
+				mockRepo.On("Create", ctx, mock.Anything).
+					Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}, nil)
+			}
+
+			_, err := mockUseCase.Create(ctx, req)
+
+			assert.Equal(t, tc.expected, err)
+			mockRepo.AssertExpectations(t)
+			mockNewsRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("error - news not found", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockNewsRepo := new(MockNewsRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, mockNewsRepo, noVotesRepo(), noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockNewsRepo.On("GetByID", ctx, testCommentNewsID).Return(nil, apperror.ErrNotFound)
+
+		_, err := mockUseCase.Create(ctx, &dto.CreateCommentRequestDTO{
+			Name:    testCommentName,
+			Comment: testCommentContent,
+			NewsID:  testCommentNewsID,
+		})
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_Vote(t *testing.T) {
+	const voter = "visitor:fingerprint"
+
+	t.Run("success - upvote approved comment", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockVoteRepo := new(MockCommentVoteRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), mockVoteRepo, noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).
+			Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}, nil)
+		mockVoteRepo.On("Vote", ctx, testCommentID, voter, 1).Return(nil)
+		mockVoteRepo.On("CountByCommentIDs", ctx, []string{testCommentID}).
+			Return(map[string]entity.CommentVotes{testCommentID: {Upvotes: 3, Downvotes: 1}}, nil)
+
+		result, err := mockUseCase.Vote(ctx, testCommentID, voter, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, &dto.CommentVotesDTO{Upvotes: 3, Downvotes: 1, Score: 2}, result)
+		mockVoteRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid vote value", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockVoteRepo := new(MockCommentVoteRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), mockVoteRepo, noReportsRepo(), nil, nil, testCommentConfig)
+
+		result, err := mockUseCase.Vote(context.Background(), testCommentID, voter, 2)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrInvalidVote, err)
+		mockVoteRepo.AssertNotCalled(t, "Vote", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - comment not approved", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockVoteRepo := new(MockCommentVoteRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), mockVoteRepo, noReportsRepo(), nil, nil, testCommentConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).
+			Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusPending}, nil)
+
+		result, err := mockUseCase.Vote(ctx, testCommentID, voter, -1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		mockVoteRepo.AssertNotCalled(t, "Vote", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_Report(t *testing.T) {
+	const reporter = "visitor:fingerprint"
+
+	reportConfig := config.Comment{MaxDepth: 2, ModerationPolicy: CommentPolicyAutoApprove, ReportThreshold: 3}
+	approved := &entity.Comment{ID: testCommentID, Status: entity.CommentStatusApproved}
+
+	t.Run("success - report below threshold", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockReportRepo := new(MockCommentReportRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), mockReportRepo, nil, nil, reportConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(approved, nil)
+		mockReportRepo.On("Add", ctx, &entity.CommentReport{
+			CommentID: testCommentID,
+			Reporter:  reporter,
+			Reason:    entity.CommentReportAbuse,
+			Note:      "insults",
+		}).Return(nil)
+		mockReportRepo.On("CountByCommentID", ctx, testCommentID).Return(2, nil)
+
+		err := mockUseCase.Report(ctx, testCommentID, reporter, &dto.ReportCommentRequestDTO{
+			Reason: entity.CommentReportAbuse,
+			Note:   "insults",
+		})
+
+		assert.NoError(t, err)
+		mockReportRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - threshold hides comment", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockReportRepo := new(MockCommentReportRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), mockReportRepo, nil, nil, reportConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).Return(approved, nil)
+		mockReportRepo.On("Add", ctx, mock.Anything).Return(nil)
+		mockReportRepo.On("CountByCommentID", ctx, testCommentID).Return(3, nil)
+		mockRepo.On("UpdateStatus", ctx, []string{testCommentID}, entity.CommentStatusPending).Return(int64(1), nil)
+
+		err := mockUseCase.Report(ctx, testCommentID, reporter, &dto.ReportCommentRequestDTO{Reason: entity.CommentReportSpam})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid reason", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockReportRepo := new(MockCommentReportRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), mockReportRepo, nil, nil, reportConfig)
+
+		err := mockUseCase.Report(context.Background(), testCommentID, reporter, &dto.ReportCommentRequestDTO{Reason: "boring"})
+
+		assert.Equal(t, apperror.ErrInvalidReportReason, err)
+		mockReportRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - comment not published", func(t *testing.T) {
+		mockRepo := new(MockCommentRepo)
+		mockReportRepo := new(MockCommentReportRepo)
+		mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), mockReportRepo, nil, nil, reportConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testCommentID).
+			Return(&entity.Comment{ID: testCommentID, Status: entity.CommentStatusPending}, nil)
+
+		err := mockUseCase.Report(ctx, testCommentID, reporter, &dto.ReportCommentRequestDTO{Reason: entity.CommentReportSpam})
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		mockReportRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUseCase_GetReported(t *testing.T) {
+	mockRepo := new(MockCommentRepo)
+	mockReportRepo := new(MockCommentReportRepo)
+	mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), mockReportRepo, nil, nil, testCommentConfig)
+
+	ctx := context.Background()
+
+	mockReportRepo.On("GetReported", ctx, defaultModerationPageSize, 0).Return([]entity.ReportedComment{
+		{
+			Comment: entity.Comment{ID: testCommentID, Status: entity.CommentStatusPending},
+			Reports: 4,
+			Reasons: []string{entity.CommentReportAbuse},
+		},
+	}, nil)
+
+	result, err := mockUseCase.GetReported(ctx, 0, 0)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, testCommentID, result[0].Comment.ID)
+	assert.Equal(t, 4, result[0].Reports)
+	assert.Equal(t, []string{entity.CommentReportAbuse}, result[0].Reasons)
+}
+
+func TestCommentUseCase_ModerationClosesReports(t *testing.T) {
+	mockRepo := new(MockCommentRepo)
+	mockReportRepo := new(MockCommentReportRepo)
+	mockUseCase := NewCommentUseCase(mockRepo, openNewsRepo(), noVotesRepo(), mockReportRepo, nil, nil, testCommentConfig)
+
+	ctx := context.Background()
+	ids := []string{testCommentID}
+
+	mockRepo.On("UpdateStatus", ctx, ids, entity.CommentStatusApproved).Return(int64(1), nil)
+	mockReportRepo.On("DeleteByCommentIDs", ctx, ids).Return(nil)
+
+	_, err := mockUseCase.Approve(ctx, ids)
+
+	assert.NoError(t, err)
+	mockReportRepo.AssertExpectations(t)
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/blocks"
+	"github.com/RizqiSugiarto/coding-test/pkg/markdown"
+	"github.com/RizqiSugiarto/coding-test/pkg/render"
+	"github.com/RizqiSugiarto/coding-test/pkg/sanitize"
+)
+
+// Formats of news and custom page content.
+const (
+	ContentFormatHTML      = "html"
+	ContentFormatMarkdown  = "markdown"
+	ContentFormatPlainText = "plaintext"
+	ContentFormatBlocks    = "blocks"
+)
+
+// _renderBatchSize is how many items of stored content are rendered again
+// per query.
+const _renderBatchSize = 100
+
+// contentTypes are the types of content rendered from their source.
+var contentTypes = []string{entity.ContentNews, entity.ContentPage}
+
+// renderedContent is content as it is stored: its source, the format of the
+// source and the sanitized HTML and plain text rendered from it.
+type renderedContent struct {
+	Source string
+	Format string
+	HTML   string
+	Text   string
+}
+
+// ContentSanitizer cleans the HTML of news and custom page content before it
+// is stored, so a compromised author account cannot plant scripts for every
+// reader.
+type ContentSanitizer struct {
+	policy     *sanitize.Policy
+	enabled    bool
+	rawAuthors []string
+}
+
+func NewContentSanitizer(cfg config.HTML) *ContentSanitizer {
+	return &ContentSanitizer{
+		policy: sanitize.New(
+			sanitize.Elements(cfg.Elements...),
+			sanitize.Attributes(cfg.Attributes...),
+			sanitize.URLSchemes(cfg.URLSchemes...),
+			sanitize.StyleProperties(cfg.StyleProperties...),
+		),
+		enabled:    cfg.Sanitize,
+		rawAuthors: cfg.RawAuthors,
+	}
+}
+
+// Clean returns content as it is stored when written by userID. Content of
+// the users trusted with raw HTML is kept as submitted.
+func (s *ContentSanitizer) Clean(userID, content string) string {
+	if !s.enabled || (userID != "" && slices.Contains(s.rawAuthors, userID)) {
+		return content
+	}
+
+	return s.policy.Sanitize(content)
+}
+
+// prepare readies content written by userID in format, html when empty, for
+// storage. HTML sources are cleaned themselves, while Markdown, plain text
+// and block documents are kept as written and only their rendered HTML is
+// cleaned. Invalid block documents fail with a *blocks.Error.
+func (s *ContentSanitizer) prepare(userID, format, content string) (renderedContent, error) {
+	switch format {
+	case "", ContentFormatHTML:
+		clean := s.Clean(userID, content)
+
+		return renderedContent{Source: clean, Format: ContentFormatHTML, HTML: clean, Text: sanitize.Text(clean)}, nil
+	case ContentFormatMarkdown:
+		rendered := s.Clean(userID, markdown.ToHTML(content))
+
+		return renderedContent{Source: content, Format: format, HTML: rendered, Text: sanitize.Text(rendered)}, nil
+	case ContentFormatPlainText:
+		return renderedContent{Source: content, Format: format, HTML: string(render.PlainText(content)), Text: content}, nil
+	case ContentFormatBlocks:
+		doc, err := blocks.Parse(content)
+		if err != nil {
+			return renderedContent{}, err
+		}
+
+		return renderedContent{Source: content, Format: format, HTML: s.Clean(userID, doc.HTML()), Text: doc.Text()}, nil
+	default:
+		return renderedContent{}, apperror.ErrInvalidContentFormat
+	}
+}
+
+// ContentUseCase keeps the stored HTML of news and custom pages in line with
+// the sanitizer settings, so content written before sanitizing was enabled
+// is never served as trusted markup.
+type ContentUseCase struct {
+	contentRepo repository.ContentRepo
+	sanitizer   *ContentSanitizer
+}
+
+func NewContentUseCase(contentRepo repository.ContentRepo, sanitizer *ContentSanitizer) *ContentUseCase {
+	return &ContentUseCase{
+		contentRepo: contentRepo,
+		sanitizer:   sanitizer,
+	}
+}
+
+// RenderStored renders all stored content again when it was never rendered,
+// or was rendered unsanitized and sanitizing is now enabled. Content rendered
+// sanitized is kept when sanitizing is disabled, since it is safe either way.
+func (cu *ContentUseCase) RenderStored(ctx context.Context) error {
+	state, err := cu.contentRepo.GetRenderState(ctx)
+	if err != nil && !errors.Is(err, apperror.ErrNotFound) {
+		return err
+	}
+
+	rendered := err == nil
+	if rendered && state.Sanitized == cu.sanitizer.enabled {
+		return nil
+	}
+
+	if !rendered || cu.sanitizer.enabled {
+		for _, contentType := range contentTypes {
+			if err := cu.renderAll(ctx, contentType); err != nil {
+				return err
+			}
+		}
+	}
+
+	return cu.contentRepo.SetRenderState(ctx, cu.sanitizer.enabled)
+}
+
+// renderAll renders every item of a type of content again from its source,
+// as written by its author.
+func (cu *ContentUseCase) renderAll(ctx context.Context, contentType string) error {
+	afterID := ""
+
+	for {
+		contents, err := cu.contentRepo.GetAfter(ctx, contentType, afterID, _renderBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, content := range contents {
+			rendered, err := cu.sanitizer.prepare(content.AuthorID, content.Format, content.Content)
+			if err != nil {
+				return fmt.Errorf("render %s %s: %w", contentType, content.ID, err)
+			}
+
+			content.Content, content.HTML, content.Text = rendered.Source, rendered.HTML, rendered.Text
+
+			if err := cu.contentRepo.UpdateRendered(ctx, contentType, content); err != nil {
+				return err
+			}
+		}
+
+		if len(contents) < _renderBatchSize {
+			return nil
+		}
+
+		afterID = contents[len(contents)-1].ID
+	}
+}
@@ -0,0 +1,377 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/blocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testRawAuthorID = "550e8400-e29b-41d4-a716-446655440099"
+
+var testContentSanitizer = NewContentSanitizer(config.HTML{
+	Sanitize:        true,
+	Elements:        []string{"p", "a", "span"},
+	Attributes:      []string{"a:href", "*:style"},
+	URLSchemes:      []string{"https"},
+	StyleProperties: []string{"color"},
+	RawAuthors:      []string{testRawAuthorID},
+})
+
+// MockContentRepo is a mock implementation of repository.ContentRepo.
+type MockContentRepo struct {
+	mock.Mock
+}
+
+func (m *MockContentRepo) GetRenderState(ctx context.Context) (*entity.ContentRenderState, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.ContentRenderState)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockContentRepo) SetRenderState(ctx context.Context, sanitized bool) error {
+	args := m.Called(ctx, sanitized)
+
+	return args.Error(0)
+}
+
+func (m *MockContentRepo) GetAfter(ctx context.Context, contentType, afterID string, limit int) ([]entity.StoredContent, error) {
+	args := m.Called(ctx, contentType, afterID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.StoredContent)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockContentRepo) UpdateRendered(ctx context.Context, contentType string, content entity.StoredContent) error {
+	args := m.Called(ctx, contentType, content)
+
+	return args.Error(0)
+}
+
+func TestContentSanitizer_Clean(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   string
+		content  string
+		expected string
+	}{
+		{
+			name:     "keeps allowed markup",
+			content:  `<p>Hello <a href="https://example.com">world</a></p>`,
+			expected: `<p>Hello <a href="https://example.com">world</a></p>`,
+		},
+		{
+			name:     "drops scripts with their content",
+			content:  `<p>Hi</p><script>alert(1)</script>`,
+			expected: `<p>Hi</p>`,
+		},
+		{
+			name:     "drops event handlers and disallowed elements",
+			content:  `<p onclick="alert(1)"><b>bold</b></p>`,
+			expected: `<p>bold</p>`,
+		},
+		{
+			name:     "drops disallowed URL schemes",
+			content:  `<a href="javascript:alert(1)">x</a><a href="http://example.com">y</a><a href="/about">z</a>`,
+			expected: `<a>x</a><a>y</a><a href="/about">z</a>`,
+		},
+		{
+			name:     "keeps allowed style properties only",
+			content:  `<span style="color: red; position: fixed; color: url(x)">s</span>`,
+			expected: `<span style="color: red">s</span>`,
+		},
+		{
+			name:     "closes elements left open",
+			content:  `<p><a href="/a">link`,
+			expected: `<p><a href="/a">link</a></p>`,
+		},
+		{
+			name:     "raw author keeps content as submitted",
+			userID:   testRawAuthorID,
+			content:  `<p onclick="track()">Hi</p><script>analytics()</script>`,
+			expected: `<p onclick="track()">Hi</p><script>analytics()</script>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, testContentSanitizer.Clean(tt.userID, tt.content))
+		})
+	}
+
+	t.Run("disabled keeps content as submitted", func(t *testing.T) {
+		sanitizer := NewContentSanitizer(config.HTML{Sanitize: false})
+
+		assert.Equal(t, `<script>x</script>`, sanitizer.Clean("", `<script>x</script>`))
+	})
+}
+
+func TestContentSanitizer_prepare(t *testing.T) {
+	sanitizer := NewContentSanitizer(config.HTML{
+		Sanitize:   true,
+		Elements:   []string{"p", "h2", "a", "sup", "table", "thead", "tbody", "tr", "th", "td", "div", "ol", "li", "hr", "pre", "code"},
+		Attributes: []string{"a:href", "*:id", "th:align", "td:align"},
+		URLSchemes: []string{"https"},
+	})
+
+	t.Run("html source is cleaned", func(t *testing.T) {
+		content, err := sanitizer.prepare("", "", `<p>Hi</p><script>x</script>`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, renderedContent{Source: `<p>Hi</p>`, Format: ContentFormatHTML, HTML: `<p>Hi</p>`, Text: "Hi"}, content)
+	})
+
+	t.Run("markdown keeps its source and renders sanitized html", func(t *testing.T) {
+		source := "## Results\n\n| a | b |\n|:--|--:|\n| 1 | 2 |\n\nSee[^1] <script>x</script>\n\n[^1]: Note\n"
+
+		content, err := sanitizer.prepare("", ContentFormatMarkdown, source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, source, content.Source)
+		assert.Equal(t, ContentFormatMarkdown, content.Format)
+		assert.Contains(t, content.HTML, `<h2 id="results">Results</h2>`)
+		assert.Contains(t, content.HTML, `<th align="left">a</th>`)
+		assert.Contains(t, content.HTML, `<sup id="fnref:1">`)
+		assert.NotContains(t, content.HTML, "<script>")
+	})
+
+	t.Run("plain text is escaped into paragraphs", func(t *testing.T) {
+		content, err := sanitizer.prepare("", ContentFormatPlainText, "a <b>\n\nc")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "a <b>\n\nc", content.Source)
+		assert.Equal(t, "<p>a &lt;b&gt;</p>\n<p>c</p>\n", content.HTML)
+	})
+
+	t.Run("blocks keep their source and render sanitized html and text", func(t *testing.T) {
+		source := `{"blocks": [
+			{"type": "heading", "text": "Results", "level": 2},
+			{"type": "paragraph", "text": "Read <a href=\"https://example.com\" onclick=\"x()\">more</a>"},
+			{"type": "list", "style": "ordered", "items": ["One", "Two"]},
+			{"type": "code", "code": "a < b", "language": "go"},
+			{"type": "html", "html": "<p>Raw</p><script>x</script>"}
+		]}`
+
+		content, err := sanitizer.prepare("", ContentFormatBlocks, source)
+
+		assert.NoError(t, err)
+		assert.Equal(t, source, content.Source)
+		assert.Equal(t, ContentFormatBlocks, content.Format)
+		assert.Equal(t, "<h2>Results</h2>\n"+
+			"<p>Read <a href=\"https://example.com\">more</a></p>\n"+
+			"<ol><li>One</li><li>Two</li></ol>\n"+
+			"<pre><code>a &lt; b</code></pre>\n"+
+			"<p>Raw</p>\n", content.HTML)
+		assert.Equal(t, "Results\n\nRead more\n\n1. One\n2. Two\n\na < b\n\nRaw", content.Text)
+	})
+
+	t.Run("invalid block", func(t *testing.T) {
+		_, err := sanitizer.prepare("", ContentFormatBlocks, `{"blocks": [{"type": "paragraph", "text": "Hi"}, {"type": "heading", "text": "x", "level": 7}]}`)
+
+		var blockErr *blocks.Error
+		assert.ErrorIs(t, err, apperror.ErrInvalidBlocks)
+		assert.ErrorAs(t, err, &blockErr)
+		assert.Equal(t, "blocks[1].level", blockErr.Path())
+	})
+
+	t.Run("field not allowed in block type", func(t *testing.T) {
+		_, err := sanitizer.prepare("", ContentFormatBlocks, `{"blocks": [{"type": "paragraph", "text": "Hi", "url": "https://example.com"}]}`)
+
+		var blockErr *blocks.Error
+		assert.ErrorAs(t, err, &blockErr)
+		assert.Equal(t, "blocks[0].url", blockErr.Path())
+	})
+
+	t.Run("blocks must be a document", func(t *testing.T) {
+		_, err := sanitizer.prepare("", ContentFormatBlocks, `<p>Hi</p>`)
+
+		var blockErr *blocks.Error
+		assert.ErrorAs(t, err, &blockErr)
+		assert.Equal(t, "blocks", blockErr.Path())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := sanitizer.prepare("", "rst", "x")
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidContentFormat)
+	})
+}
+
+func TestContentUseCase_RenderStored(t *testing.T) {
+	unsafe := entity.StoredContent{ID: testNewsID, Content: `<p>Hi</p><script>alert(1)</script>`, Format: ContentFormatHTML}
+	unsafePage := entity.StoredContent{ID: testPageID, Content: `{"blocks": [{"type": "html", "html": "<p onclick=\"x()\">Hi</p>"}]}`, Format: ContentFormatBlocks}
+	unsanitizedConfig := config.HTML{Sanitize: false}
+
+	t.Run("success - never rendered content is sanitized", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, testContentSanitizer)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetRenderState", ctx).Return(nil, apperror.ErrNotFound)
+		mockRepo.On("GetAfter", ctx, entity.ContentNews, "", _renderBatchSize).Return([]entity.StoredContent{unsafe}, nil)
+		mockRepo.On("GetAfter", ctx, entity.ContentPage, "", _renderBatchSize).Return([]entity.StoredContent{unsafePage}, nil)
+		mockRepo.On("UpdateRendered", ctx, entity.ContentNews, entity.StoredContent{
+			ID: testNewsID, Content: "<p>Hi</p>", Format: ContentFormatHTML, HTML: "<p>Hi</p>", Text: "Hi",
+		}).Return(nil)
+		mockRepo.On("UpdateRendered", ctx, entity.ContentPage, entity.StoredContent{
+			ID: testPageID, Content: unsafePage.Content, Format: ContentFormatBlocks, HTML: "<p>Hi</p>\n", Text: "Hi",
+		}).Return(nil)
+		mockRepo.On("SetRenderState", ctx, true).Return(nil)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - content rendered unsanitized is sanitized once enabled", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, testContentSanitizer)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetRenderState", ctx).Return(&entity.ContentRenderState{Sanitized: false, UpdatedAt: time.Now()}, nil)
+		mockRepo.On("GetAfter", ctx, entity.ContentNews, "", _renderBatchSize).Return([]entity.StoredContent{unsafe}, nil)
+		mockRepo.On("GetAfter", ctx, entity.ContentPage, "", _renderBatchSize).Return([]entity.StoredContent{}, nil)
+		mockRepo.On("UpdateRendered", ctx, entity.ContentNews, mock.MatchedBy(func(content entity.StoredContent) bool {
+			return content.HTML == "<p>Hi</p>"
+		})).Return(nil)
+		mockRepo.On("SetRenderState", ctx, true).Return(nil)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - never rendered content is rendered unsanitized when disabled", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, NewContentSanitizer(unsanitizedConfig))
+
+		ctx := context.Background()
+
+		mockRepo.On("GetRenderState", ctx).Return(nil, apperror.ErrNotFound)
+		mockRepo.On("GetAfter", ctx, entity.ContentNews, "", _renderBatchSize).Return([]entity.StoredContent{unsafe}, nil)
+		mockRepo.On("GetAfter", ctx, entity.ContentPage, "", _renderBatchSize).Return([]entity.StoredContent{}, nil)
+		mockRepo.On("UpdateRendered", ctx, entity.ContentNews, mock.MatchedBy(func(content entity.StoredContent) bool {
+			return content.HTML == unsafe.Content
+		})).Return(nil)
+		mockRepo.On("SetRenderState", ctx, false).Return(nil)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - sanitized content is kept", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, testContentSanitizer)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetRenderState", ctx).Return(&entity.ContentRenderState{Sanitized: true}, nil)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "SetRenderState", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - sanitized content is kept when disabled", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, NewContentSanitizer(unsanitizedConfig))
+
+		ctx := context.Background()
+
+		mockRepo.On("GetRenderState", ctx).Return(&entity.ContentRenderState{Sanitized: true}, nil)
+		mockRepo.On("SetRenderState", ctx, false).Return(nil)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - renders in batches", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, testContentSanitizer)
+
+		ctx := context.Background()
+
+		batch := make([]entity.StoredContent, _renderBatchSize)
+		for i := range batch {
+			batch[i] = entity.StoredContent{ID: fmt.Sprintf("news-%03d", i), Content: "<p>Hi</p>", Format: ContentFormatHTML}
+		}
+
+		mockRepo.On("GetRenderState", ctx).Return(nil, apperror.ErrNotFound)
+		mockRepo.On("GetAfter", ctx, entity.ContentNews, "", _renderBatchSize).Return(batch, nil)
+		mockRepo.On("GetAfter", ctx, entity.ContentNews, batch[_renderBatchSize-1].ID, _renderBatchSize).Return([]entity.StoredContent{}, nil)
+		mockRepo.On("GetAfter", ctx, entity.ContentPage, "", _renderBatchSize).Return([]entity.StoredContent{}, nil)
+		mockRepo.On("UpdateRendered", ctx, entity.ContentNews, mock.Anything).Return(nil).Times(_renderBatchSize)
+		mockRepo.On("SetRenderState", ctx, true).Return(nil)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid stored content keeps the state", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, testContentSanitizer)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetRenderState", ctx).Return(nil, apperror.ErrNotFound)
+		mockRepo.On("GetAfter", ctx, entity.ContentNews, "", _renderBatchSize).Return([]entity.StoredContent{
+			{ID: testNewsID, Content: "<p>Hi</p>", Format: ContentFormatBlocks},
+		}, nil)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidBlocks)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "SetRenderState", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - failed state lookup", func(t *testing.T) {
+		mockRepo := new(MockContentRepo)
+		useCase := NewContentUseCase(mockRepo, testContentSanitizer)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetRenderState", ctx).Return(nil, apperror.ErrDatabaseConnection)
+
+		err := useCase.RenderStored(ctx)
+
+		assert.ErrorIs(t, err, apperror.ErrDatabaseConnection)
+		mockRepo.AssertNotCalled(t, "GetAfter", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -13,8 +13,8 @@ type Auth interface {
 
 type Category interface {
 	Create(ctx context.Context, req *dto.CreateCategoryRequestDTO) (*dto.CategoryResponseDTO, error)
-	GetByID(ctx context.Context, id string) (*dto.CategoryResponseDTO, error)
-	GetAll(ctx context.Context) ([]dto.CategoryResponseDTO, error)
+	GetByID(ctx context.Context, id string, locales []string) (*dto.CategoryResponseDTO, error)
+	GetAll(ctx context.Context, locales []string) ([]dto.CategoryResponseDTO, error)
 	Update(ctx context.Context, id string, req *dto.UpdateCategoryRequestDTO) error
 	Delete(ctx context.Context, id string) error
 }
@@ -22,21 +22,76 @@ type Category interface {
 //nolint:dupl // The News and CustomPage interfaces are conceptually different, duplication is intentional
 type News interface {
 	Create(ctx context.Context, authorID string, req *dto.CreateNewsRequestDTO) (*dto.NewsResponseDTO, error)
-	GetByID(ctx context.Context, id string) (*dto.NewsResponseDTO, error)
-	GetAll(ctx context.Context) ([]dto.NewsResponseDTO, error)
-	Update(ctx context.Context, id string, req *dto.UpdateNewsRequestDTO) error
+	GetByID(ctx context.Context, id string, locales []string) (*dto.NewsResponseDTO, error)
+	GetAll(ctx context.Context, locales []string) ([]dto.NewsResponseDTO, error)
+	GetByCategory(ctx context.Context, categoryID string, locales []string) ([]dto.NewsResponseDTO, error)
+	Update(ctx context.Context, editorID, id string, req *dto.UpdateNewsRequestDTO) error
 	Delete(ctx context.Context, id string) error
+	React(ctx context.Context, newsID, voter, reaction string) (map[string]int, error)
+	Unreact(ctx context.Context, newsID, voter, reaction string) (map[string]int, error)
 }
 
 //nolint:dupl // The News and CustomPage interfaces are conceptually different, duplication is intentional
 type CustomPage interface {
 	Create(ctx context.Context, authorID string, req *dto.CreateCustomPageRequestDTO) (*dto.CustomPageResponseDTO, error)
-	GetByID(ctx context.Context, id string) (*dto.CustomPageResponseDTO, error)
-	GetAll(ctx context.Context) ([]dto.CustomPageResponseDTO, error)
-	Update(ctx context.Context, id string, req *dto.UpdateCustomPageRequestDTO) error
+	GetByID(ctx context.Context, id string, locales []string) (*dto.CustomPageResponseDTO, error)
+	GetByURL(ctx context.Context, path string, locales []string) (*dto.CustomPageResponseDTO, error)
+	GetAll(ctx context.Context, locales []string) ([]dto.CustomPageResponseDTO, error)
+	GetTree(ctx context.Context) ([]dto.CustomPageTreeDTO, error)
+	Update(ctx context.Context, editorID, id string, req *dto.UpdateCustomPageRequestDTO) error
 	Delete(ctx context.Context, id string) error
 }
 
+type Redirect interface {
+	Create(ctx context.Context, req *dto.CreateRedirectRequestDTO) (*dto.RedirectResponseDTO, error)
+	GetByID(ctx context.Context, id string) (*dto.RedirectResponseDTO, error)
+	GetAll(ctx context.Context) ([]dto.RedirectResponseDTO, error)
+	Update(ctx context.Context, id string, req *dto.UpdateRedirectRequestDTO) error
+	Delete(ctx context.Context, id string) error
+	Resolve(ctx context.Context, path string) (*dto.RedirectResponseDTO, error)
+}
+
+type Translation interface {
+	Missing(ctx context.Context, contentType string) ([]dto.MissingTranslationDTO, error)
+}
+
+type Feed interface {
+	News(ctx context.Context, categoryID string) (*dto.FeedDTO, error)
+	Tag(ctx context.Context, tag string) (*dto.FeedDTO, error)
+}
+
+type Sitemap interface {
+	Index(ctx context.Context) ([]dto.SitemapURLDTO, error)
+	URLs(ctx context.Context, section string, file int) ([]dto.SitemapURLDTO, error)
+	News(ctx context.Context) (*dto.SitemapNewsDTO, error)
+}
+
+type Media interface {
+	Upload(ctx context.Context, uploaderID string, req *dto.UploadMediaRequestDTO) (*dto.MediaResponseDTO, bool, error)
+	GetByID(ctx context.Context, id string) (*dto.MediaResponseDTO, error)
+	GetAll(ctx context.Context) ([]dto.MediaResponseDTO, error)
+	Update(ctx context.Context, id string, req *dto.UpdateMediaRequestDTO) error
+	Delete(ctx context.Context, id string) error
+	Open(ctx context.Context, key, size string) (*dto.MediaFileDTO, error)
+}
+
+type Menu interface {
+	GetAll(ctx context.Context) ([]dto.MenuSummaryDTO, error)
+	GetByName(ctx context.Context, name string) (*dto.MenuResponseDTO, error)
+	Save(ctx context.Context, name string, req *dto.SaveMenuRequestDTO) (*dto.MenuResponseDTO, error)
+	Delete(ctx context.Context, name string) error
+}
+
 type Comment interface {
-	Create(ctx context.Context, req *dto.CreateCommentRequestDTO) error
+	Create(ctx context.Context, req *dto.CreateCommentRequestDTO) (*dto.CommentResponseDTO, error)
+	GetByNewsID(ctx context.Context, newsID string, tree, top bool) ([]dto.CommentResponseDTO, error)
+	GetModerationQueue(ctx context.Context, status string, limit, offset int) ([]dto.CommentResponseDTO, error)
+	Approve(ctx context.Context, ids []string) (int64, error)
+	Reject(ctx context.Context, ids []string, spam bool) (int64, error)
+	IssueChallenge(ctx context.Context, newsID string) (*dto.CommentChallengeDTO, error)
+	Update(ctx context.Context, id string, req *dto.UpdateCommentRequestDTO) (*dto.CommentResponseDTO, error)
+	Delete(ctx context.Context, id string, req *dto.DeleteCommentRequestDTO) error
+	Vote(ctx context.Context, id, voter string, value int) (*dto.CommentVotesDTO, error)
+	Report(ctx context.Context, id, reporter string, req *dto.ReportCommentRequestDTO) error
+	GetReported(ctx context.Context, limit, offset int) ([]dto.ReportedCommentDTO, error)
 }
@@ -2,95 +2,290 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"strings"
 
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/urlpath"
 )
 
+// maxSitePathLength matches the custom_pages.custom_url and
+// redirects.source_path columns.
+const maxSitePathLength = 150
+
+// reservedPaths are served by the application itself, so no custom page or
+// redirect may live at or below them.
+var reservedPaths = []string{"/api", "/swagger", "/healthz", "/media", "/feeds", "/news", "/categories", "/sitemap.xml", "/sitemap.xml.gz", "/sitemaps"}
+
 type CustomPageUseCase struct {
 	customPageRepo repository.CustomPageRepo
+	redirectRepo   repository.RedirectRepo
+	mediaRepo      repository.MediaRepo
+	sanitizer      *ContentSanitizer
+	localizer      *Localizer
 }
 
-func NewCustomPageUseCase(customPageRepo repository.CustomPageRepo) *CustomPageUseCase {
+func NewCustomPageUseCase(
+	customPageRepo repository.CustomPageRepo,
+	redirectRepo repository.RedirectRepo,
+	mediaRepo repository.MediaRepo,
+	sanitizer *ContentSanitizer,
+	localizer *Localizer,
+) *CustomPageUseCase {
 	return &CustomPageUseCase{
 		customPageRepo: customPageRepo,
+		redirectRepo:   redirectRepo,
+		mediaRepo:      mediaRepo,
+		sanitizer:      sanitizer,
+		localizer:      localizer,
 	}
 }
 
 func (cu *CustomPageUseCase) Create(ctx context.Context, authorID string, req *dto.CreateCustomPageRequestDTO) (*dto.CustomPageResponseDTO, error) {
-	page := &entity.CustomPage{
-		CustomURL: req.CustomURL,
-		Content:   req.Content,
-		AuthorID:  authorID,
+	page, err := cu.locate(ctx, "", req.ParentID, req.Slug, req.CustomURL)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := cu.sanitizer.prepare(authorID, req.ContentFormat, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	page.Locale, err = cu.localizer.locale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	page.TranslationGroup, err = cu.localizer.join(ctx, entity.TranslationPage, req.TranslationOf, page.Locale)
+	if err != nil {
+		return nil, err
 	}
 
+	page.Position = req.Position
+	page.Content, page.ContentFormat = content.Source, content.Format
+	page.ContentHTML, page.ContentText = content.HTML, content.Text
+	page.AuthorID = authorID
+
 	result, err := cu.customPageRepo.Create(ctx, page)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.CustomPageResponseDTO{
-		ID:        result.ID,
-		CustomURL: result.CustomURL,
-		Content:   result.Content,
-		AuthorID:  result.AuthorID,
-		CreatedAt: result.CreatedAt,
-		UpdatedAt: result.UpdatedAt,
-	}, nil
+	// A redirect away from the new page's URL would hide it
+	if err := cu.redirectRepo.DeleteBySource(ctx, result.CustomURL); err != nil {
+		return nil, err
+	}
+
+	err = cu.mediaRepo.SetReferences(ctx, entity.MediaOwnerPage, result.ID, mediaKeys(result.ContentHTML))
+	if err != nil {
+		return nil, err
+	}
+
+	return customPageResponse(result), nil
 }
 
-func (cu *CustomPageUseCase) GetByID(ctx context.Context, id string) (*dto.CustomPageResponseDTO, error) {
+// GetByID returns a page, or its translation ranked best for a reader
+// preferring locales.
+func (cu *CustomPageUseCase) GetByID(ctx context.Context, id string, locales []string) (*dto.CustomPageResponseDTO, error) {
 	page, err := cu.customPageRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.CustomPageResponseDTO{
-		ID:        page.ID,
-		CustomURL: page.CustomURL,
-		Content:   page.Content,
-		AuthorID:  page.AuthorID,
-		CreatedAt: page.CreatedAt,
-		UpdatedAt: page.UpdatedAt,
-	}, nil
+	return cu.translated(ctx, page, locales)
+}
+
+// GetByURL looks a page up by its custom URL like GetByID. The path is
+// normalized the same way custom URLs are on save, so "/About-Us/" finds
+// "/about-us". A translation lives at a URL of its own, which is returned
+// with it.
+func (cu *CustomPageUseCase) GetByURL(ctx context.Context, path string, locales []string) (*dto.CustomPageResponseDTO, error) {
+	customURL, err := urlpath.Normalize(path)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := cu.customPageRepo.GetByURL(ctx, customURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return cu.translated(ctx, page, locales)
+}
+
+// translated returns page, or its translation ranked best for a reader
+// preferring locales, with its breadcrumbs.
+func (cu *CustomPageUseCase) translated(ctx context.Context, page *entity.CustomPage, locales []string) (*dto.CustomPageResponseDTO, error) {
+	best, err := cu.localizer.pick(ctx, entity.TranslationPage, page.ID, cu.localizer.chain(locales))
+	if err != nil {
+		return nil, err
+	}
+
+	if best != page.ID {
+		page, err = cu.customPageRepo.GetByID(ctx, best)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return cu.withBreadcrumbs(ctx, page)
 }
 
-func (cu *CustomPageUseCase) GetAll(ctx context.Context) ([]dto.CustomPageResponseDTO, error) {
+// GetAll lists the pages, one translation of each for a reader preferring
+// locales, or all of them when locales is empty.
+func (cu *CustomPageUseCase) GetAll(ctx context.Context, locales []string) ([]dto.CustomPageResponseDTO, error) {
 	pageList, err := cu.customPageRepo.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	pageList = localize(pageList, cu.localizer.chain(locales), func(p *entity.CustomPage) (string, string) {
+		return p.TranslationGroup, p.Locale
+	})
+
 	result := make([]dto.CustomPageResponseDTO, 0, len(pageList))
 
 	for i := range pageList {
-		result = append(result, dto.CustomPageResponseDTO{
-			ID:        pageList[i].ID,
-			CustomURL: pageList[i].CustomURL,
-			Content:   pageList[i].Content,
-			AuthorID:  pageList[i].AuthorID,
-			CreatedAt: pageList[i].CreatedAt,
-			UpdatedAt: pageList[i].UpdatedAt,
-		})
+		result = append(result, *customPageResponse(&pageList[i]))
 	}
 
 	return result, nil
 }
 
-func (cu *CustomPageUseCase) Update(ctx context.Context, id string, req *dto.UpdateCustomPageRequestDTO) error {
-	page := &entity.CustomPage{
-		ID:        id,
-		CustomURL: req.CustomURL,
-		Content:   req.Content,
+// GetTree returns every page nested under its parent, siblings ordered by
+// position and then custom URL.
+func (cu *CustomPageUseCase) GetTree(ctx context.Context) ([]dto.CustomPageTreeDTO, error) {
+	pageList, err := cu.customPageRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[string][]entity.CustomPage)
+
+	for _, page := range pageList {
+		children[page.ParentID] = append(children[page.ParentID], page)
+	}
+
+	var build func(parentID string) []dto.CustomPageTreeDTO
+
+	build = func(parentID string) []dto.CustomPageTreeDTO {
+		siblings := children[parentID]
+		sort.SliceStable(siblings, func(i, j int) bool {
+			if siblings[i].Position != siblings[j].Position {
+				return siblings[i].Position < siblings[j].Position
+			}
+
+			return siblings[i].CustomURL < siblings[j].CustomURL
+		})
+
+		result := make([]dto.CustomPageTreeDTO, 0, len(siblings))
+
+		for _, page := range siblings {
+			result = append(result, dto.CustomPageTreeDTO{
+				ID:        page.ID,
+				CustomURL: page.CustomURL,
+				Position:  page.Position,
+				Children:  build(page.ID),
+			})
+		}
+
+		return result
+	}
+
+	return build(""), nil
+}
+
+func (cu *CustomPageUseCase) Update(ctx context.Context, editorID, id string, req *dto.UpdateCustomPageRequestDTO) error {
+	current, err := cu.customPageRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
 	}
 
-	err := cu.customPageRepo.Update(ctx, page)
+	page, err := cu.locate(ctx, id, req.ParentID, req.Slug, req.CustomURL)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	content, err := cu.sanitizer.prepare(editorID, req.ContentFormat, req.Content)
+	if err != nil {
+		return err
+	}
+
+	page.Locale, err = cu.localizer.relocate(ctx, entity.TranslationPage, id, req.Locale)
+	if err != nil {
+		return err
+	}
+
+	page.ID = id
+	page.Position = req.Position
+	page.Content, page.ContentFormat = content.Source, content.Format
+	page.ContentHTML, page.ContentText = content.HTML, content.Text
+
+	if current.CustomURL == page.CustomURL {
+		if err := cu.customPageRepo.Update(ctx, page); err != nil {
+			return err
+		}
+
+		return cu.mediaRepo.SetReferences(ctx, entity.MediaOwnerPage, id, mediaKeys(page.ContentHTML))
+	}
+
+	// Work out where derived descendants go before moving anything, so an
+	// invalid path deeper down rejects the whole update
+	below, err := cu.descendantMoves(ctx, id, page.CustomURL)
+	if err != nil {
+		return err
+	}
+
+	moves := append([]entity.PageMove{{ID: id, From: current.CustomURL, To: page.CustomURL}}, below...)
+
+	if err := cu.customPageRepo.Move(ctx, page, moves); err != nil {
+		return err
+	}
+
+	return cu.mediaRepo.SetReferences(ctx, entity.MediaOwnerPage, id, mediaKeys(page.ContentHTML))
+}
+
+// descendantMoves lists the pages below parentID whose custom URL is derived
+// from it and changes when it moves to parentURL. Pages with a custom URL of
+// their own stay put, and so do their descendants.
+func (cu *CustomPageUseCase) descendantMoves(ctx context.Context, parentID, parentURL string) ([]entity.PageMove, error) {
+	children, err := cu.customPageRepo.GetChildren(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var moves []entity.PageMove
+
+	for _, child := range children {
+		if child.Slug == "" {
+			continue
+		}
+
+		to, err := normalizeSitePath(parentURL + "/" + child.Slug)
+		if err != nil {
+			return nil, err
+		}
+
+		if to == child.CustomURL {
+			continue
+		}
+
+		moves = append(moves, entity.PageMove{ID: child.ID, From: child.CustomURL, To: to})
+
+		below, err := cu.descendantMoves(ctx, child.ID, to)
+		if err != nil {
+			return nil, err
+		}
+
+		moves = append(moves, below...)
+	}
+
+	return moves, nil
 }
 
 func (cu *CustomPageUseCase) Delete(ctx context.Context, id string) error {
@@ -101,3 +296,125 @@ func (cu *CustomPageUseCase) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// locate works out where page id (empty for a new page) lives: under an
+// existing parent that is not the page itself or one of its descendants, at
+// a custom URL derived from the parent's when a slug is given, or else at
+// customURL.
+func (cu *CustomPageUseCase) locate(ctx context.Context, id, parentID, slug, customURL string) (*entity.CustomPage, error) {
+	var parentURL string
+
+	if parentID != "" {
+		if parentID == id {
+			return nil, apperror.ErrInvalidParentPage
+		}
+
+		parent, err := cu.customPageRepo.GetByID(ctx, parentID)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return nil, apperror.ErrInvalidParentPage
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if id != "" {
+			ancestors, err := cu.customPageRepo.GetAncestors(ctx, parentID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, ancestor := range ancestors {
+				if ancestor.ID == id {
+					return nil, apperror.ErrInvalidParentPage
+				}
+			}
+		}
+
+		parentURL = parent.CustomURL
+	}
+
+	if slug != "" {
+		normalized, err := urlpath.Normalize("/" + strings.TrimSpace(slug))
+		if err != nil || normalized == "/" || strings.Count(normalized, "/") > 1 {
+			return nil, apperror.ErrInvalidSlug
+		}
+
+		slug = normalized[1:]
+		customURL = parentURL + "/" + slug
+	}
+
+	normalized, err := normalizeSitePath(customURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.CustomPage{
+		ParentID:  parentID,
+		Slug:      slug,
+		CustomURL: normalized,
+	}, nil
+}
+
+// withBreadcrumbs returns a page with the trail of pages leading to it.
+func (cu *CustomPageUseCase) withBreadcrumbs(ctx context.Context, page *entity.CustomPage) (*dto.CustomPageResponseDTO, error) {
+	ancestors, err := cu.customPageRepo.GetAncestors(ctx, page.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := customPageResponse(page)
+	result.Breadcrumbs = make([]dto.PageBreadcrumbDTO, 0, len(ancestors)+1)
+
+	for _, ancestor := range append(ancestors, *page) {
+		result.Breadcrumbs = append(result.Breadcrumbs, dto.PageBreadcrumbDTO{
+			ID:        ancestor.ID,
+			CustomURL: ancestor.CustomURL,
+		})
+	}
+
+	return result, nil
+}
+
+func customPageResponse(page *entity.CustomPage) *dto.CustomPageResponseDTO {
+	return &dto.CustomPageResponseDTO{
+		ID:        page.ID,
+		ParentID:  page.ParentID,
+		Position:  page.Position,
+		Slug:      page.Slug,
+		CustomURL: page.CustomURL,
+		Content:   page.Content,
+		AuthorID:  page.AuthorID,
+		CreatedAt: page.CreatedAt,
+		UpdatedAt: page.UpdatedAt,
+
+		ContentFormat: page.ContentFormat,
+		ContentHTML:   page.ContentHTML,
+		ContentText:   page.ContentText,
+
+		Locale:           page.Locale,
+		TranslationGroup: page.TranslationGroup,
+	}
+}
+
+// normalizeSitePath normalizes a custom URL or redirect source for storage and
+// checks that it fits the column and stays clear of the application's own
+// routes.
+func normalizeSitePath(raw string) (string, error) {
+	normalized, err := urlpath.Normalize(raw)
+	if err != nil {
+		return "", err
+	}
+
+	if len(normalized) > maxSitePathLength {
+		return "", apperror.ErrPathTooLong
+	}
+
+	for _, reserved := range reservedPaths {
+		if normalized == reserved || strings.HasPrefix(normalized, reserved+"/") {
+			return "", apperror.ErrReservedPath
+		}
+	}
+
+	return normalized, nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -19,6 +20,9 @@ const (
 	testPageCustomURL    = "/about-us"
 	testPageCustomURLNew = "/about-company"
 	testPageContent      = "Updated content"
+	testParentPageID     = "550e8400-e29b-41d4-a716-446655440002"
+	testChildPageID      = "550e8400-e29b-41d4-a716-446655440003"
+	testGrandchildPageID = "550e8400-e29b-41d4-a716-446655440004"
 )
 
 // MockCustomPageRepo is a mock implementation of repository.CustomPageRepo.
@@ -54,6 +58,20 @@ func (m *MockCustomPageRepo) GetByID(ctx context.Context, id string) (*entity.Cu
 	return result, args.Error(1)
 }
 
+func (m *MockCustomPageRepo) GetByURL(ctx context.Context, customURL string) (*entity.CustomPage, error) {
+	args := m.Called(ctx, customURL)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.CustomPage)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
 func (m *MockCustomPageRepo) GetAll(ctx context.Context) ([]entity.CustomPage, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -68,6 +86,40 @@ func (m *MockCustomPageRepo) GetAll(ctx context.Context) ([]entity.CustomPage, e
 	return result, args.Error(1)
 }
 
+func (m *MockCustomPageRepo) GetChildren(ctx context.Context, parentID string) ([]entity.CustomPage, error) {
+	args := m.Called(ctx, parentID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.CustomPage)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCustomPageRepo) GetAncestors(ctx context.Context, id string) ([]entity.CustomPage, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.CustomPage)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockCustomPageRepo) Move(ctx context.Context, page *entity.CustomPage, moves []entity.PageMove) error {
+	args := m.Called(ctx, page, moves)
+
+	return args.Error(0)
+}
+
 func (m *MockCustomPageRepo) Update(ctx context.Context, page *entity.CustomPage) error {
 	args := m.Called(ctx, page)
 
@@ -83,7 +135,7 @@ func (m *MockCustomPageRepo) Delete(ctx context.Context, id string) error {
 func TestCustomPageUseCase_Create(t *testing.T) {
 	t.Run("success - create custom page", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 		req := &dto.CreateCustomPageRequestDTO{
@@ -117,9 +169,135 @@ func TestCustomPageUseCase_Create(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("success - content is sanitized unless the author keeps raw HTML", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+		content := `<p>About</p><script>track()</script>`
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(page *entity.CustomPage) bool {
+			return page.AuthorID == testPageAuthorID && page.Content == "<p>About</p>"
+		})).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURL}, nil).Once()
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(page *entity.CustomPage) bool {
+			return page.AuthorID == testRawAuthorID && page.Content == content
+		})).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURL}, nil).Once()
+
+		_, err := useCase.Create(ctx, testPageAuthorID, &dto.CreateCustomPageRequestDTO{CustomURL: testPageCustomURL, Content: content})
+		assert.NoError(t, err)
+
+		_, err = useCase.Create(ctx, testRawAuthorID, &dto.CreateCustomPageRequestDTO{CustomURL: testPageCustomURL, Content: content})
+		assert.NoError(t, err)
+
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - custom url is normalized", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+		req := &dto.CreateCustomPageRequestDTO{
+			CustomURL: "About-Us/",
+			Content:   "This is the about us page content",
+		}
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(page *entity.CustomPage) bool {
+			return page.CustomURL == testPageCustomURL
+		})).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURL}, nil)
+
+		result, err := useCase.Create(ctx, testPageAuthorID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testPageCustomURL, result.CustomURL)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid custom url", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		req := &dto.CreateCustomPageRequestDTO{
+			CustomURL: "/about%zz",
+			Content:   "This is the about us page content",
+		}
+
+		result, err := useCase.Create(context.Background(), testPageAuthorID, req)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidPath)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - reserved custom url", func(t *testing.T) {
+		for _, customURL := range []string{"/api", "/API/v1/news", "/swagger/index.html", "/healthz/", "/sitemap.xml", "/sitemaps/news-1.xml", "/news", "/News/local-election", "/categories/sports"} {
+			mockRepo := new(MockCustomPageRepo)
+			useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+			req := &dto.CreateCustomPageRequestDTO{
+				CustomURL: customURL,
+				Content:   "This is the about us page content",
+			}
+
+			result, err := useCase.Create(context.Background(), testPageAuthorID, req)
+
+			assert.ErrorIs(t, err, apperror.ErrReservedPath, customURL)
+			assert.Nil(t, result)
+			mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("success - custom url only sharing a reserved prefix", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+		req := &dto.CreateCustomPageRequestDTO{
+			CustomURL: "/newsletter",
+			Content:   "This is the about us page content",
+		}
+
+		mockRepo.On("Create", ctx, mock.Anything).Return(&entity.CustomPage{ID: testPageID, CustomURL: "/newsletter"}, nil)
+
+		_, err := useCase.Create(ctx, testPageAuthorID, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - custom url too long once normalized", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		req := &dto.CreateCustomPageRequestDTO{
+			CustomURL: "/" + strings.Repeat("ü", 30),
+			Content:   "This is the about us page content",
+		}
+
+		result, err := useCase.Create(context.Background(), testPageAuthorID, req)
+
+		assert.ErrorIs(t, err, apperror.ErrPathTooLong)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - custom url with query string", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		req := &dto.CreateCustomPageRequestDTO{
+			CustomURL: "/about-us?lang=en",
+			Content:   "This is the about us page content",
+		}
+
+		result, err := useCase.Create(context.Background(), testPageAuthorID, req)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidPath)
+		assert.Nil(t, result)
+	})
+
 	t.Run("error - repository create fails", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 		req := &dto.CreateCustomPageRequestDTO{
@@ -141,7 +319,7 @@ func TestCustomPageUseCase_Create(t *testing.T) {
 func TestCustomPageUseCase_GetByID(t *testing.T) {
 	t.Run("success - get custom page by id", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 
@@ -156,8 +334,9 @@ func TestCustomPageUseCase_GetByID(t *testing.T) {
 		}
 
 		mockRepo.On("GetByID", ctx, testPageID).Return(expectedPage, nil)
+		mockRepo.On("GetAncestors", ctx, testPageID).Return([]entity.CustomPage{}, nil)
 
-		result, err := useCase.GetByID(ctx, testPageID)
+		result, err := useCase.GetByID(ctx, testPageID, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -168,14 +347,14 @@ func TestCustomPageUseCase_GetByID(t *testing.T) {
 
 	t.Run("error - custom page not found", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 		pageID := nonExistentPageID
 
 		mockRepo.On("GetByID", ctx, pageID).Return(nil, apperror.ErrNotFound)
 
-		result, err := useCase.GetByID(ctx, pageID)
+		result, err := useCase.GetByID(ctx, pageID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -185,13 +364,13 @@ func TestCustomPageUseCase_GetByID(t *testing.T) {
 
 	t.Run("error - repository get fails", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 
 		mockRepo.On("GetByID", ctx, testPageID).Return(nil, apperror.ErrDatabaseConnection)
 
-		result, err := useCase.GetByID(ctx, testPageID)
+		result, err := useCase.GetByID(ctx, testPageID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -200,10 +379,78 @@ func TestCustomPageUseCase_GetByID(t *testing.T) {
 	})
 }
 
+func TestCustomPageUseCase_GetByURL(t *testing.T) {
+	t.Run("success - path is normalized before lookup", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByURL", ctx, testPageCustomURL).Return(&entity.CustomPage{
+			ID:        testPageID,
+			CustomURL: testPageCustomURL,
+			Content:   "This is the about us page content",
+			AuthorID:  testPageAuthorID,
+		}, nil)
+		mockRepo.On("GetAncestors", ctx, testPageID).Return([]entity.CustomPage{}, nil)
+
+		result, err := useCase.GetByURL(ctx, "/About-%55s/", nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testPageID, result.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - translation at its own URL", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		translationRepo := new(MockTranslationRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, NewLocalizer(translationRepo, testLocaleConfig))
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByURL", ctx, "/elections").Return(&entity.CustomPage{ID: testEnglishID, CustomURL: "/elections", Locale: "en"}, nil)
+		mockRepo.On("GetByID", ctx, testIndonesianID).Return(&entity.CustomPage{ID: testIndonesianID, CustomURL: "/pemilu", Locale: "id"}, nil)
+		mockRepo.On("GetAncestors", ctx, testIndonesianID).Return([]entity.CustomPage{}, nil)
+		translationRepo.On("GetGroup", ctx, entity.TranslationPage, testEnglishID).Return(testVariants(), nil)
+
+		result, err := useCase.GetByURL(ctx, "/elections", []string{"id"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/pemilu", result.CustomURL)
+		assert.Equal(t, "id", result.Locale)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - custom page not found", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByURL", ctx, "/missing").Return(nil, apperror.ErrNotFound)
+
+		result, err := useCase.GetByURL(ctx, "/missing", nil)
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - empty path", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		result, err := useCase.GetByURL(context.Background(), "", nil)
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidPath)
+		assert.Nil(t, result)
+	})
+}
+
 func TestCustomPageUseCase_GetAll(t *testing.T) {
 	t.Run("success - get all custom pages", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 
@@ -229,7 +476,7 @@ func TestCustomPageUseCase_GetAll(t *testing.T) {
 
 		mockRepo.On("GetAll", ctx).Return(pageList, nil)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -241,7 +488,7 @@ func TestCustomPageUseCase_GetAll(t *testing.T) {
 
 	t.Run("success - get all custom pages empty result", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 
@@ -249,7 +496,7 @@ func TestCustomPageUseCase_GetAll(t *testing.T) {
 
 		mockRepo.On("GetAll", ctx).Return(pageList, nil)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -259,13 +506,13 @@ func TestCustomPageUseCase_GetAll(t *testing.T) {
 
 	t.Run("error - repository getall fails", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 
 		mockRepo.On("GetAll", ctx).Return(nil, apperror.ErrDatabaseConnection)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -277,7 +524,7 @@ func TestCustomPageUseCase_GetAll(t *testing.T) {
 func TestCustomPageUseCase_Update(t *testing.T) {
 	t.Run("success - update custom page", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 		req := &dto.UpdateCustomPageRequestDTO{
@@ -285,21 +532,107 @@ func TestCustomPageUseCase_Update(t *testing.T) {
 			Content:   testPageContent,
 		}
 
+		mockRepo.On("GetByID", ctx, testPageID).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURLNew}, nil)
 		mockRepo.On("Update", ctx, mock.MatchedBy(func(page *entity.CustomPage) bool {
 			return page.ID == testPageID &&
 				page.CustomURL == testPageCustomURLNew &&
 				page.Content == testPageContent
 		})).Return(nil)
 
-		err := useCase.Update(ctx, testPageID, req)
+		err := useCase.Update(ctx, testPageAuthorID, testPageID, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - custom url is normalized", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+		req := &dto.UpdateCustomPageRequestDTO{
+			CustomURL: "//ABOUT-company//",
+			Content:   testPageContent,
+		}
+
+		mockRepo.On("GetByID", ctx, testPageID).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURLNew}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(page *entity.CustomPage) bool {
+			return page.CustomURL == testPageCustomURLNew
+		})).Return(nil)
+
+		err := useCase.Update(ctx, testPageAuthorID, testPageID, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - moved page leaves a redirect", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+		req := &dto.UpdateCustomPageRequestDTO{
+			CustomURL: testPageCustomURLNew,
+			Content:   testPageContent,
+		}
+
+		mockRepo.On("GetByID", ctx, testPageID).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURL}, nil)
+		mockRepo.On("GetChildren", ctx, testPageID).Return([]entity.CustomPage{}, nil)
+		mockRepo.On("Move", ctx, mock.Anything, []entity.PageMove{
+			{ID: testPageID, From: testPageCustomURL, To: testPageCustomURLNew},
+		}).Return(nil)
+
+		err := useCase.Update(ctx, testPageAuthorID, testPageID, req)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - failed move leaves the media references alone", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		mediaRepo := new(MockMediaRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), mediaRepo, testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+		req := &dto.UpdateCustomPageRequestDTO{
+			CustomURL: testPageCustomURLNew,
+			Content:   testPageContent,
+		}
+
+		mockRepo.On("GetByID", ctx, testPageID).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURL}, nil)
+		mockRepo.On("GetChildren", ctx, testPageID).Return([]entity.CustomPage{}, nil)
+		mockRepo.On("Move", ctx, mock.Anything, mock.Anything).Return(apperror.ErrDuplicateKey)
+
+		err := useCase.Update(ctx, testPageAuthorID, testPageID, req)
+
+		assert.Equal(t, apperror.ErrDuplicateKey, err)
+		mediaRepo.AssertNotCalled(t, "SetReferences", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - unchanged url leaves redirects alone", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		redirectRepo := new(MockRedirectRepo)
+		useCase := NewCustomPageUseCase(mockRepo, redirectRepo, noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+		req := &dto.UpdateCustomPageRequestDTO{
+			CustomURL: testPageCustomURL,
+			Content:   testPageContent,
+		}
+
+		mockRepo.On("GetByID", ctx, testPageID).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURL}, nil)
+		mockRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+		err := useCase.Update(ctx, testPageAuthorID, testPageID, req)
+
+		assert.NoError(t, err)
+		redirectRepo.AssertNotCalled(t, "Upsert", mock.Anything, mock.Anything)
 	})
 
 	t.Run("error - custom page not found", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 		pageID := nonExistentPageID
@@ -308,9 +641,9 @@ func TestCustomPageUseCase_Update(t *testing.T) {
 			Content:   testPageContent,
 		}
 
-		mockRepo.On("Update", ctx, mock.Anything).Return(apperror.ErrNotFound)
+		mockRepo.On("GetByID", ctx, pageID).Return(nil, apperror.ErrNotFound)
 
-		err := useCase.Update(ctx, pageID, req)
+		err := useCase.Update(ctx, testPageAuthorID, pageID, req)
 
 		assert.Error(t, err)
 		assert.Equal(t, apperror.ErrNotFound, err)
@@ -319,7 +652,7 @@ func TestCustomPageUseCase_Update(t *testing.T) {
 
 	t.Run("error - repository update fails", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 		req := &dto.UpdateCustomPageRequestDTO{
@@ -327,9 +660,10 @@ func TestCustomPageUseCase_Update(t *testing.T) {
 			Content:   testPageContent,
 		}
 
+		mockRepo.On("GetByID", ctx, testPageID).Return(&entity.CustomPage{ID: testPageID, CustomURL: testPageCustomURLNew}, nil)
 		mockRepo.On("Update", ctx, mock.Anything).Return(apperror.ErrDatabaseConnection)
 
-		err := useCase.Update(ctx, testPageID, req)
+		err := useCase.Update(ctx, testPageAuthorID, testPageID, req)
 
 		assert.Error(t, err)
 		assert.Equal(t, apperror.ErrDatabaseConnection, err)
@@ -337,10 +671,178 @@ func TestCustomPageUseCase_Update(t *testing.T) {
 	})
 }
 
+func TestCustomPageUseCase_Hierarchy(t *testing.T) {
+	t.Run("success - child url derived from parent and slug", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testParentPageID).Return(&entity.CustomPage{ID: testParentPageID, CustomURL: "/help"}, nil)
+		mockRepo.On("Create", ctx, &entity.CustomPage{
+			ParentID:  testParentPageID,
+			Position:  1,
+			Slug:      "billing",
+			CustomURL: "/help/billing",
+			Content:   testPageContent,
+			AuthorID:  testPageAuthorID,
+
+			ContentFormat: ContentFormatHTML,
+			ContentHTML:   testPageContent,
+			ContentText:   testPageContent,
+
+			Locale: "en",
+		}).Return(&entity.CustomPage{ID: testChildPageID, ParentID: testParentPageID, CustomURL: "/help/billing"}, nil)
+
+		result, err := useCase.Create(ctx, testPageAuthorID, &dto.CreateCustomPageRequestDTO{
+			ParentID: testParentPageID,
+			Position: 1,
+			Slug:     " Billing/ ",
+			Content:  testPageContent,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/help/billing", result.CustomURL)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - slug with several segments", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		result, err := useCase.Create(context.Background(), testPageAuthorID, &dto.CreateCustomPageRequestDTO{
+			Slug:    "help/billing",
+			Content: testPageContent,
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidSlug)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - parent not found", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testParentPageID).Return(nil, apperror.ErrNotFound)
+
+		result, err := useCase.Create(ctx, testPageAuthorID, &dto.CreateCustomPageRequestDTO{
+			ParentID: testParentPageID,
+			Slug:     "billing",
+			Content:  testPageContent,
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidParentPage)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - page moved below its own descendant", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testParentPageID).Return(&entity.CustomPage{ID: testParentPageID, CustomURL: "/help"}, nil)
+		mockRepo.On("GetByID", ctx, testChildPageID).Return(&entity.CustomPage{ID: testChildPageID, CustomURL: "/help/billing"}, nil)
+		mockRepo.On("GetAncestors", ctx, testChildPageID).Return([]entity.CustomPage{{ID: testParentPageID}}, nil)
+
+		err := useCase.Update(ctx, testPageAuthorID, testParentPageID, &dto.UpdateCustomPageRequestDTO{
+			ParentID: testChildPageID,
+			Slug:     "help",
+			Content:  testPageContent,
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidParentPage)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - moving a parent re-paths derived descendants", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testParentPageID).Return(&entity.CustomPage{ID: testParentPageID, CustomURL: "/help"}, nil)
+		mockRepo.On("GetChildren", ctx, testParentPageID).Return([]entity.CustomPage{
+			{ID: testChildPageID, ParentID: testParentPageID, Slug: "billing", CustomURL: "/help/billing"},
+			{ID: testPageID, ParentID: testParentPageID, CustomURL: "/pricing"},
+		}, nil)
+		mockRepo.On("GetChildren", ctx, testChildPageID).Return([]entity.CustomPage{
+			{ID: testGrandchildPageID, ParentID: testChildPageID, Slug: "refunds", CustomURL: "/help/billing/refunds"},
+		}, nil)
+		mockRepo.On("GetChildren", ctx, testGrandchildPageID).Return([]entity.CustomPage{}, nil)
+		mockRepo.On("Move", ctx, mock.Anything, []entity.PageMove{
+			{ID: testParentPageID, From: "/help", To: "/support"},
+			{ID: testChildPageID, From: "/help/billing", To: "/support/billing"},
+			{ID: testGrandchildPageID, From: "/help/billing/refunds", To: "/support/billing/refunds"},
+		}).Return(nil)
+
+		err := useCase.Update(ctx, testPageAuthorID, testParentPageID, &dto.UpdateCustomPageRequestDTO{
+			CustomURL: "/support",
+			Content:   testPageContent,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - breadcrumbs run from the root", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testGrandchildPageID).
+			Return(&entity.CustomPage{ID: testGrandchildPageID, CustomURL: "/help/billing/refunds"}, nil)
+		mockRepo.On("GetAncestors", ctx, testGrandchildPageID).Return([]entity.CustomPage{
+			{ID: testParentPageID, CustomURL: "/help"},
+			{ID: testChildPageID, CustomURL: "/help/billing"},
+		}, nil)
+
+		result, err := useCase.GetByID(ctx, testGrandchildPageID, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.PageBreadcrumbDTO{
+			{ID: testParentPageID, CustomURL: "/help"},
+			{ID: testChildPageID, CustomURL: "/help/billing"},
+			{ID: testGrandchildPageID, CustomURL: "/help/billing/refunds"},
+		}, result.Breadcrumbs)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - tree ordered by position then url", func(t *testing.T) {
+		mockRepo := new(MockCustomPageRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
+
+		ctx := context.Background()
+
+		mockRepo.On("GetAll", ctx).Return([]entity.CustomPage{
+			{ID: testChildPageID, ParentID: testParentPageID, Position: 1, CustomURL: "/help/billing"},
+			{ID: testGrandchildPageID, ParentID: testParentPageID, Position: 0, CustomURL: "/help/start"},
+			{ID: testPageID, CustomURL: "/about-us"},
+			{ID: testParentPageID, CustomURL: "/help"},
+		}, nil)
+
+		result, err := useCase.GetTree(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "/about-us", result[0].CustomURL)
+		assert.Equal(t, "/help", result[1].CustomURL)
+		assert.Equal(t, "/help/start", result[1].Children[0].CustomURL)
+		assert.Equal(t, "/help/billing", result[1].Children[1].CustomURL)
+		assert.Empty(t, result[0].Children)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestCustomPageUseCase_Delete(t *testing.T) {
 	t.Run("success - delete custom page", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 
@@ -354,7 +856,7 @@ func TestCustomPageUseCase_Delete(t *testing.T) {
 
 	t.Run("error - custom page not found", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 		pageID := nonExistentPageID
@@ -370,7 +872,7 @@ func TestCustomPageUseCase_Delete(t *testing.T) {
 
 	t.Run("error - repository delete fails", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		ctx := context.Background()
 
@@ -388,7 +890,7 @@ func TestNewCustomPageUseCase(t *testing.T) {
 	t.Run("success - create new custom page usecase", func(t *testing.T) {
 		mockRepo := new(MockCustomPageRepo)
 
-		useCase := NewCustomPageUseCase(mockRepo)
+		useCase := NewCustomPageUseCase(mockRepo, noRedirectsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer())
 
 		assert.NotNil(t, useCase)
 		assert.NotNil(t, useCase.customPageRepo)
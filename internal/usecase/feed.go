@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+)
+
+type FeedUseCase struct {
+	newsRepo     repository.NewsRepo
+	categoryRepo repository.CategoryRepo
+	cfg          config.Feed
+	siteCfg      config.Site
+	newsCfg      config.News
+}
+
+func NewFeedUseCase(
+	newsRepo repository.NewsRepo,
+	categoryRepo repository.CategoryRepo,
+	cfg config.Feed,
+	siteCfg config.Site,
+	newsCfg config.News,
+) *FeedUseCase {
+	return &FeedUseCase{
+		newsRepo:     newsRepo,
+		categoryRepo: categoryRepo,
+		cfg:          cfg,
+		siteCfg:      siteCfg,
+		newsCfg:      newsCfg,
+	}
+}
+
+// News builds the feed of the latest news or, when categoryID is set, of
+// the latest news of that category.
+func (fu *FeedUseCase) News(ctx context.Context, categoryID string) (*dto.FeedDTO, error) {
+	siteURL := strings.TrimSuffix(fu.siteCfg.URL, "/")
+
+	result := &dto.FeedDTO{
+		Title:       fu.cfg.Title,
+		Description: fu.cfg.Description,
+		Link:        siteURL + "/",
+		URL:         siteURL + "/feeds/news",
+	}
+
+	if categoryID != "" {
+		category, err := fu.categoryRepo.GetByID(ctx, categoryID)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Title += ": " + category.Name
+		result.Link = strings.ReplaceAll(fu.siteCfg.CategoryURL, "{id}", category.ID)
+		result.URL = siteURL + "/feeds/categories/" + category.ID
+	}
+
+	return fu.withItems(ctx, result, categoryID, "")
+}
+
+// Tag builds the feed of the latest news tagged with tag. A tag no article
+// has gives an empty feed.
+func (fu *FeedUseCase) Tag(ctx context.Context, tag string) (*dto.FeedDTO, error) {
+	siteURL := strings.TrimSuffix(fu.siteCfg.URL, "/")
+	tag = strings.ToLower(strings.TrimSpace(tag))
+
+	result := &dto.FeedDTO{
+		Title:       fu.cfg.Title + ": " + tag,
+		Description: fu.cfg.Description,
+		Link:        siteURL + "/",
+		URL:         siteURL + "/feeds/tags/" + url.PathEscape(tag),
+	}
+
+	return fu.withItems(ctx, result, "", tag)
+}
+
+// withItems adds the latest news of the category and tag, when set, to the
+// feed and dates it by the last update among them.
+func (fu *FeedUseCase) withItems(ctx context.Context, result *dto.FeedDTO, categoryID, tag string) (*dto.FeedDTO, error) {
+	newsList, err := fu.newsRepo.GetLatest(ctx, categoryID, tag, fu.cfg.ItemCount)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Items = make([]dto.FeedItemDTO, 0, len(newsList))
+
+	for i := range newsList {
+		news := &newsList[i]
+
+		item := dto.FeedItemDTO{
+			ID:        "urn:uuid:" + news.ID,
+			Title:     news.Title,
+			Link:      strings.ReplaceAll(fu.siteCfg.NewsURL, "{id}", news.ID),
+			Summary:   news.Excerpt,
+			Published: news.CreatedAt,
+			Updated:   news.UpdatedAt,
+		}
+
+		if item.Summary == "" && fu.newsCfg.ExcerptLength > 0 {
+			item.Summary = excerpt(news.ContentText, fu.newsCfg.ExcerptLength)
+		}
+
+		if fu.cfg.FullContent {
+			item.Content = news.ContentHTML
+		}
+
+		if item.Updated.After(result.Updated) {
+			result.Updated = item.Updated
+		}
+
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	testFeedConfig = config.Feed{
+		Title:       "News",
+		Description: "The latest news",
+		ItemCount:   10,
+	}
+	testSiteConfig = config.Site{
+		URL:         "https://example.com/",
+		NewsURL:     "https://example.com/news/{id}",
+		CategoryURL: "https://example.com/categories/{id}",
+	}
+)
+
+func TestFeedUseCase_News(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	newsList := []entity.News{
+		{
+			ID: testNewsID, Title: "First", Excerpt: "Written excerpt",
+			ContentHTML: "<p>First</p>", CreatedAt: older, UpdatedAt: newer,
+		},
+		{
+			ID: "550e8400-e29b-41d4-a716-446655440009", Title: "Second",
+			ContentHTML: "<p>Second</p>", ContentText: "Second body", CreatedAt: older, UpdatedAt: older,
+		},
+	}
+
+	t.Run("success - site feed with summaries", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		mockCategoryRepo := new(MockCategoryRepo)
+		useCase := NewFeedUseCase(mockNewsRepo, mockCategoryRepo, testFeedConfig, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockNewsRepo.On("GetLatest", ctx, "", "", 10).Return(newsList, nil)
+
+		result, err := useCase.News(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "News", result.Title)
+		assert.Equal(t, "https://example.com/", result.Link)
+		assert.Equal(t, "https://example.com/feeds/news", result.URL)
+		assert.Equal(t, newer, result.Updated)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, "urn:uuid:"+testNewsID, result.Items[0].ID)
+		assert.Equal(t, "https://example.com/news/"+testNewsID, result.Items[0].Link)
+		assert.Equal(t, "Written excerpt", result.Items[0].Summary)
+		assert.Equal(t, "Second body", result.Items[1].Summary)
+		assert.Empty(t, result.Items[0].Content)
+		mockNewsRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertNotCalled(t, "GetByID")
+	})
+
+	t.Run("success - category feed with full content", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		mockCategoryRepo := new(MockCategoryRepo)
+		cfg := testFeedConfig
+		cfg.FullContent = true
+		useCase := NewFeedUseCase(mockNewsRepo, mockCategoryRepo, cfg, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockCategoryRepo.On("GetByID", ctx, testNewsCategoryID).
+			Return(&entity.Category{ID: testNewsCategoryID, Name: "Tech"}, nil)
+		mockNewsRepo.On("GetLatest", ctx, testNewsCategoryID, "", 10).Return(newsList[:1], nil)
+
+		result, err := useCase.News(ctx, testNewsCategoryID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "News: Tech", result.Title)
+		assert.Equal(t, "https://example.com/categories/"+testNewsCategoryID, result.Link)
+		assert.Equal(t, "https://example.com/feeds/categories/"+testNewsCategoryID, result.URL)
+		assert.Equal(t, "<p>First</p>", result.Items[0].Content)
+		mockNewsRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - empty feed", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		useCase := NewFeedUseCase(mockNewsRepo, new(MockCategoryRepo), testFeedConfig, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockNewsRepo.On("GetLatest", ctx, "", "", 10).Return([]entity.News{}, nil)
+
+		result, err := useCase.News(ctx, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		assert.True(t, result.Updated.IsZero())
+		mockNewsRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - category not found", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		mockCategoryRepo := new(MockCategoryRepo)
+		useCase := NewFeedUseCase(mockNewsRepo, mockCategoryRepo, testFeedConfig, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockCategoryRepo.On("GetByID", ctx, testNewsCategoryID).Return(nil, apperror.ErrNotFound)
+
+		result, err := useCase.News(ctx, testNewsCategoryID)
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		assert.Nil(t, result)
+		mockNewsRepo.AssertNotCalled(t, "GetLatest")
+	})
+
+	t.Run("error - repository failure", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		useCase := NewFeedUseCase(mockNewsRepo, new(MockCategoryRepo), testFeedConfig, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockNewsRepo.On("GetLatest", ctx, "", "", 10).Return(nil, apperror.ErrDatabaseConnection)
+
+		result, err := useCase.News(ctx, "")
+
+		assert.ErrorIs(t, err, apperror.ErrDatabaseConnection)
+		assert.Nil(t, result)
+	})
+}
+
+func TestFeedUseCase_Tag(t *testing.T) {
+	updated := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success - tag feed", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		mockCategoryRepo := new(MockCategoryRepo)
+		useCase := NewFeedUseCase(mockNewsRepo, mockCategoryRepo, testFeedConfig, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockNewsRepo.On("GetLatest", ctx, "", "machine learning", 10).
+			Return([]entity.News{{ID: testNewsID, Title: "First", CreatedAt: updated, UpdatedAt: updated}}, nil)
+
+		result, err := useCase.Tag(ctx, " Machine Learning ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "News: machine learning", result.Title)
+		assert.Equal(t, "https://example.com/", result.Link)
+		assert.Equal(t, "https://example.com/feeds/tags/machine%20learning", result.URL)
+		assert.Equal(t, updated, result.Updated)
+		assert.Len(t, result.Items, 1)
+		mockNewsRepo.AssertExpectations(t)
+		mockCategoryRepo.AssertNotCalled(t, "GetByID")
+	})
+
+	t.Run("success - unknown tag gives an empty feed", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		useCase := NewFeedUseCase(mockNewsRepo, new(MockCategoryRepo), testFeedConfig, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockNewsRepo.On("GetLatest", ctx, "", "unknown", 10).Return([]entity.News{}, nil)
+
+		result, err := useCase.Tag(ctx, "unknown")
+
+		assert.NoError(t, err)
+		assert.Empty(t, result.Items)
+		mockNewsRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - repository failure", func(t *testing.T) {
+		mockNewsRepo := new(MockNewsRepo)
+		useCase := NewFeedUseCase(mockNewsRepo, new(MockCategoryRepo), testFeedConfig, testSiteConfig, testNewsConfig)
+
+		ctx := context.Background()
+
+		mockNewsRepo.On("GetLatest", ctx, "", "go", 10).Return(nil, apperror.ErrDatabaseConnection)
+
+		result, err := useCase.Tag(ctx, "go")
+
+		assert.ErrorIs(t, err, apperror.ErrDatabaseConnection)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,512 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/imaging"
+)
+
+// maxMediaFilenameLength matches the media.filename column.
+const maxMediaFilenameLength = 255
+
+// mediaExtensions are the file extensions of stored media by MIME type.
+// Files of other allowed types are stored with a .bin extension.
+var mediaExtensions = map[string]string{
+	"image/jpeg":      "jpg",
+	"image/png":       "png",
+	"image/gif":       "gif",
+	"image/webp":      "webp",
+	"image/bmp":       "bmp",
+	"application/pdf": "pdf",
+	"audio/mpeg":      "mp3",
+	"audio/wave":      "wav",
+	"video/mp4":       "mp4",
+	"video/webm":      "webm",
+}
+
+// resizableTypes are the image types sizes are derived from, by the format
+// they decode as.
+var resizableTypes = map[string]string{
+	"image/jpeg": imaging.FormatJPEG,
+	"image/png":  imaging.FormatPNG,
+	"image/gif":  imaging.FormatGIF,
+}
+
+// defaultFocalPoint centers crops.
+const defaultFocalPoint = 0.5
+
+// mediaKeyPattern finds the storage keys of media linked from content: a
+// SHA-256 hex digest and an extension at the end of a URL path.
+var mediaKeyPattern = regexp.MustCompile(`/([0-9a-f]{64}\.[a-z0-9]+)\b`)
+
+type MediaUseCase struct {
+	mediaRepo repository.MediaRepo
+	storage   repository.Storage
+	sizes     []imaging.Size
+	cfg       config.Media
+}
+
+// NewMediaUseCase fails when the configured image sizes are invalid.
+func NewMediaUseCase(mediaRepo repository.MediaRepo, storage repository.Storage, cfg config.Media) (*MediaUseCase, error) {
+	sizes, err := imaging.ParseSizes(cfg.Sizes)
+	if err != nil {
+		return nil, fmt.Errorf("usecase - NewMediaUseCase - imaging.ParseSizes: %w", err)
+	}
+
+	return &MediaUseCase{
+		mediaRepo: mediaRepo,
+		storage:   storage,
+		sizes:     sizes,
+		cfg:       cfg,
+	}, nil
+}
+
+// Upload adds a file to the media library. Its type is sniffed from its
+// content rather than trusted from the client, and metadata such as camera
+// details and locations is stripped from JPEG images. Uploading a file that
+// is already in the library returns the existing media, and created is
+// false.
+func (mu *MediaUseCase) Upload(ctx context.Context, uploaderID string, req *dto.UploadMediaRequestDTO) (*dto.MediaResponseDTO, bool, error) {
+	if int64(len(req.Data)) > mu.cfg.MaxSize {
+		return nil, false, apperror.ErrMediaTooLarge
+	}
+
+	mimeType, _, err := mime.ParseMediaType(http.DetectContentType(req.Data))
+	if err != nil || !slices.Contains(mu.cfg.AllowedTypes, mimeType) {
+		return nil, false, apperror.ErrUnsupportedMedia
+	}
+
+	data, width, height, err := mu.prepareImage(req.Data, mimeType)
+	if err != nil {
+		return nil, false, err
+	}
+
+	key := mediaKey(data, mimeType)
+
+	existing, err := mu.mediaRepo.GetByKey(ctx, key)
+	if err == nil {
+		return mu.mediaResponse(existing), false, nil
+	}
+
+	if !errors.Is(err, apperror.ErrNotFound) {
+		return nil, false, err
+	}
+
+	if err := mu.storage.Put(ctx, key, data, mimeType); err != nil {
+		return nil, false, err
+	}
+
+	media := &entity.Media{
+		StorageKey: key,
+		Filename:   mediaFilename(req.Filename),
+		MIMEType:   mimeType,
+		Size:       int64(len(data)),
+		Width:      width,
+		Height:     height,
+		AltText:    req.AltText,
+		FocalX:     defaultFocalPoint,
+		FocalY:     defaultFocalPoint,
+		UploaderID: uploaderID,
+	}
+
+	saved, err := mu.mediaRepo.Create(ctx, media)
+	if errors.Is(err, apperror.ErrDuplicateKey) {
+		// The same file was uploaded concurrently and stored under the same key
+		saved, err = mu.mediaRepo.GetByKey(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return mu.mediaResponse(saved), false, nil
+	}
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if mu.cfg.DerivativesOnUpload {
+		if err := mu.deriveAll(ctx, saved, data); err != nil {
+			return nil, false, err
+		}
+	}
+
+	return mu.mediaResponse(saved), true, nil
+}
+
+// prepareImage strips the metadata of JPEG and PNG images and returns the upright
+// dimensions of images sizes can be derived from. Such images must decode
+// and stay within the configured number of pixels. Other files are returned
+// as they are.
+func (mu *MediaUseCase) prepareImage(data []byte, mimeType string) (prepared []byte, width, height int, err error) {
+	format, ok := resizableTypes[mimeType]
+	if !ok {
+		return data, 0, 0, nil
+	}
+
+	switch format {
+	case imaging.FormatJPEG:
+		data, err = imaging.StripMetadata(data)
+	case imaging.FormatPNG:
+		data, err = imaging.StripPNGMetadata(data)
+	}
+
+	if err != nil {
+		return nil, 0, 0, apperror.ErrUnsupportedMedia
+	}
+
+	if _, width, height, err = imaging.DecodeConfig(data); err != nil {
+		return nil, 0, 0, apperror.ErrUnsupportedMedia
+	}
+
+	if width*height > mu.cfg.MaxPixels {
+		return nil, 0, 0, apperror.ErrMediaTooLarge
+	}
+
+	return data, width, height, nil
+}
+
+// GetByID returns a media file with the content referencing it.
+func (mu *MediaUseCase) GetByID(ctx context.Context, id string) (*dto.MediaResponseDTO, error) {
+	media, err := mu.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	references, err := mu.mediaRepo.GetReferences(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mu.mediaResponse(media)
+	result.References = make([]dto.MediaReferenceDTO, 0, len(references))
+
+	for _, reference := range references {
+		result.References = append(result.References, dto.MediaReferenceDTO{
+			Type: reference.OwnerType,
+			ID:   reference.OwnerID,
+		})
+	}
+
+	return result, nil
+}
+
+func (mu *MediaUseCase) GetAll(ctx context.Context) ([]dto.MediaResponseDTO, error) {
+	mediaList, err := mu.mediaRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.MediaResponseDTO, 0, len(mediaList))
+
+	for i := range mediaList {
+		result = append(result, *mu.mediaResponse(&mediaList[i]))
+	}
+
+	return result, nil
+}
+
+// Update saves the alt text and focal point of a media file. Moving the
+// focal point drops the cropped sizes derived for the old one.
+func (mu *MediaUseCase) Update(ctx context.Context, id string, req *dto.UpdateMediaRequestDTO) error {
+	current, err := mu.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	media := *current
+	media.AltText, media.FocalX, media.FocalY = req.AltText, req.FocalX, req.FocalY
+
+	if err := mu.mediaRepo.Update(ctx, &media); err != nil {
+		return err
+	}
+
+	if media.FocalX == current.FocalX && media.FocalY == current.FocalY {
+		return nil
+	}
+
+	for _, size := range mu.sizes {
+		if size.Crop() {
+			if err := mu.deleteDerivative(ctx, current, size); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Delete removes a media file and its derived sizes from the library and
+// from storage. Files still embedded in news or custom pages fail with
+// apperror.ErrMediaInUse.
+func (mu *MediaUseCase) Delete(ctx context.Context, id string) error {
+	media, err := mu.mediaRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := mu.mediaRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	for _, size := range mu.sizes {
+		if err := mu.deleteDerivative(ctx, media, size); err != nil {
+			return err
+		}
+	}
+
+	return mu.storage.Delete(ctx, media.StorageKey)
+}
+
+// Open reads the media file stored under key or, when sizeName is set, that
+// size of it. Sizes are derived on first request and then kept in storage.
+// Unknown sizes, and sizes of files that are not resizable images, are not
+// found.
+func (mu *MediaUseCase) Open(ctx context.Context, key, sizeName string) (*dto.MediaFileDTO, error) {
+	media, err := mu.mediaRepo.GetByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if sizeName == "" {
+		content, err := mu.storage.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return &dto.MediaFileDTO{
+			MIMEType: media.MIMEType,
+			Size:     media.Size,
+			Content:  content,
+		}, nil
+	}
+
+	format, ok := resizableTypes[media.MIMEType]
+	index := slices.IndexFunc(mu.sizes, func(size imaging.Size) bool { return size.Name == sizeName })
+
+	if !ok || index < 0 || media.Width == 0 {
+		return nil, apperror.ErrNotFound
+	}
+
+	size := mu.sizes[index]
+	mimeType := "image/" + imaging.DerivedFormat(format)
+
+	content, err := mu.storage.Get(ctx, derivativeKey(media, size))
+	if err == nil {
+		return &dto.MediaFileDTO{MIMEType: mimeType, Size: -1, Content: content}, nil
+	}
+
+	if !errors.Is(err, apperror.ErrNotFound) {
+		return nil, err
+	}
+
+	original, err := mu.readFile(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	derived, err := mu.derive(ctx, media, original, size)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MediaFileDTO{
+		MIMEType: mimeType,
+		Size:     int64(len(derived)),
+		Content:  io.NopCloser(bytes.NewReader(derived)),
+	}, nil
+}
+
+func (mu *MediaUseCase) readFile(ctx context.Context, key string) ([]byte, error) {
+	content, err := mu.storage.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	return io.ReadAll(content)
+}
+
+// deriveAll derives and stores every size of an image.
+func (mu *MediaUseCase) deriveAll(ctx context.Context, media *entity.Media, original []byte) error {
+	if _, ok := resizableTypes[media.MIMEType]; !ok {
+		return nil
+	}
+
+	for _, size := range mu.sizes {
+		if _, err := mu.derive(ctx, media, original, size); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// derive scales the original image to size and stores the result.
+func (mu *MediaUseCase) derive(ctx context.Context, media *entity.Media, original []byte, size imaging.Size) ([]byte, error) {
+	img, format, err := imaging.Decode(original)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	derivedFormat := imaging.DerivedFormat(format)
+
+	err = imaging.Encode(&buf, imaging.Derive(img, size, media.FocalX, media.FocalY), derivedFormat, mu.cfg.JPEGQuality)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mu.storage.Put(ctx, derivativeKey(media, size), buf.Bytes(), "image/"+derivedFormat); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (mu *MediaUseCase) deleteDerivative(ctx context.Context, media *entity.Media, size imaging.Size) error {
+	if _, ok := resizableTypes[media.MIMEType]; !ok || media.Width == 0 {
+		return nil
+	}
+
+	return mu.storage.Delete(ctx, derivativeKey(media, size))
+}
+
+func (mu *MediaUseCase) mediaResponse(media *entity.Media) *dto.MediaResponseDTO {
+	url := mediaURL(mu.cfg, media.StorageKey)
+
+	result := &dto.MediaResponseDTO{
+		ID:             media.ID,
+		URL:            url,
+		Filename:       media.Filename,
+		MIMEType:       media.MIMEType,
+		Size:           media.Size,
+		Width:          media.Width,
+		Height:         media.Height,
+		AltText:        media.AltText,
+		FocalX:         media.FocalX,
+		FocalY:         media.FocalY,
+		UploaderID:     media.UploaderID,
+		ReferenceCount: media.ReferenceCount,
+		CreatedAt:      media.CreatedAt,
+		UpdatedAt:      media.UpdatedAt,
+	}
+
+	if _, ok := resizableTypes[media.MIMEType]; !ok || media.Width == 0 {
+		return result
+	}
+
+	result.Sizes = make(map[string]dto.MediaSizeDTO, len(mu.sizes))
+
+	// The srcset lists the original and every size keeping its aspect ratio,
+	// one per width
+	widths := map[int]string{media.Width: url}
+
+	for _, size := range mu.sizes {
+		width, height := size.Fit(media.Width, media.Height)
+		sizeURL := url + "/" + size.Name
+
+		result.Sizes[size.Name] = dto.MediaSizeDTO{URL: sizeURL, Width: width, Height: height}
+
+		if _, seen := widths[width]; !seen && !size.Crop() {
+			widths[width] = sizeURL
+		}
+	}
+
+	candidates := make([]int, 0, len(widths))
+	for width := range widths {
+		candidates = append(candidates, width)
+	}
+
+	sort.Ints(candidates)
+
+	srcset := make([]string, 0, len(candidates))
+	for _, width := range candidates {
+		srcset = append(srcset, widths[width]+" "+strconv.Itoa(width)+"w")
+	}
+
+	result.SrcSet = strings.Join(srcset, ", ")
+
+	return result
+}
+
+// mediaKey derives the storage key of a file from its content.
+func mediaKey(data []byte, mimeType string) string {
+	ext, ok := mediaExtensions[mimeType]
+	if !ok {
+		ext = "bin"
+	}
+
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]) + "." + ext
+}
+
+// mediaURL is the public URL of the media file stored under key.
+func mediaURL(cfg config.Media, key string) string {
+	return strings.TrimSuffix(cfg.PublicURL, "/") + "/" + key
+}
+
+// derivativeKey is the storage key of a size of an image, derived from its
+// dimensions and, for cropped sizes, the focal point, so that changing
+// either leads to a new file, e.g. "9f86…0a08-150x150-500-500.jpeg".
+func derivativeKey(media *entity.Media, size imaging.Size) string {
+	width, height := size.Fit(media.Width, media.Height)
+	key := strings.TrimSuffix(media.StorageKey, path.Ext(media.StorageKey)) +
+		"-" + strconv.Itoa(width) + "x" + strconv.Itoa(height)
+
+	if size.Crop() {
+		key += "-" + strconv.Itoa(int(math.Round(media.FocalX*1000))) + "-" + strconv.Itoa(int(math.Round(media.FocalY*1000)))
+	}
+
+	return key + "." + imaging.DerivedFormat(resizableTypes[media.MIMEType])
+}
+
+// mediaFilename keeps the last path element of an uploaded file's name,
+// shortened to fit the media.filename column.
+func mediaFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+
+	if runes := []rune(name); len(runes) > maxMediaFilenameLength {
+		name = string(runes[:maxMediaFilenameLength])
+	}
+
+	return name
+}
+
+// mediaKeys lists the storage keys of the media linked from rendered
+// content, each once.
+func mediaKeys(html string) []string {
+	keys := []string{}
+
+	for _, match := range mediaKeyPattern.FindAllStringSubmatch(html, -1) {
+		if !slices.Contains(keys, match[1]) {
+			keys = append(keys, match[1])
+		}
+	}
+
+	return keys
+}
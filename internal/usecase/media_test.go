@@ -0,0 +1,716 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testMediaID         = "550e8400-e29b-41d4-a716-446655440030"
+	testMediaUploaderID = "550e8400-e29b-41d4-a716-446655440031"
+)
+
+var testMediaConfig = config.Media{
+	PublicURL:    "/media",
+	MaxSize:      1024,
+	AllowedTypes: []string{"image/png", "image/jpeg", "application/pdf"},
+	Sizes:        []string{"thumbnail:2x2", "small:4"},
+	JPEGQuality:  85,
+	MaxPixels:    100,
+}
+
+// MockMediaRepo is a mock implementation of repository.MediaRepo.
+type MockMediaRepo struct {
+	mock.Mock
+}
+
+func (m *MockMediaRepo) Create(ctx context.Context, media *entity.Media) (*entity.Media, error) {
+	args := m.Called(ctx, media)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Media)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMediaRepo) GetByID(ctx context.Context, id string) (*entity.Media, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Media)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMediaRepo) GetByKey(ctx context.Context, storageKey string) (*entity.Media, error) {
+	args := m.Called(ctx, storageKey)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Media)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMediaRepo) GetAll(ctx context.Context) ([]entity.Media, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Media)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMediaRepo) Update(ctx context.Context, media *entity.Media) error {
+	args := m.Called(ctx, media)
+
+	return args.Error(0)
+}
+
+func (m *MockMediaRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockMediaRepo) GetReferences(ctx context.Context, id string) ([]entity.MediaReference, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.MediaReference)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMediaRepo) SetReferences(ctx context.Context, ownerType, ownerID string, storageKeys []string) error {
+	args := m.Called(ctx, ownerType, ownerID, storageKeys)
+
+	return args.Error(0)
+}
+
+// noMediaRepo returns a media repository that accepts the references kept
+// for saved news and custom pages.
+func noMediaRepo() *MockMediaRepo {
+	mediaRepo := new(MockMediaRepo)
+	mediaRepo.On("SetReferences", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return mediaRepo
+}
+
+// MockStorage is a mock implementation of repository.Storage.
+type MockStorage struct {
+	mock.Mock
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	args := m.Called(ctx, key, data, contentType)
+
+	return args.Error(0)
+}
+
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(io.ReadCloser)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+
+	return args.Error(0)
+}
+
+// newTestMediaUseCase builds a media use case with testMediaConfig.
+func newTestMediaUseCase(t *testing.T, mediaRepo *MockMediaRepo, storage *MockStorage) *MediaUseCase {
+	t.Helper()
+
+	useCase, err := NewMediaUseCase(mediaRepo, storage, testMediaConfig)
+	require.NoError(t, err)
+
+	return useCase
+}
+
+// testPNG encodes a width by height PNG image.
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+
+	return buf.Bytes()
+}
+
+// testJPEG encodes a width by height JPEG image carrying a comment.
+func testJPEG(t *testing.T, width, height int, comment string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height)), nil))
+
+	// Insert a COM segment right after SOI
+	data := buf.Bytes()
+	segment := append([]byte{0xFF, 0xFE, 0, byte(len(comment) + 2)}, comment...)
+
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+// testPNGWithText encodes a width by height PNG image carrying a tEXt chunk.
+func testPNGWithText(t *testing.T, width, height int, text string) []byte {
+	t.Helper()
+
+	data := testPNG(t, width, height)
+
+	// Insert the chunk right after IHDR, which follows the 8 byte signature
+	body := append([]byte("tEXt"), "Comment\x00"+text...)
+	chunk := binary.BigEndian.AppendUint32(nil, uint32(len(body)-4))
+	chunk = append(chunk, body...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(body))
+
+	const ihdrEnd = 8 + 25
+
+	return append(append(append([]byte{}, data[:ihdrEnd]...), chunk...), data[ihdrEnd:]...)
+}
+
+func TestMediaUseCase_Upload(t *testing.T) {
+	t.Run("success - stores a new image with its dimensions", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		data := testPNG(t, 3, 2)
+		key := mediaKey(data, "image/png")
+
+		mockRepo.On("GetByKey", ctx, key).Return(nil, apperror.ErrNotFound)
+		mockStorage.On("Put", ctx, key, data, "image/png").Return(nil)
+		mockRepo.On("Create", ctx, &entity.Media{
+			StorageKey: key,
+			Filename:   "photo.png",
+			MIMEType:   "image/png",
+			Size:       int64(len(data)),
+			Width:      3,
+			Height:     2,
+			AltText:    "A photo",
+			FocalX:     0.5,
+			FocalY:     0.5,
+			UploaderID: testMediaUploaderID,
+		}).Return(&entity.Media{ID: testMediaID, StorageKey: key, MIMEType: "image/png"}, nil)
+
+		result, created, err := useCase.Upload(ctx, testMediaUploaderID, &dto.UploadMediaRequestDTO{
+			Filename: `C:\Users\me\photo.png`,
+			AltText:  "A photo",
+			Data:     data,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, testMediaID, result.ID)
+		assert.Equal(t, "/media/"+key, result.URL)
+		assert.True(t, strings.HasSuffix(key, ".png"))
+		mockRepo.AssertExpectations(t)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("success - returns the media already holding the same file", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		data := []byte("%PDF-1.4 document")
+		key := mediaKey(data, "application/pdf")
+
+		mockRepo.On("GetByKey", ctx, key).Return(&entity.Media{ID: testMediaID, StorageKey: key}, nil)
+
+		result, created, err := useCase.Upload(ctx, testMediaUploaderID, &dto.UploadMediaRequestDTO{Data: data})
+
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, testMediaID, result.ID)
+		mockStorage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - concurrent upload of the same file", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		data := []byte("%PDF-1.4 document")
+		key := mediaKey(data, "application/pdf")
+
+		mockRepo.On("GetByKey", ctx, key).Return(nil, apperror.ErrNotFound).Once()
+		mockStorage.On("Put", ctx, key, data, "application/pdf").Return(nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(nil, apperror.ErrDuplicateKey)
+		mockRepo.On("GetByKey", ctx, key).Return(&entity.Media{ID: testMediaID, StorageKey: key}, nil).Once()
+
+		result, created, err := useCase.Upload(ctx, testMediaUploaderID, &dto.UploadMediaRequestDTO{Data: data})
+
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.Equal(t, testMediaID, result.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - strips the metadata of JPEG images", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		data := testJPEG(t, 4, 2, "secret location")
+
+		var stored []byte
+
+		mockRepo.On("GetByKey", ctx, mock.Anything).Return(nil, apperror.ErrNotFound)
+		mockStorage.On("Put", ctx, mock.Anything, mock.Anything, "image/jpeg").
+			Run(func(args mock.Arguments) {
+				stored, _ = args.Get(2).([]byte)
+			}).
+			Return(nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(&entity.Media{ID: testMediaID}, nil)
+
+		_, created, err := useCase.Upload(ctx, testMediaUploaderID, &dto.UploadMediaRequestDTO{Data: data})
+
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.NotContains(t, string(stored), "secret location")
+
+		media, ok := mockRepo.Calls[1].Arguments.Get(1).(*entity.Media)
+		require.True(t, ok)
+		assert.Equal(t, mediaKey(stored, "image/jpeg"), media.StorageKey)
+		assert.Equal(t, int64(len(stored)), media.Size)
+	})
+
+	t.Run("success - strips the metadata of PNG images", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		data := testPNGWithText(t, 4, 2, "secret location")
+
+		var stored []byte
+
+		mockRepo.On("GetByKey", ctx, mock.Anything).Return(nil, apperror.ErrNotFound)
+		mockStorage.On("Put", ctx, mock.Anything, mock.Anything, "image/png").
+			Run(func(args mock.Arguments) {
+				stored, _ = args.Get(2).([]byte)
+			}).
+			Return(nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(&entity.Media{ID: testMediaID}, nil)
+
+		_, created, err := useCase.Upload(ctx, testMediaUploaderID, &dto.UploadMediaRequestDTO{Data: data})
+
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Contains(t, string(data), "secret location")
+		assert.NotContains(t, string(stored), "secret location")
+		assert.Equal(t, testPNG(t, 4, 2), stored)
+	})
+
+	t.Run("success - derives every size on upload when configured", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+
+		cfg := testMediaConfig
+		cfg.DerivativesOnUpload = true
+
+		useCase, err := NewMediaUseCase(mockRepo, mockStorage, cfg)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		data := testPNG(t, 8, 4)
+		key := mediaKey(data, "image/png")
+		hash := strings.TrimSuffix(key, ".png")
+		media := &entity.Media{ID: testMediaID, StorageKey: key, MIMEType: "image/png", Width: 8, Height: 4, FocalX: 0.5, FocalY: 0.5}
+
+		mockRepo.On("GetByKey", ctx, key).Return(nil, apperror.ErrNotFound)
+		mockStorage.On("Put", ctx, key, data, "image/png").Return(nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(media, nil)
+		mockStorage.On("Put", ctx, hash+"-2x2-500-500.png", mock.Anything, "image/png").Return(nil)
+		mockStorage.On("Put", ctx, hash+"-4x2.png", mock.Anything, "image/png").Return(nil)
+
+		_, _, err = useCase.Upload(ctx, testMediaUploaderID, &dto.UploadMediaRequestDTO{Data: data})
+
+		assert.NoError(t, err)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("error - image has too many pixels", func(t *testing.T) {
+		useCase := newTestMediaUseCase(t, new(MockMediaRepo), new(MockStorage))
+
+		result, _, err := useCase.Upload(context.Background(), testMediaUploaderID, &dto.UploadMediaRequestDTO{
+			Data: testPNG(t, 11, 10),
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrMediaTooLarge)
+	})
+
+	t.Run("error - file too large", func(t *testing.T) {
+		useCase := newTestMediaUseCase(t, new(MockMediaRepo), new(MockStorage))
+
+		result, _, err := useCase.Upload(context.Background(), testMediaUploaderID, &dto.UploadMediaRequestDTO{
+			Data: make([]byte, testMediaConfig.MaxSize+1),
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrMediaTooLarge)
+	})
+
+	t.Run("error - type sniffed from the content is not allowed", func(t *testing.T) {
+		useCase := newTestMediaUseCase(t, new(MockMediaRepo), new(MockStorage))
+
+		result, _, err := useCase.Upload(context.Background(), testMediaUploaderID, &dto.UploadMediaRequestDTO{
+			Filename: "photo.png",
+			Data:     []byte("<html><script>alert(1)</script></html>"),
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrUnsupportedMedia)
+	})
+}
+
+func TestMediaUseCase_GetByID(t *testing.T) {
+	t.Run("success - media with its references", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		useCase := newTestMediaUseCase(t, mockRepo, new(MockStorage))
+
+		ctx := context.Background()
+		now := time.Now()
+
+		mockRepo.On("GetByID", ctx, testMediaID).Return(&entity.Media{
+			ID: testMediaID, StorageKey: "abcd.png", ReferenceCount: 1, CreatedAt: now, UpdatedAt: now,
+		}, nil)
+		mockRepo.On("GetReferences", ctx, testMediaID).Return([]entity.MediaReference{
+			{OwnerType: entity.MediaOwnerNews, OwnerID: testNewsID},
+		}, nil)
+
+		result, err := useCase.GetByID(ctx, testMediaID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/media/abcd.png", result.URL)
+		assert.Equal(t, []dto.MediaReferenceDTO{{Type: entity.MediaOwnerNews, ID: testNewsID}}, result.References)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - image with its sizes and srcset", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		useCase := newTestMediaUseCase(t, mockRepo, new(MockStorage))
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testMediaID).Return(&entity.Media{
+			ID: testMediaID, StorageKey: "abcd.png", MIMEType: "image/png", Width: 8, Height: 6,
+		}, nil)
+		mockRepo.On("GetReferences", ctx, testMediaID).Return([]entity.MediaReference{}, nil)
+
+		result, err := useCase.GetByID(ctx, testMediaID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]dto.MediaSizeDTO{
+			"thumbnail": {URL: "/media/abcd.png/thumbnail", Width: 2, Height: 2},
+			"small":     {URL: "/media/abcd.png/small", Width: 4, Height: 3},
+		}, result.Sizes)
+		assert.Equal(t, "/media/abcd.png/small 4w, /media/abcd.png 8w", result.SrcSet)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		useCase := newTestMediaUseCase(t, mockRepo, new(MockStorage))
+
+		mockRepo.On("GetByID", mock.Anything, testMediaID).Return(nil, apperror.ErrNotFound)
+
+		result, err := useCase.GetByID(context.Background(), testMediaID)
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+}
+
+func TestMediaUseCase_Update(t *testing.T) {
+	media := &entity.Media{
+		ID: testMediaID, StorageKey: "abcd.png", MIMEType: "image/png", Width: 8, Height: 6, FocalX: 0.5, FocalY: 0.5,
+	}
+
+	t.Run("success - moving the focal point drops cropped sizes", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testMediaID).Return(media, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(updated *entity.Media) bool {
+			return updated.AltText == "A photo" && updated.FocalX == 0.2 && updated.FocalY == 0.5
+		})).Return(nil)
+		mockStorage.On("Delete", ctx, "abcd-2x2-500-500.png").Return(nil)
+
+		err := useCase.Update(ctx, testMediaID, &dto.UpdateMediaRequestDTO{AltText: "A photo", FocalX: 0.2, FocalY: 0.5})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0.5, media.FocalX)
+		mockRepo.AssertExpectations(t)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("success - same focal point keeps sizes", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testMediaID).Return(media, nil)
+		mockRepo.On("Update", ctx, mock.Anything).Return(nil)
+
+		err := useCase.Update(ctx, testMediaID, &dto.UpdateMediaRequestDTO{AltText: "A photo", FocalX: 0.5, FocalY: 0.5})
+
+		assert.NoError(t, err)
+		mockStorage.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - not found", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		useCase := newTestMediaUseCase(t, mockRepo, new(MockStorage))
+
+		mockRepo.On("GetByID", mock.Anything, testMediaID).Return(nil, apperror.ErrNotFound)
+
+		err := useCase.Update(context.Background(), testMediaID, &dto.UpdateMediaRequestDTO{FocalX: 0.5, FocalY: 0.5})
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMediaUseCase_Delete(t *testing.T) {
+	t.Run("success - removes the sizes derived from an image", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testMediaID).Return(&entity.Media{
+			ID: testMediaID, StorageKey: "abcd.png", MIMEType: "image/png", Width: 8, Height: 6, FocalX: 0.5, FocalY: 0.5,
+		}, nil)
+		mockRepo.On("Delete", ctx, testMediaID).Return(nil)
+		mockStorage.On("Delete", ctx, "abcd-2x2-500-500.png").Return(nil)
+		mockStorage.On("Delete", ctx, "abcd-4x3.png").Return(nil)
+		mockStorage.On("Delete", ctx, "abcd.png").Return(nil)
+
+		err := useCase.Delete(ctx, testMediaID)
+
+		assert.NoError(t, err)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("success - removes the row and the file", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testMediaID).Return(&entity.Media{ID: testMediaID, StorageKey: "abcd.png"}, nil)
+		mockRepo.On("Delete", ctx, testMediaID).Return(nil)
+		mockStorage.On("Delete", ctx, "abcd.png").Return(nil)
+
+		err := useCase.Delete(ctx, testMediaID)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("error - media in use keeps its file", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testMediaID).Return(&entity.Media{ID: testMediaID, StorageKey: "abcd.png"}, nil)
+		mockRepo.On("Delete", ctx, testMediaID).Return(apperror.ErrMediaInUse)
+
+		err := useCase.Delete(ctx, testMediaID)
+
+		assert.ErrorIs(t, err, apperror.ErrMediaInUse)
+		mockStorage.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMediaUseCase_Open(t *testing.T) {
+	t.Run("success - file with its stored type", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		content := io.NopCloser(strings.NewReader("image"))
+
+		mockRepo.On("GetByKey", ctx, "abcd.png").Return(&entity.Media{MIMEType: "image/png", Size: 5}, nil)
+		mockStorage.On("Get", ctx, "abcd.png").Return(content, nil)
+
+		result, err := useCase.Open(ctx, "abcd.png", "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.MediaFileDTO{MIMEType: "image/png", Size: 5, Content: content}, result)
+	})
+
+	t.Run("success - derives a size on first request", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		media := &entity.Media{StorageKey: "abcd.png", MIMEType: "image/png", Width: 8, Height: 6, FocalX: 0.5, FocalY: 0.5}
+
+		mockRepo.On("GetByKey", ctx, "abcd.png").Return(media, nil)
+		mockStorage.On("Get", ctx, "abcd-2x2-500-500.png").Return(nil, apperror.ErrNotFound)
+		mockStorage.On("Get", ctx, "abcd.png").Return(io.NopCloser(bytes.NewReader(testPNG(t, 8, 6))), nil)
+		mockStorage.On("Put", ctx, "abcd-2x2-500-500.png", mock.Anything, "image/png").Return(nil)
+
+		result, err := useCase.Open(ctx, "abcd.png", "thumbnail")
+
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", result.MIMEType)
+
+		img, _, err := image.Decode(result.Content)
+		require.NoError(t, err)
+		assert.Equal(t, image.Rect(0, 0, 2, 2), img.Bounds())
+		mockStorage.AssertExpectations(t)
+	})
+
+	t.Run("success - size already derived", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		mockStorage := new(MockStorage)
+		useCase := newTestMediaUseCase(t, mockRepo, mockStorage)
+
+		ctx := context.Background()
+		content := io.NopCloser(strings.NewReader("image"))
+
+		mockRepo.On("GetByKey", ctx, "abcd.jpg").Return(&entity.Media{
+			StorageKey: "abcd.jpg", MIMEType: "image/jpeg", Width: 8, Height: 6,
+		}, nil)
+		mockStorage.On("Get", ctx, "abcd-4x3.jpeg").Return(content, nil)
+
+		result, err := useCase.Open(ctx, "abcd.jpg", "small")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &dto.MediaFileDTO{MIMEType: "image/jpeg", Size: -1, Content: content}, result)
+		mockStorage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - unknown size", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		useCase := newTestMediaUseCase(t, mockRepo, new(MockStorage))
+
+		mockRepo.On("GetByKey", mock.Anything, "abcd.png").Return(&entity.Media{
+			StorageKey: "abcd.png", MIMEType: "image/png", Width: 8, Height: 6,
+		}, nil)
+
+		result, err := useCase.Open(context.Background(), "abcd.png", "huge")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+
+	t.Run("error - size of a file that is not an image", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		useCase := newTestMediaUseCase(t, mockRepo, new(MockStorage))
+
+		mockRepo.On("GetByKey", mock.Anything, "abcd.pdf").Return(&entity.Media{
+			StorageKey: "abcd.pdf", MIMEType: "application/pdf",
+		}, nil)
+
+		result, err := useCase.Open(context.Background(), "abcd.pdf", "small")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+
+	t.Run("error - unknown key", func(t *testing.T) {
+		mockRepo := new(MockMediaRepo)
+		useCase := newTestMediaUseCase(t, mockRepo, new(MockStorage))
+
+		mockRepo.On("GetByKey", mock.Anything, "abcd.png").Return(nil, apperror.ErrNotFound)
+
+		result, err := useCase.Open(context.Background(), "abcd.png", "")
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+	})
+}
+
+func TestNewMediaUseCase(t *testing.T) {
+	cfg := testMediaConfig
+	cfg.Sizes = []string{"thumbnail:150x"}
+
+	useCase, err := NewMediaUseCase(new(MockMediaRepo), new(MockStorage), cfg)
+
+	assert.Nil(t, useCase)
+	assert.Error(t, err)
+}
+
+func TestMediaKeys(t *testing.T) {
+	key := strings.Repeat("ab", 32) + ".png"
+	other := strings.Repeat("cd", 32) + ".pdf"
+
+	html := `<p><img src="/media/` + key + `" alt=""><a href="https://cdn.example.com/media/` + other + `">PDF</a>` +
+		`<img src="/media/` + key + `"><a href="/media/` + strings.Repeat("ab", 31) + `.png">short</a></p>`
+
+	assert.Equal(t, []string{key, other}, mediaKeys(html))
+	assert.Empty(t, mediaKeys("<p>No media</p>"))
+}
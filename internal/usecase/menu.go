@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+)
+
+const (
+	// maxMenuDepth is how many levels of nested items a menu may have.
+	maxMenuDepth = 3
+	// maxMenuTitleLength matches the menu_items.title column.
+	maxMenuTitleLength = 150
+)
+
+// menuNamePattern allows names such as "main" or "footer-links".
+var menuNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+type MenuUseCase struct {
+	menuRepo repository.MenuRepo
+	cfg      config.Menu
+}
+
+func NewMenuUseCase(menuRepo repository.MenuRepo, cfg config.Menu) *MenuUseCase {
+	return &MenuUseCase{
+		menuRepo: menuRepo,
+		cfg:      cfg,
+	}
+}
+
+func (mu *MenuUseCase) GetAll(ctx context.Context) ([]dto.MenuSummaryDTO, error) {
+	menus, err := mu.menuRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.MenuSummaryDTO, 0, len(menus))
+
+	for _, menu := range menus {
+		result = append(result, dto.MenuSummaryDTO{
+			ID:        menu.ID,
+			Name:      menu.Name,
+			CreatedAt: menu.CreatedAt,
+			UpdatedAt: menu.UpdatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// GetByName returns a menu with its items nested and resolved into titles and
+// hrefs.
+func (mu *MenuUseCase) GetByName(ctx context.Context, name string) (*dto.MenuResponseDTO, error) {
+	menu, err := mu.menuRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MenuResponseDTO{
+		ID:        menu.ID,
+		Name:      menu.Name,
+		Items:     mu.buildTree(menu.Items),
+		CreatedAt: menu.CreatedAt,
+		UpdatedAt: menu.UpdatedAt,
+	}, nil
+}
+
+// Save creates the named menu or replaces all of its items.
+func (mu *MenuUseCase) Save(ctx context.Context, name string, req *dto.SaveMenuRequestDTO) (*dto.MenuResponseDTO, error) {
+	if !menuNamePattern.MatchString(name) {
+		return nil, apperror.ErrInvalidMenuName
+	}
+
+	items, err := menuItems(req.Items, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	menu, err := mu.menuRepo.Save(ctx, &entity.Menu{Name: name, Items: items})
+	if err != nil {
+		return nil, err
+	}
+
+	return mu.GetByName(ctx, menu.Name)
+}
+
+func (mu *MenuUseCase) Delete(ctx context.Context, name string) error {
+	return mu.menuRepo.Delete(ctx, name)
+}
+
+// menuItems validates submitted items at the given depth, keeping only the
+// fields that apply to each item type.
+func menuItems(reqs []dto.MenuItemRequestDTO, depth int) ([]entity.MenuItem, error) {
+	if len(reqs) > 0 && depth > maxMenuDepth {
+		return nil, apperror.ErrMenuTooDeep
+	}
+
+	items := make([]entity.MenuItem, 0, len(reqs))
+
+	for _, req := range reqs {
+		item := entity.MenuItem{
+			Type:  req.Type,
+			Title: strings.TrimSpace(req.Title),
+		}
+
+		if utf8.RuneCountInString(item.Title) > maxMenuTitleLength {
+			return nil, apperror.ErrInvalidMenuItem
+		}
+
+		switch req.Type {
+		case entity.MenuItemPage, entity.MenuItemCategory, entity.MenuItemNews:
+			if req.TargetID == "" {
+				return nil, apperror.ErrInvalidMenuItem
+			}
+
+			item.TargetID = req.TargetID
+		case entity.MenuItemURL:
+			link, err := normalizeLink(req.URL)
+			if err != nil || item.Title == "" {
+				return nil, apperror.ErrInvalidMenuItem
+			}
+
+			item.URL = link
+		default:
+			return nil, apperror.ErrInvalidMenuItem
+		}
+
+		children, err := menuItems(req.Children, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		item.Children = children
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// buildTree nests items loaded in position order under their parents.
+func (mu *MenuUseCase) buildTree(items []entity.MenuItem) []dto.MenuItemResponseDTO {
+	children := make(map[string][]entity.MenuItem)
+
+	for _, item := range items {
+		children[item.ParentID] = append(children[item.ParentID], item)
+	}
+
+	var build func(parentID string) []dto.MenuItemResponseDTO
+
+	build = func(parentID string) []dto.MenuItemResponseDTO {
+		result := make([]dto.MenuItemResponseDTO, 0, len(children[parentID]))
+
+		for i := range children[parentID] {
+			resolved := mu.resolveItem(&children[parentID][i])
+			resolved.Children = build(resolved.ID)
+			result = append(result, resolved)
+		}
+
+		return result
+	}
+
+	return build("")
+}
+
+// resolveItem works out an item's href and, when it has no title of its own,
+// its title from the target. Items whose target no longer exists are marked
+// broken.
+func (mu *MenuUseCase) resolveItem(item *entity.MenuItem) dto.MenuItemResponseDTO {
+	var href, fallbackTitle string
+
+	switch item.Type {
+	case entity.MenuItemPage:
+		href = item.TargetPath
+		fallbackTitle = item.TargetPath
+	case entity.MenuItemCategory:
+		href = strings.ReplaceAll(mu.cfg.CategoryHref, "{id}", item.TargetID)
+		fallbackTitle = item.TargetTitle
+	case entity.MenuItemNews:
+		href = strings.ReplaceAll(mu.cfg.NewsHref, "{id}", item.TargetID)
+		fallbackTitle = item.TargetTitle
+	default:
+		href = item.URL
+	}
+
+	broken := item.Type != entity.MenuItemURL && !item.TargetExists
+	if broken {
+		href = ""
+	}
+
+	title := item.Title
+	if title == "" {
+		title = fallbackTitle
+	}
+
+	return dto.MenuItemResponseDTO{
+		ID:       item.ID,
+		Type:     item.Type,
+		TargetID: item.TargetID,
+		Title:    title,
+		Href:     href,
+		Broken:   broken,
+	}
+}
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testMenuID       = "550e8400-e29b-41d4-a716-446655440020"
+	testMenuItemID   = "550e8400-e29b-41d4-a716-446655440021"
+	testMenuChildID  = "550e8400-e29b-41d4-a716-446655440022"
+	testMenuTargetID = "550e8400-e29b-41d4-a716-446655440023"
+)
+
+var testMenuConfig = config.Menu{CategoryHref: "/categories/{id}", NewsHref: "/news/{id}"}
+
+// MockMenuRepo is a mock implementation of repository.MenuRepo.
+type MockMenuRepo struct {
+	mock.Mock
+}
+
+func (m *MockMenuRepo) GetAll(ctx context.Context) ([]entity.Menu, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Menu)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMenuRepo) GetByName(ctx context.Context, name string) (*entity.Menu, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Menu)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMenuRepo) Save(ctx context.Context, menu *entity.Menu) (*entity.Menu, error) {
+	args := m.Called(ctx, menu)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Menu)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockMenuRepo) Delete(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+
+	return args.Error(0)
+}
+
+func TestMenuUseCase_GetAll(t *testing.T) {
+	t.Run("success - list menus", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		mockRepo.On("GetAll", mock.Anything).Return([]entity.Menu{{ID: testMenuID, Name: "main"}}, nil)
+
+		result, err := uc.GetAll(context.Background())
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "main", result[0].Name)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestMenuUseCase_GetByName(t *testing.T) {
+	t.Run("success - items nested and resolved", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		now := time.Now()
+		mockRepo.On("GetByName", mock.Anything, "main").Return(&entity.Menu{
+			ID:        testMenuID,
+			Name:      "main",
+			CreatedAt: now,
+			UpdatedAt: now,
+			Items: []entity.MenuItem{
+				{ID: testMenuItemID, Type: entity.MenuItemPage, TargetID: testMenuTargetID, TargetExists: true, TargetPath: "/about-us"},
+				{
+					ID: testMenuChildID, ParentID: testMenuItemID, Type: entity.MenuItemCategory,
+					TargetID: testMenuTargetID, TargetExists: true, TargetTitle: "Technology",
+				},
+				{ID: "item-3", Position: 1, Type: entity.MenuItemURL, URL: "https://example.com", Title: "Example"},
+			},
+		}, nil)
+
+		result, err := uc.GetByName(context.Background(), "main")
+
+		assert.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		assert.Equal(t, "/about-us", result.Items[0].Href)
+		assert.Equal(t, "/about-us", result.Items[0].Title)
+		assert.Len(t, result.Items[0].Children, 1)
+		assert.Equal(t, "/categories/"+testMenuTargetID, result.Items[0].Children[0].Href)
+		assert.Equal(t, "Technology", result.Items[0].Children[0].Title)
+		assert.Equal(t, "https://example.com", result.Items[1].Href)
+		assert.Empty(t, result.Items[1].Children)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - deleted target flagged as broken", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		mockRepo.On("GetByName", mock.Anything, "main").Return(&entity.Menu{
+			ID:   testMenuID,
+			Name: "main",
+			Items: []entity.MenuItem{
+				{ID: testMenuItemID, Type: entity.MenuItemNews, TargetID: testMenuTargetID, Title: "Launch"},
+			},
+		}, nil)
+
+		result, err := uc.GetByName(context.Background(), "main")
+
+		assert.NoError(t, err)
+		assert.True(t, result.Items[0].Broken)
+		assert.Empty(t, result.Items[0].Href)
+		assert.Equal(t, "Launch", result.Items[0].Title)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - menu not found", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		mockRepo.On("GetByName", mock.Anything, "missing").Return(nil, apperror.ErrNotFound)
+
+		result, err := uc.GetByName(context.Background(), "missing")
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		assert.Nil(t, result)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestMenuUseCase_Save(t *testing.T) {
+	t.Run("success - items normalized before saving", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		req := &dto.SaveMenuRequestDTO{
+			Items: []dto.MenuItemRequestDTO{
+				{
+					Type: entity.MenuItemPage, TargetID: testMenuTargetID, URL: "/ignored", Title: " About ",
+					Children: []dto.MenuItemRequestDTO{
+						{Type: entity.MenuItemURL, TargetID: testMenuTargetID, URL: "/Contact/", Title: "Contact"},
+					},
+				},
+			},
+		}
+
+		mockRepo.On("Save", mock.Anything, mock.MatchedBy(func(menu *entity.Menu) bool {
+			page := menu.Items[0]
+			link := page.Children[0]
+
+			return menu.Name == "main" && page.URL == "" && page.Title == "About" &&
+				link.TargetID == "" && link.URL == "/contact"
+		})).Return(&entity.Menu{ID: testMenuID, Name: "main"}, nil)
+		mockRepo.On("GetByName", mock.Anything, "main").Return(&entity.Menu{ID: testMenuID, Name: "main"}, nil)
+
+		result, err := uc.Save(context.Background(), "main", req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, testMenuID, result.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid name", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		result, err := uc.Save(context.Background(), "Main Menu", &dto.SaveMenuRequestDTO{})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidMenuName)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - invalid items", func(t *testing.T) {
+		tests := []struct {
+			name string
+			item dto.MenuItemRequestDTO
+		}{
+			{"unknown type", dto.MenuItemRequestDTO{Type: "tag", TargetID: testMenuTargetID}},
+			{"page without target", dto.MenuItemRequestDTO{Type: entity.MenuItemPage}},
+			{"url without title", dto.MenuItemRequestDTO{Type: entity.MenuItemURL, URL: "https://example.com"}},
+			{"url with bad scheme", dto.MenuItemRequestDTO{Type: entity.MenuItemURL, URL: "javascript:alert(1)", Title: "X"}},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockRepo := new(MockMenuRepo)
+				uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+				result, err := uc.Save(context.Background(), "main", &dto.SaveMenuRequestDTO{
+					Items: []dto.MenuItemRequestDTO{tt.item},
+				})
+
+				assert.ErrorIs(t, err, apperror.ErrInvalidMenuItem)
+				assert.Nil(t, result)
+				mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("error - nested too deeply", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		item := dto.MenuItemRequestDTO{Type: entity.MenuItemURL, URL: "/a", Title: "A"}
+		for range maxMenuDepth {
+			item = dto.MenuItemRequestDTO{
+				Type: entity.MenuItemURL, URL: "/a", Title: "A",
+				Children: []dto.MenuItemRequestDTO{item},
+			}
+		}
+
+		result, err := uc.Save(context.Background(), "main", &dto.SaveMenuRequestDTO{
+			Items: []dto.MenuItemRequestDTO{item},
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrMenuTooDeep)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMenuUseCase_Delete(t *testing.T) {
+	t.Run("error - menu not found", func(t *testing.T) {
+		mockRepo := new(MockMenuRepo)
+		uc := NewMenuUseCase(mockRepo, testMenuConfig)
+
+		mockRepo.On("Delete", mock.Anything, "missing").Return(apperror.ErrNotFound)
+
+		err := uc.Delete(context.Background(), "missing")
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -2,100 +2,324 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
 
+	"github.com/RizqiSugiarto/coding-test/config"
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
 )
 
 type NewsUseCase struct {
-	newsRepo repository.NewsRepo
+	newsRepo     repository.NewsRepo
+	reactionRepo repository.NewsReactionRepo
+	mediaRepo    repository.MediaRepo
+	sanitizer    *ContentSanitizer
+	localizer    *Localizer
+	cfg          config.News
+	mediaCfg     config.Media
 }
 
-func NewNewsUseCase(newsRepo repository.NewsRepo) *NewsUseCase {
+func NewNewsUseCase(
+	newsRepo repository.NewsRepo,
+	reactionRepo repository.NewsReactionRepo,
+	mediaRepo repository.MediaRepo,
+	sanitizer *ContentSanitizer,
+	localizer *Localizer,
+	cfg config.News,
+	mediaCfg config.Media,
+) *NewsUseCase {
 	return &NewsUseCase{
-		newsRepo: newsRepo,
+		newsRepo:     newsRepo,
+		reactionRepo: reactionRepo,
+		mediaRepo:    mediaRepo,
+		sanitizer:    sanitizer,
+		localizer:    localizer,
+		cfg:          cfg,
+		mediaCfg:     mediaCfg,
 	}
 }
 
 func (nu *NewsUseCase) Create(ctx context.Context, authorID string, req *dto.CreateNewsRequestDTO) (*dto.NewsResponseDTO, error) {
+	content, err := nu.sanitizer.prepare(authorID, req.ContentFormat, req.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	featuredKey, err := nu.featuredMediaKey(ctx, req.FeaturedMediaID)
+	if err != nil {
+		return nil, err
+	}
+
+	locale, err := nu.localizer.locale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	group, err := nu.localizer.join(ctx, entity.TranslationNews, req.TranslationOf, locale)
+	if err != nil {
+		return nil, err
+	}
+
 	news := &entity.News{
-		CategoryID: req.CategoryID,
-		AuthorID:   authorID,
-		Title:      req.Title,
-		Content:    req.Content,
+		CategoryID:    req.CategoryID,
+		AuthorID:      authorID,
+		Title:         req.Title,
+		Content:       content.Source,
+		ContentFormat: content.Format,
+		ContentHTML:   content.HTML,
+		ContentText:   content.Text,
+
+		CommentsEnabled: commentsEnabled(req.CommentsEnabled),
+		CommentsCloseAt: req.CommentsCloseAt,
+
+		Locale:           locale,
+		TranslationGroup: group,
 	}
 
+	setNewsMetadata(news, &req.NewsMetadataDTO)
+
 	result, err := nu.newsRepo.Create(ctx, news)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.NewsResponseDTO{
-		ID:         result.ID,
-		CategoryID: result.CategoryID,
-		AuthorID:   result.AuthorID,
-		Title:      result.Title,
-		Content:    result.Content,
-		CreatedAt:  result.CreatedAt,
-		UpdatedAt:  result.UpdatedAt,
-	}, nil
+	err = nu.mediaRepo.SetReferences(ctx, entity.MediaOwnerNews, result.ID, newsMediaKeys(result.ContentHTML, featuredKey))
+	if err != nil {
+		return nil, err
+	}
+
+	response := nu.newsResponse(result, nil)
+
+	return &response, nil
 }
 
-func (nu *NewsUseCase) GetByID(ctx context.Context, id string) (*dto.NewsResponseDTO, error) {
+// GetByID returns a news article, or its translation ranked best for a
+// reader preferring locales.
+func (nu *NewsUseCase) GetByID(ctx context.Context, id string, locales []string) (*dto.NewsResponseDTO, error) {
 	news, err := nu.newsRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	return &dto.NewsResponseDTO{
-		ID:         news.ID,
-		CategoryID: news.CategoryID,
-		AuthorID:   news.AuthorID,
-		Title:      news.Title,
-		Content:    news.Content,
-		CreatedAt:  news.CreatedAt,
-		UpdatedAt:  news.UpdatedAt,
-	}, nil
+	best, err := nu.localizer.pick(ctx, entity.TranslationNews, id, nu.localizer.chain(locales))
+	if err != nil {
+		return nil, err
+	}
+
+	if best != id {
+		news, err = nu.newsRepo.GetByID(ctx, best)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reactions, err := nu.reactionRepo.CountByNewsIDs(ctx, []string{news.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	response := nu.newsResponse(news, reactions[news.ID])
+
+	return &response, nil
 }
 
-func (nu *NewsUseCase) GetAll(ctx context.Context) ([]dto.NewsResponseDTO, error) {
+// GetAll lists the news, newest first, one translation of each for a reader
+// preferring locales, or all of them when locales is empty.
+func (nu *NewsUseCase) GetAll(ctx context.Context, locales []string) ([]dto.NewsResponseDTO, error) {
 	newsList, err := nu.newsRepo.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	return nu.newsResponses(ctx, newsList, locales)
+}
+
+// GetByCategory lists the news of a category like GetAll.
+func (nu *NewsUseCase) GetByCategory(ctx context.Context, categoryID string, locales []string) ([]dto.NewsResponseDTO, error) {
+	newsList, err := nu.newsRepo.GetByCategory(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return nu.newsResponses(ctx, newsList, locales)
+}
+
+// newsResponses builds the responses of a list of news, one translation of
+// each for a reader preferring locales, counting the reactions of all of
+// them at once.
+func (nu *NewsUseCase) newsResponses(ctx context.Context, newsList []entity.News, locales []string) ([]dto.NewsResponseDTO, error) {
+	newsList = localize(newsList, nu.localizer.chain(locales), func(n *entity.News) (string, string) {
+		return n.TranslationGroup, n.Locale
+	})
+
+	ids := make([]string, 0, len(newsList))
+	for i := range newsList {
+		ids = append(ids, newsList[i].ID)
+	}
+
+	reactions := map[string]map[string]int{}
+	if len(ids) > 0 {
+		var err error
+
+		reactions, err = nu.reactionRepo.CountByNewsIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	result := make([]dto.NewsResponseDTO, 0, len(newsList))
 
 	for i := range newsList {
-		result = append(result, dto.NewsResponseDTO{
-			ID:         newsList[i].ID,
-			CategoryID: newsList[i].CategoryID,
-			AuthorID:   newsList[i].AuthorID,
-			Title:      newsList[i].Title,
-			Content:    newsList[i].Content,
-			CreatedAt:  newsList[i].CreatedAt,
-			UpdatedAt:  newsList[i].UpdatedAt,
-		})
+		result = append(result, nu.newsResponse(&newsList[i], reactions[newsList[i].ID]))
 	}
 
 	return result, nil
 }
 
-func (nu *NewsUseCase) Update(ctx context.Context, id string, req *dto.UpdateNewsRequestDTO) error {
+func (nu *NewsUseCase) newsResponse(news *entity.News, reactions map[string]int) dto.NewsResponseDTO {
+	result := dto.NewsResponseDTO{
+		ID:            news.ID,
+		CategoryID:    news.CategoryID,
+		AuthorID:      news.AuthorID,
+		Title:         news.Title,
+		Content:       news.Content,
+		ContentFormat: news.ContentFormat,
+		ContentHTML:   news.ContentHTML,
+		ContentText:   news.ContentText,
+		CreatedAt:     news.CreatedAt,
+		UpdatedAt:     news.UpdatedAt,
+
+		CommentsEnabled: news.CommentsEnabled,
+		CommentsCloseAt: news.CommentsCloseAt,
+		Reactions:       nu.reactionCounts(reactions),
+
+		Locale:           news.Locale,
+		TranslationGroup: news.TranslationGroup,
+
+		NewsMetadataDTO: dto.NewsMetadataDTO{
+			FeaturedMediaID: news.FeaturedMediaID,
+			Excerpt:         news.Excerpt,
+			MetaTitle:       news.MetaTitle,
+			MetaDescription: news.MetaDescription,
+			CanonicalURL:    news.CanonicalURL,
+			OGTitle:         news.OGTitle,
+			OGDescription:   news.OGDescription,
+			OGImage:         news.OGImage,
+			NoIndex:         news.NoIndex,
+			Tags:            news.Tags,
+		},
+	}
+
+	if result.Excerpt == "" && nu.cfg.ExcerptLength > 0 {
+		result.Excerpt = excerpt(news.ContentText, nu.cfg.ExcerptLength)
+	}
+
+	if news.FeaturedMediaKey != "" {
+		result.FeaturedImageURL = mediaURL(nu.mediaCfg, news.FeaturedMediaKey)
+	}
+
+	return result
+}
+
+func (nu *NewsUseCase) Update(ctx context.Context, editorID, id string, req *dto.UpdateNewsRequestDTO) error {
+	content, err := nu.sanitizer.prepare(editorID, req.ContentFormat, req.Content)
+	if err != nil {
+		return err
+	}
+
+	featuredKey, err := nu.featuredMediaKey(ctx, req.FeaturedMediaID)
+	if err != nil {
+		return err
+	}
+
+	locale, err := nu.localizer.relocate(ctx, entity.TranslationNews, id, req.Locale)
+	if err != nil {
+		return err
+	}
+
+	enabled, closeAt, err := nu.commentSettings(ctx, id, req)
+	if err != nil {
+		return err
+	}
+
 	news := &entity.News{
-		ID:         id,
-		CategoryID: req.CategoryID,
-		Title:      req.Title,
-		Content:    req.Content,
+		ID:            id,
+		CategoryID:    req.CategoryID,
+		Title:         req.Title,
+		Content:       content.Source,
+		ContentFormat: content.Format,
+		ContentHTML:   content.HTML,
+		ContentText:   content.Text,
+
+		CommentsEnabled: enabled,
+		CommentsCloseAt: closeAt,
+
+		Locale: locale,
 	}
 
-	err := nu.newsRepo.Update(ctx, news)
+	setNewsMetadata(news, &req.NewsMetadataDTO)
+
+	err = nu.newsRepo.Update(ctx, news)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return nu.mediaRepo.SetReferences(ctx, entity.MediaOwnerNews, id, newsMediaKeys(content.HTML, featuredKey))
+}
+
+// commentSettings returns the comment settings of the article with the
+// given ID after an update: those given, and the stored ones for those left
+// out. A cleared closing time counts as given.
+func (nu *NewsUseCase) commentSettings(ctx context.Context, id string, req *dto.UpdateNewsRequestDTO) (bool, *time.Time, error) {
+	closeAtGiven := req.CommentsCloseAt != nil || req.ClearCommentsCloseAt
+
+	if req.CommentsEnabled != nil && closeAtGiven {
+		return *req.CommentsEnabled, req.CommentsCloseAt, nil
+	}
+
+	current, err := nu.newsRepo.GetByID(ctx, id)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if req.CommentsEnabled != nil {
+		current.CommentsEnabled = *req.CommentsEnabled
+	}
+
+	if closeAtGiven {
+		current.CommentsCloseAt = req.CommentsCloseAt
+	}
+
+	return current.CommentsEnabled, current.CommentsCloseAt, nil
+}
+
+// featuredMediaKey returns the storage key of a featured image, which must
+// be an image in the media library, or an empty key when there is none.
+func (nu *NewsUseCase) featuredMediaKey(ctx context.Context, mediaID *string) (string, error) {
+	if mediaID == nil {
+		return "", nil
+	}
+
+	media, err := nu.mediaRepo.GetByID(ctx, *mediaID)
+	if errors.Is(err, apperror.ErrNotFound) {
+		return "", apperror.ErrInvalidFeaturedMedia
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if !strings.HasPrefix(media.MIMEType, "image/") {
+		return "", apperror.ErrInvalidFeaturedMedia
+	}
+
+	return media.StorageKey, nil
 }
 
 func (nu *NewsUseCase) Delete(ctx context.Context, id string) error {
@@ -106,3 +330,125 @@ func (nu *NewsUseCase) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+// React records a reaction of a reader on a news article and returns the
+// updated reaction counts. Each reader counts once per reaction.
+func (nu *NewsUseCase) React(ctx context.Context, newsID, voter, reaction string) (map[string]int, error) {
+	if err := nu.checkReaction(ctx, newsID, reaction); err != nil {
+		return nil, err
+	}
+
+	if err := nu.reactionRepo.Add(ctx, newsID, voter, reaction); err != nil {
+		return nil, err
+	}
+
+	return nu.countReactions(ctx, newsID)
+}
+
+// Unreact withdraws a reaction of a reader and returns the updated reaction
+// counts.
+func (nu *NewsUseCase) Unreact(ctx context.Context, newsID, voter, reaction string) (map[string]int, error) {
+	if err := nu.checkReaction(ctx, newsID, reaction); err != nil {
+		return nil, err
+	}
+
+	if err := nu.reactionRepo.Remove(ctx, newsID, voter, reaction); err != nil {
+		return nil, err
+	}
+
+	return nu.countReactions(ctx, newsID)
+}
+
+// checkReaction ensures the reaction is one of the configured reactions and
+// the news article exists.
+func (nu *NewsUseCase) checkReaction(ctx context.Context, newsID, reaction string) error {
+	if !slices.Contains(nu.cfg.Reactions, reaction) {
+		return apperror.ErrInvalidReaction
+	}
+
+	_, err := nu.newsRepo.GetByID(ctx, newsID)
+
+	return err
+}
+
+func (nu *NewsUseCase) countReactions(ctx context.Context, newsID string) (map[string]int, error) {
+	counts, err := nu.reactionRepo.CountByNewsIDs(ctx, []string{newsID})
+	if err != nil {
+		return nil, err
+	}
+
+	return nu.reactionCounts(counts[newsID]), nil
+}
+
+// reactionCounts lists every configured reaction with its count, dropping
+// reactions that are no longer configured.
+func (nu *NewsUseCase) reactionCounts(counts map[string]int) map[string]int {
+	result := make(map[string]int, len(nu.cfg.Reactions))
+	for _, reaction := range nu.cfg.Reactions {
+		result[reaction] = counts[reaction]
+	}
+
+	return result
+}
+
+func setNewsMetadata(news *entity.News, metadata *dto.NewsMetadataDTO) {
+	news.FeaturedMediaID = metadata.FeaturedMediaID
+	news.Excerpt = metadata.Excerpt
+	news.MetaTitle = metadata.MetaTitle
+	news.MetaDescription = metadata.MetaDescription
+	news.CanonicalURL = metadata.CanonicalURL
+	news.OGTitle = metadata.OGTitle
+	news.OGDescription = metadata.OGDescription
+	news.OGImage = metadata.OGImage
+	news.NoIndex = metadata.NoIndex
+	news.Tags = newsTags(metadata.Tags)
+}
+
+// newsTags lowercases tags and drops blank and repeated ones, keeping their
+// order.
+func newsTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(result, tag) {
+			result = append(result, tag)
+		}
+	}
+
+	return result
+}
+
+// newsMediaKeys lists the media an article uses: those linked from its
+// content and its featured image, so neither can be deleted while in use.
+func newsMediaKeys(html, featuredKey string) []string {
+	keys := mediaKeys(html)
+	if featuredKey != "" && !slices.Contains(keys, featuredKey) {
+		keys = append(keys, featuredKey)
+	}
+
+	return keys
+}
+
+// excerpt shortens text to at most length characters, cutting it at a word
+// boundary and marking the cut with an ellipsis.
+func excerpt(text string, length int) string {
+	text = strings.Join(strings.Fields(text), " ")
+
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+
+	cut := string(runes[:length])
+	if i := strings.LastIndexFunc(cut, unicode.IsSpace); i > 0 {
+		cut = cut[:i]
+	}
+
+	return strings.TrimRightFunc(cut, unicode.IsPunct) + "…"
+}
+
+// commentsEnabled defaults the comment setting of a new article to enabled.
+func commentsEnabled(enabled *bool) bool {
+	return enabled == nil || *enabled
+}
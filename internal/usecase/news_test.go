@@ -2,9 +2,11 @@ package usecase
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/RizqiSugiarto/coding-test/config"
 	"github.com/RizqiSugiarto/coding-test/internal/dto"
 	"github.com/RizqiSugiarto/coding-test/internal/entity"
 	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
@@ -19,6 +21,8 @@ const (
 	nonExistentNewsID  = "non-existent-id"
 )
 
+var testNewsConfig = config.News{Reactions: []string{"like", "love"}, ExcerptLength: 40}
+
 // MockNewsRepo is a mock implementation of repository.NewsRepo.
 type MockNewsRepo struct {
 	mock.Mock
@@ -66,6 +70,34 @@ func (m *MockNewsRepo) GetAll(ctx context.Context) ([]entity.News, error) {
 	return result, args.Error(1)
 }
 
+func (m *MockNewsRepo) GetByCategory(ctx context.Context, categoryID string) ([]entity.News, error) {
+	args := m.Called(ctx, categoryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.News)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockNewsRepo) GetLatest(ctx context.Context, categoryID, tag string, limit int) ([]entity.News, error) {
+	args := m.Called(ctx, categoryID, tag, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.News)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
 func (m *MockNewsRepo) Update(ctx context.Context, news *entity.News) error {
 	args := m.Called(ctx, news)
 
@@ -78,10 +110,52 @@ func (m *MockNewsRepo) Delete(ctx context.Context, id string) error {
 	return args.Error(0)
 }
 
+// MockNewsReactionRepo is a mock implementation of repository.NewsReactionRepo.
+type MockNewsReactionRepo struct {
+	mock.Mock
+}
+
+func (m *MockNewsReactionRepo) Add(ctx context.Context, newsID, voter, reaction string) error {
+	args := m.Called(ctx, newsID, voter, reaction)
+
+	return args.Error(0)
+}
+
+func (m *MockNewsReactionRepo) Remove(ctx context.Context, newsID, voter, reaction string) error {
+	args := m.Called(ctx, newsID, voter, reaction)
+
+	return args.Error(0)
+}
+
+func (m *MockNewsReactionRepo) CountByNewsIDs(ctx context.Context, newsIDs []string) (map[string]map[string]int, error) {
+	args := m.Called(ctx, newsIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(map[string]map[string]int)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+// noReactionsRepo returns a reaction repository in which no news has
+// reactions.
+func noReactionsRepo() *MockNewsReactionRepo {
+	reactionRepo := new(MockNewsReactionRepo)
+	reactionRepo.On("CountByNewsIDs", mock.Anything, mock.Anything).
+		Return(map[string]map[string]int{}, nil).
+		Maybe()
+
+	return reactionRepo
+}
+
 func TestNewsUseCase_Create(t *testing.T) {
 	t.Run("success - create news", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 		req := &dto.CreateNewsRequestDTO{
@@ -118,9 +192,100 @@ func TestNewsUseCase_Create(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("success - comment settings", func(t *testing.T) {
+		disabled := false
+		closeAt := time.Now().Add(24 * time.Hour)
+
+		testCases := []struct {
+			name            string
+			enabled         *bool
+			closeAt         *time.Time
+			expectedEnabled bool
+		}{
+			{name: "comments enabled by default", expectedEnabled: true},
+			{name: "comments disabled with close time", enabled: &disabled, closeAt: &closeAt, expectedEnabled: false},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				mockRepo := new(MockNewsRepo)
+				useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+				ctx := context.Background()
+				req := &dto.CreateNewsRequestDTO{
+					CategoryID:      testNewsCategoryID,
+					Title:           "Breaking News",
+					Content:         "This is the news content",
+					CommentsEnabled: tc.enabled,
+					CommentsCloseAt: tc.closeAt,
+				}
+
+				mockRepo.On("Create", ctx, mock.MatchedBy(func(news *entity.News) bool {
+					return news.CommentsEnabled == tc.expectedEnabled && news.CommentsCloseAt == tc.closeAt
+				})).Return(&entity.News{
+					ID:              testNewsID,
+					CommentsEnabled: tc.expectedEnabled,
+					CommentsCloseAt: tc.closeAt,
+				}, nil)
+
+				result, err := useCase.Create(ctx, testNewsAuthorID, req)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expectedEnabled, result.CommentsEnabled)
+				assert.Equal(t, tc.closeAt, result.CommentsCloseAt)
+				mockRepo.AssertExpectations(t)
+			})
+		}
+	})
+
+	t.Run("success - markdown stored with its rendered html", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+		req := &dto.CreateNewsRequestDTO{
+			CategoryID:    testNewsCategoryID,
+			Title:         "Breaking News",
+			Content:       "Read [more](https://example.com)",
+			ContentFormat: ContentFormatMarkdown,
+		}
+		expectedHTML := "<p>Read <a href=\"https://example.com\">more</a></p>\n"
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(news *entity.News) bool {
+			return news.Content == req.Content && news.ContentFormat == ContentFormatMarkdown && news.ContentHTML == expectedHTML
+		})).Return(&entity.News{
+			ID:            testNewsID,
+			Content:       req.Content,
+			ContentFormat: ContentFormatMarkdown,
+			ContentHTML:   expectedHTML,
+		}, nil)
+
+		result, err := useCase.Create(ctx, testNewsAuthorID, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, ContentFormatMarkdown, result.ContentFormat)
+		assert.Equal(t, expectedHTML, result.ContentHTML)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - invalid content format", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		_, err := useCase.Create(context.Background(), testNewsAuthorID, &dto.CreateNewsRequestDTO{
+			CategoryID:    testNewsCategoryID,
+			Title:         "Breaking News",
+			Content:       "x",
+			ContentFormat: "rst",
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidContentFormat)
+		mockRepo.AssertNotCalled(t, "Create")
+	})
+
 	t.Run("error - repository create fails", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 		req := &dto.CreateNewsRequestDTO{
@@ -143,7 +308,7 @@ func TestNewsUseCase_Create(t *testing.T) {
 func TestNewsUseCase_GetByID(t *testing.T) {
 	t.Run("success - get news by id", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 
@@ -160,7 +325,7 @@ func TestNewsUseCase_GetByID(t *testing.T) {
 
 		mockRepo.On("GetByID", ctx, testNewsID).Return(expectedNews, nil)
 
-		result, err := useCase.GetByID(ctx, testNewsID)
+		result, err := useCase.GetByID(ctx, testNewsID, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -171,14 +336,14 @@ func TestNewsUseCase_GetByID(t *testing.T) {
 
 	t.Run("error - news not found", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 		newsID := nonExistentNewsID
 
 		mockRepo.On("GetByID", ctx, newsID).Return(nil, apperror.ErrNotFound)
 
-		result, err := useCase.GetByID(ctx, newsID)
+		result, err := useCase.GetByID(ctx, newsID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -188,13 +353,13 @@ func TestNewsUseCase_GetByID(t *testing.T) {
 
 	t.Run("error - repository get fails", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 
 		mockRepo.On("GetByID", ctx, testNewsID).Return(nil, apperror.ErrDatabaseConnection)
 
-		result, err := useCase.GetByID(ctx, testNewsID)
+		result, err := useCase.GetByID(ctx, testNewsID, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -206,7 +371,7 @@ func TestNewsUseCase_GetByID(t *testing.T) {
 func TestNewsUseCase_GetAll(t *testing.T) {
 	t.Run("success - get all news", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 
@@ -234,7 +399,7 @@ func TestNewsUseCase_GetAll(t *testing.T) {
 
 		mockRepo.On("GetAll", ctx).Return(newsList, nil)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -246,7 +411,7 @@ func TestNewsUseCase_GetAll(t *testing.T) {
 
 	t.Run("success - get all news empty result", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 
@@ -254,7 +419,7 @@ func TestNewsUseCase_GetAll(t *testing.T) {
 
 		mockRepo.On("GetAll", ctx).Return(newsList, nil)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
@@ -262,15 +427,35 @@ func TestNewsUseCase_GetAll(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("success - one translation of each article", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetAll", ctx).Return([]entity.News{
+			{ID: testIndonesianID, Title: "Pemilu", Locale: "id", TranslationGroup: testTranslationGroup},
+			{ID: testEnglishID, Title: "Elections", Locale: "en", TranslationGroup: testTranslationGroup},
+		}, nil)
+
+		result, err := useCase.GetAll(ctx, []string{"en-US"})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "Elections", result[0].Title)
+		assert.Equal(t, "en", result[0].Locale)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("error - repository getall fails", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 
 		mockRepo.On("GetAll", ctx).Return(nil, apperror.ErrDatabaseConnection)
 
-		result, err := useCase.GetAll(ctx)
+		result, err := useCase.GetAll(ctx, nil)
 
 		assert.Error(t, err)
 		assert.Nil(t, result)
@@ -279,10 +464,52 @@ func TestNewsUseCase_GetAll(t *testing.T) {
 	})
 }
 
+func TestNewsUseCase_GetByCategory(t *testing.T) {
+	t.Run("success - news of a category with reactions", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mockReactionRepo := new(MockNewsReactionRepo)
+		useCase := NewNewsUseCase(mockRepo, mockReactionRepo, noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		newsList := []entity.News{
+			{ID: testNewsID, CategoryID: testNewsCategoryID, Title: "News 1"},
+		}
+
+		mockRepo.On("GetByCategory", ctx, testNewsCategoryID).Return(newsList, nil)
+		mockReactionRepo.On("CountByNewsIDs", ctx, []string{testNewsID}).
+			Return(map[string]map[string]int{testNewsID: {"like": 2}}, nil)
+
+		result, err := useCase.GetByCategory(ctx, testNewsCategoryID, nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, 2, result[0].Reactions["like"])
+		mockRepo.AssertExpectations(t)
+		mockReactionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - empty category skips reactions", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mockReactionRepo := new(MockNewsReactionRepo)
+		useCase := NewNewsUseCase(mockRepo, mockReactionRepo, noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByCategory", ctx, testNewsCategoryID).Return([]entity.News{}, nil)
+
+		result, err := useCase.GetByCategory(ctx, testNewsCategoryID, nil)
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		mockReactionRepo.AssertNotCalled(t, "CountByNewsIDs", mock.Anything, mock.Anything)
+	})
+}
+
 func TestNewsUseCase_Update(t *testing.T) {
 	t.Run("success - update news", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 		req := &dto.UpdateNewsRequestDTO{
@@ -291,6 +518,7 @@ func TestNewsUseCase_Update(t *testing.T) {
 			Content:    "Updated content",
 		}
 
+		mockRepo.On("GetByID", ctx, testNewsID).Return(&entity.News{ID: testNewsID, CommentsEnabled: true}, nil)
 		mockRepo.On("Update", ctx, mock.MatchedBy(func(news *entity.News) bool {
 			return news.ID == testNewsID &&
 				news.CategoryID == testNewsCategoryID &&
@@ -298,15 +526,112 @@ func TestNewsUseCase_Update(t *testing.T) {
 				news.Content == "Updated content"
 		})).Return(nil)
 
-		err := useCase.Update(ctx, testNewsID, req)
+		err := useCase.Update(ctx, testNewsAuthorID, testNewsID, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - content is sanitized on write", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+		req := &dto.UpdateNewsRequestDTO{
+			CategoryID: testNewsCategoryID,
+			Title:      "Updated News",
+			Content:    `<p onmouseover="steal()">Updated</p><script>steal()</script>`,
+		}
+
+		mockRepo.On("GetByID", ctx, testNewsID).Return(&entity.News{ID: testNewsID, CommentsEnabled: true}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(news *entity.News) bool {
+			return news.Content == "<p>Updated</p>"
+		})).Return(nil)
+
+		err := useCase.Update(ctx, testNewsAuthorID, testNewsID, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - omitted comment settings keep the stored ones", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+		closeAt := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
+		req := &dto.UpdateNewsRequestDTO{
+			CategoryID: testNewsCategoryID,
+			Title:      "Updated News",
+			Content:    "Updated content",
+		}
+
+		mockRepo.On("GetByID", ctx, testNewsID).
+			Return(&entity.News{ID: testNewsID, CommentsEnabled: false, CommentsCloseAt: &closeAt}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(news *entity.News) bool {
+			return !news.CommentsEnabled && news.CommentsCloseAt != nil && news.CommentsCloseAt.Equal(closeAt)
+		})).Return(nil)
+
+		err := useCase.Update(ctx, testNewsAuthorID, testNewsID, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - cleared closing time removes the stored one", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+		closeAt := time.Date(2025, 12, 31, 23, 59, 59, 0, time.UTC)
+		req := &dto.UpdateNewsRequestDTO{
+			CategoryID:           testNewsCategoryID,
+			Title:                "Updated News",
+			Content:              "Updated content",
+			ClearCommentsCloseAt: true,
+		}
+
+		mockRepo.On("GetByID", ctx, testNewsID).
+			Return(&entity.News{ID: testNewsID, CommentsEnabled: true, CommentsCloseAt: &closeAt}, nil)
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(news *entity.News) bool {
+			return news.CommentsEnabled && news.CommentsCloseAt == nil
+		})).Return(nil)
+
+		err := useCase.Update(ctx, testNewsAuthorID, testNewsID, req)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("success - given comment settings skip the lookup", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+		enabled := true
+		closeAt := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+		req := &dto.UpdateNewsRequestDTO{
+			CategoryID:      testNewsCategoryID,
+			Title:           "Updated News",
+			Content:         "Updated content",
+			CommentsEnabled: &enabled,
+			CommentsCloseAt: &closeAt,
+		}
+
+		mockRepo.On("Update", ctx, mock.MatchedBy(func(news *entity.News) bool {
+			return news.CommentsEnabled && news.CommentsCloseAt == &closeAt
+		})).Return(nil)
+
+		err := useCase.Update(ctx, testNewsAuthorID, testNewsID, req)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("error - news not found", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 		newsID := nonExistentNewsID
@@ -316,9 +641,9 @@ func TestNewsUseCase_Update(t *testing.T) {
 			Content:    "Updated content",
 		}
 
-		mockRepo.On("Update", ctx, mock.Anything).Return(apperror.ErrNotFound)
+		mockRepo.On("GetByID", ctx, newsID).Return(nil, apperror.ErrNotFound)
 
-		err := useCase.Update(ctx, newsID, req)
+		err := useCase.Update(ctx, testNewsAuthorID, newsID, req)
 
 		assert.Error(t, err)
 		assert.Equal(t, apperror.ErrNotFound, err)
@@ -327,7 +652,7 @@ func TestNewsUseCase_Update(t *testing.T) {
 
 	t.Run("error - repository update fails", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 		req := &dto.UpdateNewsRequestDTO{
@@ -336,9 +661,10 @@ func TestNewsUseCase_Update(t *testing.T) {
 			Content:    "Updated content",
 		}
 
+		mockRepo.On("GetByID", ctx, testNewsID).Return(&entity.News{ID: testNewsID, CommentsEnabled: true}, nil)
 		mockRepo.On("Update", ctx, mock.Anything).Return(apperror.ErrDatabaseConnection)
 
-		err := useCase.Update(ctx, testNewsID, req)
+		err := useCase.Update(ctx, testNewsAuthorID, testNewsID, req)
 
 		assert.Error(t, err)
 		assert.Equal(t, apperror.ErrDatabaseConnection, err)
@@ -349,7 +675,7 @@ func TestNewsUseCase_Update(t *testing.T) {
 func TestNewsUseCase_Delete(t *testing.T) {
 	t.Run("success - delete news", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 
@@ -363,7 +689,7 @@ func TestNewsUseCase_Delete(t *testing.T) {
 
 	t.Run("error - news not found", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 		newsID := nonExistentNewsID
@@ -379,7 +705,7 @@ func TestNewsUseCase_Delete(t *testing.T) {
 
 	t.Run("error - repository delete fails", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		ctx := context.Background()
 
@@ -397,9 +723,234 @@ func TestNewNewsUseCase(t *testing.T) {
 	t.Run("success - create new news usecase", func(t *testing.T) {
 		mockRepo := new(MockNewsRepo)
 
-		useCase := NewNewsUseCase(mockRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
 
 		assert.NotNil(t, useCase)
 		assert.NotNil(t, useCase.newsRepo)
 	})
 }
+
+func TestNewsUseCase_React(t *testing.T) {
+	const voter = "visitor:fingerprint"
+
+	t.Run("success - react to news", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mockReactionRepo := new(MockNewsReactionRepo)
+		useCase := NewNewsUseCase(mockRepo, mockReactionRepo, noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testNewsID).Return(&entity.News{ID: testNewsID}, nil)
+		mockReactionRepo.On("Add", ctx, testNewsID, voter, "like").Return(nil)
+		mockReactionRepo.On("CountByNewsIDs", ctx, []string{testNewsID}).
+			Return(map[string]map[string]int{testNewsID: {"like": 2, "retired": 4}}, nil)
+
+		result, err := useCase.React(ctx, testNewsID, voter, "like")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"like": 2, "love": 0}, result)
+		mockReactionRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - withdraw reaction", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mockReactionRepo := new(MockNewsReactionRepo)
+		useCase := NewNewsUseCase(mockRepo, mockReactionRepo, noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testNewsID).Return(&entity.News{ID: testNewsID}, nil)
+		mockReactionRepo.On("Remove", ctx, testNewsID, voter, "love").Return(nil)
+		mockReactionRepo.On("CountByNewsIDs", ctx, []string{testNewsID}).Return(map[string]map[string]int{}, nil)
+
+		result, err := useCase.Unreact(ctx, testNewsID, voter, "love")
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"like": 0, "love": 0}, result)
+		mockReactionRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - reaction not configured", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mockReactionRepo := new(MockNewsReactionRepo)
+		useCase := NewNewsUseCase(mockRepo, mockReactionRepo, noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		result, err := useCase.React(context.Background(), testNewsID, voter, "angry")
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrInvalidReaction, err)
+		mockReactionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - news not found", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mockReactionRepo := new(MockNewsReactionRepo)
+		useCase := NewNewsUseCase(mockRepo, mockReactionRepo, noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, nonExistentNewsID).Return(nil, apperror.ErrNotFound)
+
+		result, err := useCase.React(ctx, nonExistentNewsID, voter, "like")
+
+		assert.Nil(t, result)
+		assert.Equal(t, apperror.ErrNotFound, err)
+		mockReactionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestNewsUseCase_MediaReferences(t *testing.T) {
+	key := strings.Repeat("ab", 32) + ".png"
+	content := `<p><img src="/media/` + key + `" alt="Chart"></p>`
+
+	t.Run("success - create records the embedded media", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mediaRepo := new(MockMediaRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), mediaRepo, testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("Create", ctx, mock.Anything).Return(&entity.News{ID: testNewsID, ContentHTML: content}, nil)
+		mediaRepo.On("SetReferences", ctx, entity.MediaOwnerNews, testNewsID, []string{key}).Return(nil)
+
+		_, err := useCase.Create(ctx, testNewsAuthorID, &dto.CreateNewsRequestDTO{Title: "Chart", Content: content})
+
+		assert.NoError(t, err)
+		mediaRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - update replaces the embedded media", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mediaRepo := new(MockMediaRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), mediaRepo, testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testNewsID).Return(&entity.News{ID: testNewsID, CommentsEnabled: true}, nil)
+		mockRepo.On("Update", ctx, mock.Anything).Return(nil)
+		mediaRepo.On("SetReferences", ctx, entity.MediaOwnerNews, testNewsID, []string{}).Return(nil)
+
+		err := useCase.Update(ctx, testNewsAuthorID, testNewsID, &dto.UpdateNewsRequestDTO{Title: "Chart", Content: "<p>No chart</p>"})
+
+		assert.NoError(t, err)
+		mediaRepo.AssertExpectations(t)
+	})
+}
+
+func TestNewsUseCase_Metadata(t *testing.T) {
+	key := strings.Repeat("cd", 32) + ".jpg"
+	featuredID := testMediaID
+
+	t.Run("success - featured image is recorded and linked", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mediaRepo := new(MockMediaRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), mediaRepo, testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mediaRepo.On("GetByID", ctx, featuredID).Return(&entity.Media{ID: featuredID, StorageKey: key, MIMEType: "image/jpeg"}, nil)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(news *entity.News) bool {
+			return news.FeaturedMediaID != nil && *news.FeaturedMediaID == featuredID && news.MetaTitle == "Meta" && news.NoIndex
+		})).Return(&entity.News{
+			ID: testNewsID, FeaturedMediaID: &featuredID, FeaturedMediaKey: key, Excerpt: "Written excerpt", NoIndex: true,
+		}, nil)
+		mediaRepo.On("SetReferences", ctx, entity.MediaOwnerNews, testNewsID, []string{key}).Return(nil)
+
+		result, err := useCase.Create(ctx, testNewsAuthorID, &dto.CreateNewsRequestDTO{
+			Title:   "Photo",
+			Content: "<p>Photo</p>",
+			NewsMetadataDTO: dto.NewsMetadataDTO{
+				FeaturedMediaID: &featuredID,
+				MetaTitle:       "Meta",
+				NoIndex:         true,
+			},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/media/"+key, result.FeaturedImageURL)
+		assert.Equal(t, "Written excerpt", result.Excerpt)
+		assert.True(t, result.NoIndex)
+		mockRepo.AssertExpectations(t)
+		mediaRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - tags are lowercased without blanks and repeats", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+		tags := []string{"technology", "ai"}
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(news *entity.News) bool {
+			return assert.ObjectsAreEqual(tags, news.Tags)
+		})).Return(&entity.News{ID: testNewsID, Tags: tags}, nil)
+
+		result, err := useCase.Create(ctx, testNewsAuthorID, &dto.CreateNewsRequestDTO{
+			Title:           "Tagged",
+			Content:         "<p>Tagged</p>",
+			NewsMetadataDTO: dto.NewsMetadataDTO{Tags: []string{" Technology ", "AI", "", "technology"}},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, tags, result.Tags)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - excerpt derived from the content", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), noMediaRepo(), testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetByID", ctx, testNewsID).Return(&entity.News{
+			ID:          testNewsID,
+			ContentText: "The quick brown fox jumps over the lazy dog, twice.",
+		}, nil)
+
+		result, err := useCase.GetByID(ctx, testNewsID, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "The quick brown fox jumps over the lazy…", result.Excerpt)
+		assert.Empty(t, result.FeaturedImageURL)
+	})
+
+	t.Run("error - featured media is not an image", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mediaRepo := new(MockMediaRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), mediaRepo, testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		mediaRepo.On("GetByID", mock.Anything, featuredID).Return(&entity.Media{ID: featuredID, MIMEType: "application/pdf"}, nil)
+
+		err := useCase.Update(context.Background(), testNewsAuthorID, testNewsID, &dto.UpdateNewsRequestDTO{
+			Title:           "Report",
+			Content:         "<p>Report</p>",
+			NewsMetadataDTO: dto.NewsMetadataDTO{FeaturedMediaID: &featuredID},
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidFeaturedMedia)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - featured media not found", func(t *testing.T) {
+		mockRepo := new(MockNewsRepo)
+		mediaRepo := new(MockMediaRepo)
+		useCase := NewNewsUseCase(mockRepo, noReactionsRepo(), mediaRepo, testContentSanitizer, testLocalizer(), testNewsConfig, testMediaConfig)
+
+		mediaRepo.On("GetByID", mock.Anything, featuredID).Return(nil, apperror.ErrNotFound)
+
+		result, err := useCase.Create(context.Background(), testNewsAuthorID, &dto.CreateNewsRequestDTO{
+			Title:           "Photo",
+			Content:         "<p>Photo</p>",
+			NewsMetadataDTO: dto.NewsMetadataDTO{FeaturedMediaID: &featuredID},
+		})
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, apperror.ErrInvalidFeaturedMedia)
+	})
+}
+
+func TestExcerpt(t *testing.T) {
+	assert.Equal(t, "Short text", excerpt("  Short\n text ", 40))
+	assert.Equal(t, "Héllo wörld…", excerpt("Héllo wörld, again", 14))
+	assert.Equal(t, "Unbreakablewo…", excerpt("Unbreakablewords", 13))
+}
@@ -0,0 +1,231 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/urlpath"
+)
+
+// maxRedirectHops bounds how far a chain of redirects is followed before it
+// is treated as a loop.
+const maxRedirectHops = 10
+
+type RedirectUseCase struct {
+	redirectRepo repository.RedirectRepo
+}
+
+func NewRedirectUseCase(redirectRepo repository.RedirectRepo) *RedirectUseCase {
+	return &RedirectUseCase{
+		redirectRepo: redirectRepo,
+	}
+}
+
+func (ru *RedirectUseCase) Create(ctx context.Context, req *dto.CreateRedirectRequestDTO) (*dto.RedirectResponseDTO, error) {
+	redirect, err := ru.prepare(ctx, "", req.SourcePath, req.Target, req.StatusCode)
+	if err != nil {
+		return nil, err
+	}
+
+	redirect.ExpiresAt = req.ExpiresAt
+
+	result, err := ru.redirectRepo.Create(ctx, redirect)
+	if err != nil {
+		return nil, err
+	}
+
+	// Redirects that pointed at the new source now skip straight to its target
+	if err := ru.redirectRepo.Retarget(ctx, result.SourcePath, result.Target); err != nil {
+		return nil, err
+	}
+
+	return redirectResponse(result), nil
+}
+
+func (ru *RedirectUseCase) GetByID(ctx context.Context, id string) (*dto.RedirectResponseDTO, error) {
+	redirect, err := ru.redirectRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return redirectResponse(redirect), nil
+}
+
+func (ru *RedirectUseCase) GetAll(ctx context.Context) ([]dto.RedirectResponseDTO, error) {
+	redirects, err := ru.redirectRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]dto.RedirectResponseDTO, 0, len(redirects))
+
+	for i := range redirects {
+		result = append(result, *redirectResponse(&redirects[i]))
+	}
+
+	return result, nil
+}
+
+func (ru *RedirectUseCase) Update(ctx context.Context, id string, req *dto.UpdateRedirectRequestDTO) error {
+	redirect, err := ru.prepare(ctx, id, req.SourcePath, req.Target, req.StatusCode)
+	if err != nil {
+		return err
+	}
+
+	redirect.ID = id
+	redirect.ExpiresAt = req.ExpiresAt
+
+	if err := ru.redirectRepo.Update(ctx, redirect); err != nil {
+		return err
+	}
+
+	return ru.redirectRepo.Retarget(ctx, redirect.SourcePath, redirect.Target)
+}
+
+func (ru *RedirectUseCase) Delete(ctx context.Context, id string) error {
+	return ru.redirectRepo.Delete(ctx, id)
+}
+
+// Resolve returns the active redirect for a request path and counts the hit.
+// Paths without one, including unparsable paths, are not found.
+func (ru *RedirectUseCase) Resolve(ctx context.Context, path string) (*dto.RedirectResponseDTO, error) {
+	sourcePath, err := urlpath.Normalize(path)
+	if err != nil {
+		return nil, apperror.ErrNotFound
+	}
+
+	redirect, err := ru.redirectRepo.GetBySource(ctx, sourcePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if redirect.Expired(time.Now()) {
+		return nil, apperror.ErrNotFound
+	}
+
+	if err := ru.redirectRepo.RecordHit(ctx, redirect.ID); err != nil {
+		return nil, err
+	}
+
+	redirect.Hits++
+
+	return redirectResponse(redirect), nil
+}
+
+// prepare validates and normalizes a redirect. A target that is itself
+// redirected is replaced by the end of its chain, and a chain that leads back
+// to the source is rejected as a loop. id is the redirect being updated, so
+// its own current entry does not count towards the chain.
+func (ru *RedirectUseCase) prepare(ctx context.Context, id, source, target string, statusCode int) (*entity.Redirect, error) {
+	sourcePath, err := normalizeSitePath(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode == 0 {
+		statusCode = http.StatusMovedPermanently
+	}
+
+	if !isRedirectCode(statusCode) {
+		return nil, apperror.ErrInvalidRedirectCode
+	}
+
+	target, err = normalizeLink(target)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err = ru.followChain(ctx, id, sourcePath, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Redirect{
+		SourcePath: sourcePath,
+		Target:     target,
+		StatusCode: statusCode,
+	}, nil
+}
+
+// followChain follows local redirects starting at target and returns where
+// the chain ends.
+func (ru *RedirectUseCase) followChain(ctx context.Context, id, sourcePath, target string) (string, error) {
+	for range maxRedirectHops {
+		if !strings.HasPrefix(target, "/") {
+			return target, nil
+		}
+
+		if target == sourcePath {
+			return "", apperror.ErrRedirectLoop
+		}
+
+		next, err := ru.redirectRepo.GetBySource(ctx, target)
+		if errors.Is(err, apperror.ErrNotFound) {
+			return target, nil
+		}
+
+		if err != nil {
+			return "", err
+		}
+
+		if next.ID == id || next.Expired(time.Now()) {
+			return target, nil
+		}
+
+		target = next.Target
+	}
+
+	return "", apperror.ErrRedirectLoop
+}
+
+// normalizeLink accepts a site path, normalized like any other, or an
+// absolute http(s) URL, kept as is. It checks redirect targets and menu links.
+func normalizeLink(target string) (string, error) {
+	target = strings.TrimSpace(target)
+
+	if strings.HasPrefix(target, "/") && !strings.HasPrefix(target, "//") {
+		normalized, err := urlpath.Normalize(target)
+		if err != nil {
+			return "", apperror.ErrInvalidRedirect
+		}
+
+		return normalized, nil
+	}
+
+	parsed, err := url.Parse(target)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return "", apperror.ErrInvalidRedirect
+	}
+
+	return target, nil
+}
+
+func isRedirectCode(code int) bool {
+	switch code {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	default:
+		return false
+	}
+}
+
+func redirectResponse(redirect *entity.Redirect) *dto.RedirectResponseDTO {
+	return &dto.RedirectResponseDTO{
+		ID:         redirect.ID,
+		SourcePath: redirect.SourcePath,
+		Target:     redirect.Target,
+		StatusCode: redirect.StatusCode,
+		Hits:       redirect.Hits,
+		ExpiresAt:  redirect.ExpiresAt,
+		CreatedAt:  redirect.CreatedAt,
+		UpdatedAt:  redirect.UpdatedAt,
+	}
+}
@@ -0,0 +1,436 @@
+package usecase
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testRedirectID      = "550e8400-e29b-41d4-a716-446655440010"
+	testRedirectOtherID = "550e8400-e29b-41d4-a716-446655440011"
+	testRedirectSource  = "/about-company"
+	testRedirectTarget  = "/about-us"
+)
+
+// MockRedirectRepo is a mock implementation of repository.RedirectRepo.
+type MockRedirectRepo struct {
+	mock.Mock
+}
+
+func (m *MockRedirectRepo) Create(ctx context.Context, redirect *entity.Redirect) (*entity.Redirect, error) {
+	args := m.Called(ctx, redirect)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Redirect)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRedirectRepo) GetByID(ctx context.Context, id string) (*entity.Redirect, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Redirect)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRedirectRepo) GetBySource(ctx context.Context, sourcePath string) (*entity.Redirect, error) {
+	args := m.Called(ctx, sourcePath)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).(*entity.Redirect)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRedirectRepo) GetAll(ctx context.Context) ([]entity.Redirect, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Redirect)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockRedirectRepo) Update(ctx context.Context, redirect *entity.Redirect) error {
+	args := m.Called(ctx, redirect)
+
+	return args.Error(0)
+}
+
+func (m *MockRedirectRepo) Upsert(ctx context.Context, redirect *entity.Redirect) error {
+	args := m.Called(ctx, redirect)
+
+	return args.Error(0)
+}
+
+func (m *MockRedirectRepo) Retarget(ctx context.Context, from, to string) error {
+	args := m.Called(ctx, from, to)
+
+	return args.Error(0)
+}
+
+func (m *MockRedirectRepo) RecordHit(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockRedirectRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+
+	return args.Error(0)
+}
+
+func (m *MockRedirectRepo) DeleteBySource(ctx context.Context, sourcePath string) error {
+	args := m.Called(ctx, sourcePath)
+
+	return args.Error(0)
+}
+
+// noRedirectsRepo returns a redirect repository that accepts the redirects
+// maintained for moved pages.
+func noRedirectsRepo() *MockRedirectRepo {
+	redirectRepo := new(MockRedirectRepo)
+	redirectRepo.On("DeleteBySource", mock.Anything, mock.Anything).Return(nil).Maybe()
+	redirectRepo.On("Upsert", mock.Anything, mock.Anything).Return(nil).Maybe()
+	redirectRepo.On("Retarget", mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	return redirectRepo
+}
+
+func TestRedirectUseCase_Create(t *testing.T) {
+	t.Run("success - normalizes paths and defaults to 301", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetBySource", ctx, testRedirectTarget).Return(nil, apperror.ErrNotFound)
+		mockRepo.On("Create", ctx, &entity.Redirect{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: http.StatusMovedPermanently,
+		}).Return(&entity.Redirect{
+			ID:         testRedirectID,
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: http.StatusMovedPermanently,
+		}, nil)
+		mockRepo.On("Retarget", ctx, testRedirectSource, testRedirectTarget).Return(nil)
+
+		result, err := useCase.Create(ctx, &dto.CreateRedirectRequestDTO{
+			SourcePath: "/About-Company/",
+			Target:     "/About-Us",
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, testRedirectID, result.ID)
+		assert.Equal(t, http.StatusMovedPermanently, result.StatusCode)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - external target", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(redirect *entity.Redirect) bool {
+			return redirect.Target == "https://example.com/About" && redirect.StatusCode == http.StatusFound
+		})).Return(&entity.Redirect{ID: testRedirectID, SourcePath: testRedirectSource, Target: "https://example.com/About"}, nil)
+		mockRepo.On("Retarget", ctx, testRedirectSource, "https://example.com/About").Return(nil)
+
+		_, err := useCase.Create(ctx, &dto.CreateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     "https://example.com/About",
+			StatusCode: http.StatusFound,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetBySource", mock.Anything, mock.Anything)
+	})
+
+	t.Run("success - chain collapses to its final target", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetBySource", ctx, testRedirectTarget).Return(&entity.Redirect{
+			ID:         testRedirectOtherID,
+			SourcePath: testRedirectTarget,
+			Target:     "/team",
+		}, nil)
+		mockRepo.On("GetBySource", ctx, "/team").Return(nil, apperror.ErrNotFound)
+		mockRepo.On("Create", ctx, mock.MatchedBy(func(redirect *entity.Redirect) bool {
+			return redirect.Target == "/team"
+		})).Return(&entity.Redirect{ID: testRedirectID, SourcePath: testRedirectSource, Target: "/team"}, nil)
+		mockRepo.On("Retarget", ctx, testRedirectSource, "/team").Return(nil)
+
+		result, err := useCase.Create(ctx, &dto.CreateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "/team", result.Target)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - expired redirect ends the chain", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+		expired := time.Now().Add(-time.Hour)
+
+		mockRepo.On("GetBySource", ctx, testRedirectTarget).Return(&entity.Redirect{
+			ID:         testRedirectOtherID,
+			SourcePath: testRedirectTarget,
+			Target:     testRedirectSource,
+			ExpiresAt:  &expired,
+		}, nil)
+		mockRepo.On("Create", ctx, mock.Anything).
+			Return(&entity.Redirect{ID: testRedirectID, SourcePath: testRedirectSource, Target: testRedirectTarget}, nil)
+		mockRepo.On("Retarget", ctx, testRedirectSource, testRedirectTarget).Return(nil)
+
+		_, err := useCase.Create(ctx, &dto.CreateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - redirect to itself", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		result, err := useCase.Create(context.Background(), &dto.CreateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     "/About-Company/",
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrRedirectLoop)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - chain leads back to the source", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetBySource", ctx, testRedirectTarget).Return(&entity.Redirect{
+			ID:         testRedirectOtherID,
+			SourcePath: testRedirectTarget,
+			Target:     testRedirectSource,
+		}, nil)
+
+		result, err := useCase.Create(ctx, &dto.CreateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrRedirectLoop)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - invalid target", func(t *testing.T) {
+		for _, target := range []string{"about-us", "//evil.example.com", "ftp://example.com/file", "javascript:alert(1)"} {
+			mockRepo := new(MockRedirectRepo)
+			useCase := NewRedirectUseCase(mockRepo)
+
+			_, err := useCase.Create(context.Background(), &dto.CreateRedirectRequestDTO{
+				SourcePath: testRedirectSource,
+				Target:     target,
+			})
+
+			assert.ErrorIs(t, err, apperror.ErrInvalidRedirect, target)
+		}
+	})
+
+	t.Run("error - invalid status code", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		_, err := useCase.Create(context.Background(), &dto.CreateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: http.StatusOK,
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidRedirectCode)
+	})
+
+	t.Run("error - reserved source path", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		_, err := useCase.Create(context.Background(), &dto.CreateRedirectRequestDTO{
+			SourcePath: "/api/v1/pages",
+			Target:     testRedirectTarget,
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrReservedPath)
+	})
+}
+
+func TestRedirectUseCase_Update(t *testing.T) {
+	t.Run("success - own entry does not count as a loop", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		// Swapping source and target: the old entry for /about-us is this redirect
+		mockRepo.On("GetBySource", ctx, testRedirectSource).Return(&entity.Redirect{
+			ID:         testRedirectID,
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+		}, nil)
+		mockRepo.On("Update", ctx, &entity.Redirect{
+			ID:         testRedirectID,
+			SourcePath: testRedirectTarget,
+			Target:     testRedirectSource,
+			StatusCode: http.StatusPermanentRedirect,
+		}).Return(nil)
+		mockRepo.On("Retarget", ctx, testRedirectTarget, testRedirectSource).Return(nil)
+
+		err := useCase.Update(ctx, testRedirectID, &dto.UpdateRedirectRequestDTO{
+			SourcePath: testRedirectTarget,
+			Target:     testRedirectSource,
+			StatusCode: http.StatusPermanentRedirect,
+		})
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - redirect not found", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetBySource", ctx, testRedirectTarget).Return(nil, apperror.ErrNotFound)
+		mockRepo.On("Update", ctx, mock.Anything).Return(apperror.ErrNotFound)
+
+		err := useCase.Update(ctx, testRedirectID, &dto.UpdateRedirectRequestDTO{
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+		})
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		mockRepo.AssertNotCalled(t, "Retarget", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestRedirectUseCase_Resolve(t *testing.T) {
+	t.Run("success - resolves and counts the hit", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetBySource", ctx, testRedirectSource).Return(&entity.Redirect{
+			ID:         testRedirectID,
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			StatusCode: http.StatusMovedPermanently,
+			Hits:       4,
+		}, nil)
+		mockRepo.On("RecordHit", ctx, testRedirectID).Return(nil)
+
+		result, err := useCase.Resolve(ctx, "/About-Company/")
+
+		assert.NoError(t, err)
+		assert.Equal(t, testRedirectTarget, result.Target)
+		assert.Equal(t, int64(5), result.Hits)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - expired redirect", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+		expired := time.Now().Add(-time.Minute)
+
+		mockRepo.On("GetBySource", ctx, testRedirectSource).Return(&entity.Redirect{
+			ID:         testRedirectID,
+			SourcePath: testRedirectSource,
+			Target:     testRedirectTarget,
+			ExpiresAt:  &expired,
+		}, nil)
+
+		result, err := useCase.Resolve(ctx, testRedirectSource)
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.Nil(t, result)
+		mockRepo.AssertNotCalled(t, "RecordHit", mock.Anything, mock.Anything)
+	})
+
+	t.Run("error - unparsable path", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		result, err := useCase.Resolve(context.Background(), "/%zz")
+
+		assert.Equal(t, apperror.ErrNotFound, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestRedirectUseCase_GetAll(t *testing.T) {
+	t.Run("success - list redirects", func(t *testing.T) {
+		mockRepo := new(MockRedirectRepo)
+		useCase := NewRedirectUseCase(mockRepo)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetAll", ctx).Return([]entity.Redirect{
+			{ID: testRedirectID, SourcePath: testRedirectSource, Target: testRedirectTarget},
+		}, nil)
+
+		result, err := useCase.GetAll(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		mockRepo.AssertExpectations(t)
+	})
+}
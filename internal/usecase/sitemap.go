@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/sitemap"
+)
+
+// _sitemapNewsAge is how long an article stays in the Google News sitemap.
+const _sitemapNewsAge = 48 * time.Hour
+
+// sitemapSections are listed in the sitemap index in this order.
+var sitemapSections = []string{entity.SitemapNews, entity.SitemapCategories, entity.SitemapPages}
+
+type SitemapUseCase struct {
+	sitemapRepo repository.SitemapRepo
+	cfg         config.Sitemap
+	siteCfg     config.Site
+}
+
+func NewSitemapUseCase(sitemapRepo repository.SitemapRepo, cfg config.Sitemap, siteCfg config.Site) *SitemapUseCase {
+	return &SitemapUseCase{
+		sitemapRepo: sitemapRepo,
+		cfg:         cfg,
+		siteCfg:     siteCfg,
+	}
+}
+
+// Index lists the sitemap files: each section split into files of at most
+// URLsPerFile pages, e.g. news-1.xml, followed by the Google News sitemap.
+// Files are linked gzipped when the Gzip option is set.
+func (su *SitemapUseCase) Index(ctx context.Context) ([]dto.SitemapURLDTO, error) {
+	var files []dto.SitemapURLDTO
+
+	for _, section := range sitemapSections {
+		lastMods, err := su.sitemapRepo.GetFiles(ctx, section, su.perFile())
+		if err != nil {
+			return nil, err
+		}
+
+		for i, lastMod := range lastMods {
+			files = append(files, dto.SitemapURLDTO{
+				URL:     su.fileURL(section + "-" + strconv.Itoa(i+1)),
+				LastMod: lastMod,
+			})
+		}
+	}
+
+	files = append(files, dto.SitemapURLDTO{URL: su.fileURL(entity.SitemapGoogleNews)})
+
+	return files, nil
+}
+
+// URLs lists the pages of a file of a section, counted from 1. A section
+// or file that does not exist returns apperror.ErrNotFound.
+func (su *SitemapUseCase) URLs(ctx context.Context, section string, file int) ([]dto.SitemapURLDTO, error) {
+	if !slices.Contains(sitemapSections, section) || file < 1 {
+		return nil, apperror.ErrNotFound
+	}
+
+	perFile := su.perFile()
+
+	entries, err := su.sitemapRepo.GetEntries(ctx, section, (file-1)*perFile, perFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) == 0 {
+		return nil, apperror.ErrNotFound
+	}
+
+	urls := make([]dto.SitemapURLDTO, 0, len(entries))
+
+	for _, entry := range entries {
+		urls = append(urls, dto.SitemapURLDTO{
+			URL:     su.entryURL(section, entry),
+			LastMod: entry.UpdatedAt,
+		})
+	}
+
+	return urls, nil
+}
+
+// News lists the articles of the last 48 hours for the Google News sitemap.
+func (su *SitemapUseCase) News(ctx context.Context) (*dto.SitemapNewsDTO, error) {
+	entries, err := su.sitemapRepo.GetNewsSince(ctx, time.Now().Add(-_sitemapNewsAge), sitemap.MaxNewsURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &dto.SitemapNewsDTO{
+		Publication: su.cfg.NewsPublication,
+		Language:    su.cfg.NewsLanguage,
+		Articles:    make([]dto.SitemapArticleDTO, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		result.Articles = append(result.Articles, dto.SitemapArticleDTO{
+			URL:       su.entryURL(entity.SitemapNews, entry),
+			Title:     entry.Title,
+			Published: entry.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// perFile is the number of pages per sitemap file, within the limit of the
+// sitemap protocol.
+func (su *SitemapUseCase) perFile() int {
+	return min(max(su.cfg.URLsPerFile, 1), sitemap.MaxURLs)
+}
+
+func (su *SitemapUseCase) fileURL(name string) string {
+	loc := strings.TrimSuffix(su.siteCfg.URL, "/") + "/sitemaps/" + name + ".xml"
+	if su.cfg.Gzip {
+		loc += ".gz"
+	}
+
+	return loc
+}
+
+func (su *SitemapUseCase) entryURL(section string, entry entity.SitemapEntry) string {
+	switch section {
+	case entity.SitemapNews:
+		return strings.ReplaceAll(su.siteCfg.NewsURL, "{id}", entry.ID)
+	case entity.SitemapCategories:
+		return strings.ReplaceAll(su.siteCfg.CategoryURL, "{id}", entry.ID)
+	default:
+		return strings.TrimSuffix(su.siteCfg.URL, "/") + entry.URL
+	}
+}
@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var testSitemapConfig = config.Sitemap{
+	URLsPerFile:     2,
+	NewsPublication: "Daily",
+	NewsLanguage:    "en",
+}
+
+// MockSitemapRepo is a mock implementation of repository.SitemapRepo.
+type MockSitemapRepo struct {
+	mock.Mock
+}
+
+func (m *MockSitemapRepo) GetFiles(ctx context.Context, section string, perFile int) ([]time.Time, error) {
+	args := m.Called(ctx, section, perFile)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]time.Time)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockSitemapRepo) GetEntries(ctx context.Context, section string, offset, limit int) ([]entity.SitemapEntry, error) {
+	args := m.Called(ctx, section, offset, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.SitemapEntry)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockSitemapRepo) GetNewsSince(ctx context.Context, since time.Time, limit int) ([]entity.SitemapEntry, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.SitemapEntry)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func TestSitemapUseCase_Index(t *testing.T) {
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success - files of every section and Google News", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetFiles", ctx, entity.SitemapNews, 2).Return([]time.Time{first, second}, nil)
+		mockRepo.On("GetFiles", ctx, entity.SitemapCategories, 2).Return([]time.Time{first}, nil)
+		mockRepo.On("GetFiles", ctx, entity.SitemapPages, 2).Return([]time.Time{}, nil)
+
+		result, err := useCase.Index(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 4)
+		assert.Equal(t, "https://example.com/sitemaps/news-1.xml", result[0].URL)
+		assert.Equal(t, first, result[0].LastMod)
+		assert.Equal(t, "https://example.com/sitemaps/news-2.xml", result[1].URL)
+		assert.Equal(t, second, result[1].LastMod)
+		assert.Equal(t, "https://example.com/sitemaps/categories-1.xml", result[2].URL)
+		assert.Equal(t, "https://example.com/sitemaps/google-news.xml", result[3].URL)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - gzipped files within the protocol limit", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		cfg := testSitemapConfig
+		cfg.URLsPerFile = 100000
+		cfg.Gzip = true
+		useCase := NewSitemapUseCase(mockRepo, cfg, testSiteConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetFiles", ctx, mock.Anything, 50000).Return([]time.Time{first}, nil)
+
+		result, err := useCase.Index(ctx)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 4)
+		assert.Equal(t, "https://example.com/sitemaps/news-1.xml.gz", result[0].URL)
+		assert.Equal(t, "https://example.com/sitemaps/google-news.xml.gz", result[3].URL)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - repository failure", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetFiles", ctx, entity.SitemapNews, 2).Return(nil, apperror.ErrDatabaseConnection)
+
+		result, err := useCase.Index(ctx)
+
+		assert.ErrorIs(t, err, apperror.ErrDatabaseConnection)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSitemapUseCase_URLs(t *testing.T) {
+	now := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success - second file of news", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetEntries", ctx, entity.SitemapNews, 2, 2).
+			Return([]entity.SitemapEntry{{ID: testNewsID, UpdatedAt: now}}, nil)
+
+		result, err := useCase.URLs(ctx, entity.SitemapNews, 2)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 1)
+		assert.Equal(t, "https://example.com/news/"+testNewsID, result[0].URL)
+		assert.Equal(t, now, result[0].LastMod)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - categories and custom pages", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetEntries", ctx, entity.SitemapCategories, 0, 2).
+			Return([]entity.SitemapEntry{{ID: testNewsCategoryID}}, nil)
+		mockRepo.On("GetEntries", ctx, entity.SitemapPages, 0, 2).
+			Return([]entity.SitemapEntry{{ID: testNewsID, URL: "/about-us"}}, nil)
+
+		categories, err := useCase.URLs(ctx, entity.SitemapCategories, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/categories/"+testNewsCategoryID, categories[0].URL)
+
+		pages, err := useCase.URLs(ctx, entity.SitemapPages, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, "https://example.com/about-us", pages[0].URL)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - file past the last", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetEntries", ctx, entity.SitemapNews, 4, 2).Return([]entity.SitemapEntry{}, nil)
+
+		result, err := useCase.URLs(ctx, entity.SitemapNews, 3)
+
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - unknown section or file", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+
+		_, err := useCase.URLs(ctx, "tags", 1)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+
+		_, err = useCase.URLs(ctx, entity.SitemapNews, 0)
+		assert.ErrorIs(t, err, apperror.ErrNotFound)
+		mockRepo.AssertNotCalled(t, "GetEntries")
+	})
+}
+
+func TestSitemapUseCase_News(t *testing.T) {
+	t.Run("success - articles of the last 48 hours", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+		published := time.Now().Add(-time.Hour)
+
+		mockRepo.On("GetNewsSince", ctx, mock.MatchedBy(func(since time.Time) bool {
+			age := time.Since(since)
+
+			return age >= 48*time.Hour && age < 49*time.Hour
+		}), 1000).Return([]entity.SitemapEntry{
+			{ID: testNewsID, Title: "Breaking", CreatedAt: published},
+		}, nil)
+
+		result, err := useCase.News(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Daily", result.Publication)
+		assert.Equal(t, "en", result.Language)
+		assert.Len(t, result.Articles, 1)
+		assert.Equal(t, "https://example.com/news/"+testNewsID, result.Articles[0].URL)
+		assert.Equal(t, "Breaking", result.Articles[0].Title)
+		assert.Equal(t, published, result.Articles[0].Published)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - repository failure", func(t *testing.T) {
+		mockRepo := new(MockSitemapRepo)
+		useCase := NewSitemapUseCase(mockRepo, testSitemapConfig, testSiteConfig)
+
+		ctx := context.Background()
+
+		mockRepo.On("GetNewsSince", ctx, mock.Anything, 1000).Return(nil, apperror.ErrDatabaseConnection)
+
+		result, err := useCase.News(ctx)
+
+		assert.ErrorIs(t, err, apperror.ErrDatabaseConnection)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/internal/repository"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/locale"
+)
+
+// _defaultLocale is the locale content is written in when none are
+// configured.
+const _defaultLocale = "en"
+
+// translationTypes are the types of translated content, in the order their
+// missing translations are listed.
+var translationTypes = []string{entity.TranslationNews, entity.TranslationCategory, entity.TranslationPage}
+
+// Localizer picks the locale news, categories and custom pages are written
+// in, links their translations, and picks the variant a reader gets from
+// the locales they prefer.
+type Localizer struct {
+	translationRepo repository.TranslationRepo
+	locales         []string
+}
+
+func NewLocalizer(translationRepo repository.TranslationRepo, cfg config.Locale) *Localizer {
+	locales := make([]string, 0, len(cfg.Supported))
+
+	for _, tag := range cfg.Supported {
+		tag = locale.Normalize(tag)
+		if tag != "" && !slices.Contains(locales, tag) {
+			locales = append(locales, tag)
+		}
+	}
+
+	if len(locales) == 0 {
+		locales = append(locales, _defaultLocale)
+	}
+
+	return &Localizer{
+		translationRepo: translationRepo,
+		locales:         locales,
+	}
+}
+
+// locale returns the supported locale content requested in tag is written
+// in, the default locale when tag is empty.
+func (l *Localizer) locale(tag string) (string, error) {
+	if tag == "" {
+		return l.locales[0], nil
+	}
+
+	tag = locale.Normalize(tag)
+	if !slices.Contains(l.locales, tag) {
+		return "", apperror.ErrUnsupportedLocale
+	}
+
+	return tag, nil
+}
+
+// join returns the translation group new content in loc joins as a
+// translation of the content with the ID sourceID, or an empty group for
+// content that translates nothing.
+func (l *Localizer) join(ctx context.Context, contentType, sourceID, loc string) (string, error) {
+	if sourceID == "" {
+		return "", nil
+	}
+
+	variants, err := l.translationRepo.GetGroup(ctx, contentType, sourceID)
+	if err != nil {
+		return "", err
+	}
+
+	if len(variants) == 0 {
+		return "", apperror.ErrInvalidTranslation
+	}
+
+	for _, variant := range variants {
+		if variant.Locale == loc {
+			return "", apperror.ErrTranslationExists
+		}
+	}
+
+	return variants[0].Group, nil
+}
+
+// relocate returns the locale content with the given ID moves to, tag
+// normalized, or an empty locale when tag is empty and it keeps its own.
+// Another translation of the content must not already be in that locale.
+func (l *Localizer) relocate(ctx context.Context, contentType, id, tag string) (string, error) {
+	if tag == "" {
+		return "", nil
+	}
+
+	loc, err := l.locale(tag)
+	if err != nil {
+		return "", err
+	}
+
+	variants, err := l.translationRepo.GetGroup(ctx, contentType, id)
+	if err != nil {
+		return "", err
+	}
+
+	for _, variant := range variants {
+		if variant.ID != id && variant.Locale == loc {
+			return "", apperror.ErrTranslationExists
+		}
+	}
+
+	return loc, nil
+}
+
+// chain returns the locales, best first, content is shown in to a reader
+// preferring preferred, or nil to show content as it is when the reader
+// states no preference.
+func (l *Localizer) chain(preferred []string) []string {
+	if len(preferred) == 0 {
+		return nil
+	}
+
+	return locale.Negotiate(preferred, l.locales)
+}
+
+// pick returns the ID of the translation of the content with the given ID
+// ranked best in chain, id itself when it ranks best or chain is nil.
+func (l *Localizer) pick(ctx context.Context, contentType, id string, chain []string) (string, error) {
+	if chain == nil {
+		return id, nil
+	}
+
+	variants, err := l.translationRepo.GetGroup(ctx, contentType, id)
+	if err != nil {
+		return "", err
+	}
+
+	best, bestRank := id, len(chain)
+
+	for _, variant := range variants {
+		if rank := localeRank(chain, variant.Locale); rank < bestRank || (rank == bestRank && variant.ID == id) {
+			best, bestRank = variant.ID, rank
+		}
+	}
+
+	return best, nil
+}
+
+// localeRank is the position of loc in chain, len(chain) for locales left
+// out of it.
+func localeRank(chain []string, loc string) int {
+	if i := slices.Index(chain, locale.Normalize(loc)); i >= 0 {
+		return i
+	}
+
+	return len(chain)
+}
+
+// localize keeps, of each translation group in items, the translation
+// ranked best in chain, in the place of the first translation listed. Items
+// are kept as they are when chain is nil.
+func localize[T any](items []T, chain []string, variant func(*T) (group, loc string)) []T {
+	if chain == nil {
+		return items
+	}
+
+	positions := make(map[string]int, len(items))
+	ranks := make(map[string]int, len(items))
+	result := make([]T, 0, len(items))
+
+	for i := range items {
+		group, loc := variant(&items[i])
+		rank := localeRank(chain, loc)
+
+		position, seen := positions[group]
+		if !seen {
+			positions[group] = len(result)
+			ranks[group] = rank
+			result = append(result, items[i])
+
+			continue
+		}
+
+		if rank < ranks[group] {
+			ranks[group] = rank
+			result[position] = items[i]
+		}
+	}
+
+	return result
+}
+
+// TranslationUseCase lists content not yet translated into every supported
+// locale.
+type TranslationUseCase struct {
+	localizer *Localizer
+}
+
+func NewTranslationUseCase(localizer *Localizer) *TranslationUseCase {
+	return &TranslationUseCase{
+		localizer: localizer,
+	}
+}
+
+// Missing lists, for content of a type or of every type when contentType is
+// empty, the translation groups lacking a supported locale, oldest first.
+func (tu *TranslationUseCase) Missing(ctx context.Context, contentType string) ([]dto.MissingTranslationDTO, error) {
+	types := translationTypes
+	if contentType != "" {
+		if !slices.Contains(translationTypes, contentType) {
+			return nil, apperror.ErrInvalidTranslationType
+		}
+
+		types = []string{contentType}
+	}
+
+	result := []dto.MissingTranslationDTO{}
+
+	for _, t := range types {
+		translations, err := tu.localizer.translationRepo.GetByType(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, tu.missing(t, translations)...)
+	}
+
+	return result, nil
+}
+
+func (tu *TranslationUseCase) missing(contentType string, translations []entity.Translation) []dto.MissingTranslationDTO {
+	positions := map[string]int{}
+	groups := []dto.MissingTranslationDTO{}
+
+	for _, translation := range translations {
+		position, ok := positions[translation.Group]
+		if !ok {
+			position = len(groups)
+			positions[translation.Group] = position
+			groups = append(groups, dto.MissingTranslationDTO{
+				Type:             contentType,
+				TranslationGroup: translation.Group,
+				Translations:     []dto.TranslationDTO{},
+			})
+		}
+
+		groups[position].Translations = append(groups[position].Translations, dto.TranslationDTO{
+			ID:     translation.ID,
+			Locale: translation.Locale,
+			Title:  translation.Title,
+		})
+	}
+
+	result := make([]dto.MissingTranslationDTO, 0, len(groups))
+
+	for _, group := range groups {
+		group.Missing = []string{}
+
+		for _, loc := range tu.localizer.locales {
+			translated := slices.ContainsFunc(group.Translations, func(t dto.TranslationDTO) bool {
+				return locale.Normalize(t.Locale) == loc
+			})
+			if !translated {
+				group.Missing = append(group.Missing, loc)
+			}
+		}
+
+		if len(group.Missing) > 0 {
+			result = append(result, group)
+		}
+	}
+
+	return result
+}
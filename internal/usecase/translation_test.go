@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/config"
+	"github.com/RizqiSugiarto/coding-test/internal/dto"
+	"github.com/RizqiSugiarto/coding-test/internal/entity"
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTranslationGroup = "550e8400-e29b-41d4-a716-446655440090"
+	testEnglishID        = "550e8400-e29b-41d4-a716-446655440091"
+	testIndonesianID     = "550e8400-e29b-41d4-a716-446655440092"
+)
+
+var testLocaleConfig = config.Locale{Supported: []string{"en", "id"}}
+
+// MockTranslationRepo is a mock implementation of repository.TranslationRepo.
+type MockTranslationRepo struct {
+	mock.Mock
+}
+
+func (m *MockTranslationRepo) GetByType(ctx context.Context, contentType string) ([]entity.Translation, error) {
+	args := m.Called(ctx, contentType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Translation)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+func (m *MockTranslationRepo) GetGroup(ctx context.Context, contentType, id string) ([]entity.Translation, error) {
+	args := m.Called(ctx, contentType, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+
+	result, ok := args.Get(0).([]entity.Translation)
+	if !ok {
+		return nil, args.Error(1)
+	}
+
+	return result, args.Error(1)
+}
+
+// testLocalizer is a Localizer for content that is not translated: it
+// fails the test when it has to look a translation up.
+func testLocalizer() *Localizer {
+	return NewLocalizer(new(MockTranslationRepo), testLocaleConfig)
+}
+
+// testVariants is content written in English and translated into
+// Indonesian.
+func testVariants() []entity.Translation {
+	return []entity.Translation{
+		{ID: testEnglishID, Locale: "en", Group: testTranslationGroup, Title: "Elections"},
+		{ID: testIndonesianID, Locale: "id", Group: testTranslationGroup, Title: "Pemilu"},
+	}
+}
+
+func TestNewLocalizer(t *testing.T) {
+	t.Run("success - normalizes locales and drops duplicates", func(t *testing.T) {
+		localizer := NewLocalizer(new(MockTranslationRepo), config.Locale{Supported: []string{"id_ID", " en ", "id-id"}})
+
+		assert.Equal(t, []string{"id-id", "en"}, localizer.locales)
+	})
+
+	t.Run("success - defaults to English without locales", func(t *testing.T) {
+		localizer := NewLocalizer(new(MockTranslationRepo), config.Locale{})
+
+		assert.Equal(t, []string{"en"}, localizer.locales)
+	})
+}
+
+func TestLocalizer_Locale(t *testing.T) {
+	localizer := testLocalizer()
+
+	tests := []struct {
+		name     string
+		tag      string
+		expected string
+		err      error
+	}{
+		{name: "empty is the default", tag: "", expected: "en"},
+		{name: "supported", tag: "id", expected: "id"},
+		{name: "normalized", tag: "ID", expected: "id"},
+		{name: "unsupported", tag: "fr", err: apperror.ErrUnsupportedLocale},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := localizer.locale(tt.tag)
+
+			assert.Equal(t, tt.err, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestLocalizer_Join(t *testing.T) {
+	t.Run("success - joins the group of the translated content", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		localizer := NewLocalizer(translationRepo, testLocaleConfig)
+
+		ctx := context.Background()
+		translationRepo.On("GetGroup", ctx, entity.TranslationNews, testEnglishID).Return(testVariants()[:1], nil)
+
+		group, err := localizer.join(ctx, entity.TranslationNews, testEnglishID, "id")
+
+		assert.NoError(t, err)
+		assert.Equal(t, testTranslationGroup, group)
+		translationRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - content translating nothing starts a group", func(t *testing.T) {
+		group, err := testLocalizer().join(context.Background(), entity.TranslationNews, "", "en")
+
+		assert.NoError(t, err)
+		assert.Empty(t, group)
+	})
+
+	t.Run("error - translated content does not exist", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		localizer := NewLocalizer(translationRepo, testLocaleConfig)
+
+		ctx := context.Background()
+		translationRepo.On("GetGroup", ctx, entity.TranslationNews, nonExistentID).Return([]entity.Translation{}, nil)
+
+		group, err := localizer.join(ctx, entity.TranslationNews, nonExistentID, "id")
+
+		assert.Equal(t, apperror.ErrInvalidTranslation, err)
+		assert.Empty(t, group)
+	})
+
+	t.Run("error - locale already translated", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		localizer := NewLocalizer(translationRepo, testLocaleConfig)
+
+		ctx := context.Background()
+		translationRepo.On("GetGroup", ctx, entity.TranslationNews, testEnglishID).Return(testVariants(), nil)
+
+		_, err := localizer.join(ctx, entity.TranslationNews, testEnglishID, "id")
+
+		assert.Equal(t, apperror.ErrTranslationExists, err)
+	})
+}
+
+func TestLocalizer_Relocate(t *testing.T) {
+	t.Run("success - empty keeps the locale without a lookup", func(t *testing.T) {
+		loc, err := testLocalizer().relocate(context.Background(), entity.TranslationPage, testEnglishID, "")
+
+		assert.NoError(t, err)
+		assert.Empty(t, loc)
+	})
+
+	t.Run("success - keeping its own locale", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		localizer := NewLocalizer(translationRepo, testLocaleConfig)
+
+		ctx := context.Background()
+		translationRepo.On("GetGroup", ctx, entity.TranslationPage, testIndonesianID).Return(testVariants(), nil)
+
+		loc, err := localizer.relocate(ctx, entity.TranslationPage, testIndonesianID, "ID")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "id", loc)
+	})
+
+	t.Run("error - another translation is in the locale", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		localizer := NewLocalizer(translationRepo, testLocaleConfig)
+
+		ctx := context.Background()
+		translationRepo.On("GetGroup", ctx, entity.TranslationPage, testIndonesianID).Return(testVariants(), nil)
+
+		_, err := localizer.relocate(ctx, entity.TranslationPage, testIndonesianID, "en")
+
+		assert.Equal(t, apperror.ErrTranslationExists, err)
+	})
+
+	t.Run("error - unsupported locale", func(t *testing.T) {
+		_, err := testLocalizer().relocate(context.Background(), entity.TranslationPage, testIndonesianID, "fr")
+
+		assert.Equal(t, apperror.ErrUnsupportedLocale, err)
+	})
+}
+
+func TestLocalizer_Pick(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		preferred []string
+		expected  string
+	}{
+		{name: "preferred translation", id: testEnglishID, preferred: []string{"id-ID", "en"}, expected: testIndonesianID},
+		{name: "content in the preferred locale", id: testEnglishID, preferred: []string{"en"}, expected: testEnglishID},
+		{name: "falls back to the default locale", id: testIndonesianID, preferred: []string{"fr"}, expected: testEnglishID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			translationRepo := new(MockTranslationRepo)
+			localizer := NewLocalizer(translationRepo, testLocaleConfig)
+
+			ctx := context.Background()
+			translationRepo.On("GetGroup", ctx, entity.TranslationNews, tt.id).Return(testVariants(), nil)
+
+			result, err := localizer.pick(ctx, entity.TranslationNews, tt.id, localizer.chain(tt.preferred))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+
+	t.Run("success - no preference keeps the content", func(t *testing.T) {
+		localizer := testLocalizer()
+
+		result, err := localizer.pick(context.Background(), entity.TranslationNews, testIndonesianID, localizer.chain(nil))
+
+		assert.NoError(t, err)
+		assert.Equal(t, testIndonesianID, result)
+	})
+}
+
+func TestLocalize(t *testing.T) {
+	categories := []entity.Category{
+		{ID: "1", Name: "Politics", Locale: "en", TranslationGroup: "politics"},
+		{ID: "2", Name: "Sports", Locale: "en", TranslationGroup: "sports"},
+		{ID: "3", Name: "Politik", Locale: "id", TranslationGroup: "politics"},
+	}
+
+	variant := func(c *entity.Category) (string, string) {
+		return c.TranslationGroup, c.Locale
+	}
+
+	t.Run("success - one translation of each in place of the first", func(t *testing.T) {
+		result := localize(categories, []string{"id", "en"}, variant)
+
+		require.Len(t, result, 2)
+		assert.Equal(t, "Politik", result[0].Name)
+		assert.Equal(t, "Sports", result[1].Name)
+	})
+
+	t.Run("success - all translations without a preference", func(t *testing.T) {
+		assert.Len(t, localize(categories, nil, variant), 3)
+	})
+}
+
+func TestTranslationUseCase_Missing(t *testing.T) {
+	t.Run("success - groups lacking a locale", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		useCase := NewTranslationUseCase(NewLocalizer(translationRepo, testLocaleConfig))
+
+		ctx := context.Background()
+		translationRepo.On("GetByType", ctx, entity.TranslationNews).Return(append(testVariants(),
+			entity.Translation{ID: "3", Locale: "id", Group: "untranslated", Title: "Cuaca"},
+		), nil)
+
+		result, err := useCase.Missing(ctx, entity.TranslationNews)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []dto.MissingTranslationDTO{{
+			Type:             entity.TranslationNews,
+			TranslationGroup: "untranslated",
+			Translations:     []dto.TranslationDTO{{ID: "3", Locale: "id", Title: "Cuaca"}},
+			Missing:          []string{"en"},
+		}}, result)
+		translationRepo.AssertExpectations(t)
+	})
+
+	t.Run("success - every type without a type", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		useCase := NewTranslationUseCase(NewLocalizer(translationRepo, testLocaleConfig))
+
+		ctx := context.Background()
+		translationRepo.On("GetByType", ctx, entity.TranslationNews).Return(testVariants(), nil)
+		translationRepo.On("GetByType", ctx, entity.TranslationCategory).Return([]entity.Translation{}, nil)
+		translationRepo.On("GetByType", ctx, entity.TranslationPage).Return([]entity.Translation{
+			{ID: "4", Locale: "en", Group: "about", Title: "/about"},
+		}, nil)
+
+		result, err := useCase.Missing(ctx, "")
+
+		assert.NoError(t, err)
+		require.Len(t, result, 1)
+		assert.Equal(t, entity.TranslationPage, result[0].Type)
+		assert.Equal(t, []string{"id"}, result[0].Missing)
+		translationRepo.AssertExpectations(t)
+	})
+
+	t.Run("error - unknown type", func(t *testing.T) {
+		result, err := NewTranslationUseCase(testLocalizer()).Missing(context.Background(), "tags")
+
+		assert.Equal(t, apperror.ErrInvalidTranslationType, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("error - repository fails", func(t *testing.T) {
+		translationRepo := new(MockTranslationRepo)
+		useCase := NewTranslationUseCase(NewLocalizer(translationRepo, testLocaleConfig))
+
+		ctx := context.Background()
+		translationRepo.On("GetByType", ctx, entity.TranslationCategory).Return(nil, apperror.ErrDatabaseConnection)
+
+		result, err := useCase.Missing(ctx, entity.TranslationCategory)
+
+		assert.Equal(t, apperror.ErrDatabaseConnection, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_comments_news_id_parent_id;
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS comments_parent_same_news_fkey,
+    DROP COLUMN IF EXISTS depth,
+    DROP COLUMN IF EXISTS parent_id;
+
+ALTER TABLE comments DROP CONSTRAINT IF EXISTS comments_id_news_id_key;
@@ -0,0 +1,9 @@
+ALTER TABLE comments ADD CONSTRAINT comments_id_news_id_key UNIQUE (id, news_id);
+
+ALTER TABLE comments
+    ADD COLUMN parent_id UUID,
+    ADD COLUMN depth INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT comments_parent_same_news_fkey
+        FOREIGN KEY (parent_id, news_id) REFERENCES comments (id, news_id) ON DELETE CASCADE;
+
+CREATE INDEX idx_comments_news_id_parent_id ON comments (news_id, parent_id);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_comments_fingerprint_status;
+DROP INDEX IF EXISTS idx_comments_status_created_at;
+
+ALTER TABLE comments
+    DROP CONSTRAINT IF EXISTS comments_status_check,
+    DROP COLUMN IF EXISTS moderated_at,
+    DROP COLUMN IF EXISTS fingerprint,
+    DROP COLUMN IF EXISTS status;
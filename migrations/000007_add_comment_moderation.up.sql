@@ -0,0 +1,11 @@
+ALTER TABLE comments
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'approved',
+    ADD COLUMN fingerprint VARCHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN moderated_at TIMESTAMP,
+    ADD CONSTRAINT comments_status_check CHECK (status IN ('pending', 'approved', 'rejected', 'spam'));
+
+-- Existing comments were published instantly, new ones wait for moderation by default
+ALTER TABLE comments ALTER COLUMN status SET DEFAULT 'pending';
+
+CREATE INDEX idx_comments_status_created_at ON comments (status, created_at);
+CREATE INDEX idx_comments_fingerprint_status ON comments (fingerprint, status);
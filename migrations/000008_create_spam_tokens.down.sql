@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_comments_comment_created_at;
+DROP TABLE IF EXISTS spam_tokens;
@@ -0,0 +1,9 @@
+-- Token counts learned from moderator spam/ham decisions. The reserved token
+-- '__documents__' holds the number of trained comments per class.
+CREATE TABLE spam_tokens (
+    token VARCHAR(64) PRIMARY KEY,
+    spam_count INT NOT NULL DEFAULT 0,
+    ham_count INT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_comments_comment_created_at ON comments (md5(lower(btrim(comment))), created_at);
@@ -0,0 +1,7 @@
+-- Solved proof-of-work challenges, kept until they expire to block replays
+CREATE TABLE pow_redemptions (
+    id CHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_pow_redemptions_expires_at ON pow_redemptions (expires_at);
@@ -0,0 +1,4 @@
+-- SHA-256 of the secret token letting an anonymous author edit or delete a comment
+ALTER TABLE comments
+    ADD COLUMN edit_token_hash CHAR(64) NOT NULL DEFAULT '',
+    ADD COLUMN updated_at TIMESTAMP;
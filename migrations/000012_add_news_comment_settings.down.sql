@@ -0,0 +1,3 @@
+ALTER TABLE news
+    DROP COLUMN IF EXISTS comments_close_at,
+    DROP COLUMN IF EXISTS comments_enabled;
@@ -0,0 +1,4 @@
+-- Per-article comment settings: comments can be turned off, or closed at a given time
+ALTER TABLE news
+    ADD COLUMN comments_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN comments_close_at TIMESTAMP;
@@ -0,0 +1,17 @@
+-- Reader reactions on news and votes on comments. A voter is either a signed in
+-- user ("user:<id>") or an anonymous visitor fingerprint ("visitor:<hash>").
+CREATE TABLE news_reactions (
+    news_id UUID NOT NULL REFERENCES news(id) ON DELETE CASCADE,
+    voter VARCHAR(80) NOT NULL,
+    reaction VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (news_id, voter, reaction)
+);
+
+CREATE TABLE comment_votes (
+    comment_id UUID NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    voter VARCHAR(80) NOT NULL,
+    value SMALLINT NOT NULL CHECK (value IN (-1, 1)),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (comment_id, voter)
+);
@@ -0,0 +1,10 @@
+-- Reader reports on comments, one per reporter and comment. Reports are
+-- removed once a moderator approves or rejects the comment.
+CREATE TABLE comment_reports (
+    comment_id UUID NOT NULL REFERENCES comments(id) ON DELETE CASCADE,
+    reporter VARCHAR(80) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    note VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (comment_id, reporter)
+);
@@ -0,0 +1,2 @@
+-- The original spelling of normalized custom URLs is not kept, nothing to undo.
+SELECT 1;
@@ -0,0 +1,5 @@
+-- Bring existing custom URLs in line with the normalization applied on save:
+-- lower case, one leading slash and no trailing slash. Fails on rows that
+-- only differed by case or slashes; resolve those duplicates by hand first.
+UPDATE custom_pages
+SET custom_url = '/' || TRIM(BOTH '/' FROM LOWER(TRIM(custom_url)));
@@ -0,0 +1,13 @@
+-- Managed redirects for moved pages and articles
+CREATE TABLE redirects (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    source_path VARCHAR(150) UNIQUE NOT NULL,
+    target VARCHAR(2048) NOT NULL,
+    status_code SMALLINT NOT NULL DEFAULT 301 CHECK (status_code IN (301, 302, 307, 308)),
+    hits BIGINT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_redirects_target ON redirects (target);
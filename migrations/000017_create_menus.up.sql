@@ -0,0 +1,22 @@
+-- Navigation menus. Items reference a custom page, category or news article by
+-- ID without a foreign key, so deleting the target leaves a broken item that
+-- is flagged when the menu is resolved instead of silently disappearing.
+CREATE TABLE menus (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(64) UNIQUE NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE menu_items (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    menu_id UUID NOT NULL REFERENCES menus(id) ON DELETE CASCADE,
+    parent_id UUID REFERENCES menu_items(id) ON DELETE CASCADE,
+    position INT NOT NULL DEFAULT 0,
+    item_type VARCHAR(16) NOT NULL CHECK (item_type IN ('page', 'category', 'news', 'url')),
+    target_id UUID,
+    url VARCHAR(2048) NOT NULL DEFAULT '',
+    title VARCHAR(150) NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_menu_items_menu_id_position ON menu_items (menu_id, position);
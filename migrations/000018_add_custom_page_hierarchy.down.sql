@@ -0,0 +1,4 @@
+ALTER TABLE custom_pages
+    DROP COLUMN IF EXISTS slug,
+    DROP COLUMN IF EXISTS position,
+    DROP COLUMN IF EXISTS parent_id;
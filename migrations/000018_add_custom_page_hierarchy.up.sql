@@ -0,0 +1,9 @@
+-- Page hierarchy: pages are ordered under an optional parent. A page with a
+-- slug has its custom_url derived from its parent's, and follows it when the
+-- parent moves. Deleting a parent leaves its children as top-level pages.
+ALTER TABLE custom_pages
+    ADD COLUMN parent_id UUID REFERENCES custom_pages(id) ON DELETE SET NULL,
+    ADD COLUMN position INT NOT NULL DEFAULT 0,
+    ADD COLUMN slug VARCHAR(150) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_custom_pages_parent_id ON custom_pages (parent_id);
@@ -0,0 +1,7 @@
+ALTER TABLE custom_pages
+    DROP COLUMN IF EXISTS content_html,
+    DROP COLUMN IF EXISTS content_format;
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS content_html,
+    DROP COLUMN IF EXISTS content_format;
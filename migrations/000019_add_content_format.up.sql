@@ -0,0 +1,15 @@
+-- Content is stored in its source format next to its rendered, sanitized
+-- HTML, so reads never render. Existing content is HTML.
+ALTER TABLE news
+    ADD COLUMN content_format VARCHAR(20) NOT NULL DEFAULT 'html'
+        CHECK (content_format IN ('html', 'markdown', 'plaintext')),
+    ADD COLUMN content_html TEXT NOT NULL DEFAULT '';
+
+UPDATE news SET content_html = content;
+
+ALTER TABLE custom_pages
+    ADD COLUMN content_format VARCHAR(20) NOT NULL DEFAULT 'html'
+        CHECK (content_format IN ('html', 'markdown', 'plaintext')),
+    ADD COLUMN content_html TEXT NOT NULL DEFAULT '';
+
+UPDATE custom_pages SET content_html = content;
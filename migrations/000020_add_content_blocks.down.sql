@@ -0,0 +1,33 @@
+-- A document holding a single raw HTML block is HTML again; any other block
+-- document is replaced by the HTML rendered from it.
+UPDATE custom_pages
+SET content = CASE
+        WHEN json_array_length(content::json -> 'blocks') = 1
+            AND content::json -> 'blocks' -> 0 ->> 'type' = 'html'
+        THEN content::json -> 'blocks' -> 0 ->> 'html'
+        ELSE content_html
+    END,
+    content_format = 'html'
+WHERE content_format = 'blocks';
+
+ALTER TABLE custom_pages
+    DROP COLUMN IF EXISTS content_text,
+    DROP CONSTRAINT custom_pages_content_format_check,
+    ADD CONSTRAINT custom_pages_content_format_check
+        CHECK (content_format IN ('html', 'markdown', 'plaintext'));
+
+UPDATE news
+SET content = CASE
+        WHEN json_array_length(content::json -> 'blocks') = 1
+            AND content::json -> 'blocks' -> 0 ->> 'type' = 'html'
+        THEN content::json -> 'blocks' -> 0 ->> 'html'
+        ELSE content_html
+    END,
+    content_format = 'html'
+WHERE content_format = 'blocks';
+
+ALTER TABLE news
+    DROP COLUMN IF EXISTS content_text,
+    DROP CONSTRAINT news_content_format_check,
+    ADD CONSTRAINT news_content_format_check
+        CHECK (content_format IN ('html', 'markdown', 'plaintext'));
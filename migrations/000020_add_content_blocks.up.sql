@@ -0,0 +1,36 @@
+-- Content may be a JSON block document, and its plain text is stored next
+-- to its HTML. Existing HTML content becomes a document holding a single
+-- raw HTML block.
+ALTER TABLE news
+    DROP CONSTRAINT news_content_format_check,
+    ADD CONSTRAINT news_content_format_check
+        CHECK (content_format IN ('html', 'markdown', 'plaintext', 'blocks')),
+    ADD COLUMN content_text TEXT NOT NULL DEFAULT '';
+
+UPDATE news
+SET content = json_build_object(
+        'blocks', json_build_array(json_build_object('type', 'html', 'html', content))
+    )::text,
+    content_format = 'blocks'
+WHERE content_format = 'html';
+
+ALTER TABLE custom_pages
+    DROP CONSTRAINT custom_pages_content_format_check,
+    ADD CONSTRAINT custom_pages_content_format_check
+        CHECK (content_format IN ('html', 'markdown', 'plaintext', 'blocks')),
+    ADD COLUMN content_text TEXT NOT NULL DEFAULT '';
+
+UPDATE custom_pages
+SET content = json_build_object(
+        'blocks', json_build_array(json_build_object('type', 'html', 'html', content))
+    )::text,
+    content_format = 'blocks'
+WHERE content_format = 'html';
+
+-- Plain text is rendered when content is written; until then it is the
+-- HTML with its tags stripped.
+UPDATE news
+SET content_text = btrim(regexp_replace(regexp_replace(content_html, '<[^>]*>', ' ', 'g'), '\s+', ' ', 'g'));
+
+UPDATE custom_pages
+SET content_text = btrim(regexp_replace(regexp_replace(content_html, '<[^>]*>', ' ', 'g'), '\s+', ' ', 'g'));
@@ -0,0 +1,28 @@
+-- The media library. Files are stored under a key derived from their
+-- content, so each file is stored once.
+CREATE TABLE media (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    storage_key VARCHAR(80) UNIQUE NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    mime_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    width INTEGER NOT NULL DEFAULT 0,
+    height INTEGER NOT NULL DEFAULT 0,
+    alt_text VARCHAR(500) NOT NULL DEFAULT '',
+    uploader_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- News and custom pages embedding a media file. Media in use cannot be
+-- deleted; references go away with their article or page.
+CREATE TABLE media_references (
+    media_id UUID NOT NULL REFERENCES media(id) ON DELETE RESTRICT,
+    news_id UUID REFERENCES news(id) ON DELETE CASCADE,
+    page_id UUID REFERENCES custom_pages(id) ON DELETE CASCADE,
+    CHECK ((news_id IS NULL) <> (page_id IS NULL))
+);
+
+CREATE UNIQUE INDEX idx_media_references_news ON media_references (news_id, media_id) WHERE news_id IS NOT NULL;
+CREATE UNIQUE INDEX idx_media_references_page ON media_references (page_id, media_id) WHERE page_id IS NOT NULL;
+CREATE INDEX idx_media_references_media ON media_references (media_id);
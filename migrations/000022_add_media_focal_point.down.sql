@@ -0,0 +1,3 @@
+ALTER TABLE media
+    DROP COLUMN IF EXISTS focal_y,
+    DROP COLUMN IF EXISTS focal_x;
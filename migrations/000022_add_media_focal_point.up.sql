@@ -0,0 +1,5 @@
+-- The point of an image kept in view when it is cropped to a size, as
+-- fractions of its width and height.
+ALTER TABLE media
+    ADD COLUMN focal_x REAL NOT NULL DEFAULT 0.5 CHECK (focal_x BETWEEN 0 AND 1),
+    ADD COLUMN focal_y REAL NOT NULL DEFAULT 0.5 CHECK (focal_y BETWEEN 0 AND 1);
@@ -0,0 +1,10 @@
+ALTER TABLE news
+    DROP COLUMN IF EXISTS noindex,
+    DROP COLUMN IF EXISTS og_image,
+    DROP COLUMN IF EXISTS og_description,
+    DROP COLUMN IF EXISTS og_title,
+    DROP COLUMN IF EXISTS canonical_url,
+    DROP COLUMN IF EXISTS meta_description,
+    DROP COLUMN IF EXISTS meta_title,
+    DROP COLUMN IF EXISTS excerpt,
+    DROP COLUMN IF EXISTS featured_media_id;
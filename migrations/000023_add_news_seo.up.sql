@@ -0,0 +1,12 @@
+-- A featured image, an excerpt and metadata for search engines and social
+-- sharing. Empty texts fall back to the article's own title, content and URL.
+ALTER TABLE news
+    ADD COLUMN featured_media_id UUID REFERENCES media(id) ON DELETE RESTRICT,
+    ADD COLUMN excerpt VARCHAR(500) NOT NULL DEFAULT '',
+    ADD COLUMN meta_title VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN meta_description VARCHAR(500) NOT NULL DEFAULT '',
+    ADD COLUMN canonical_url VARCHAR(2048) NOT NULL DEFAULT '',
+    ADD COLUMN og_title VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN og_description VARCHAR(500) NOT NULL DEFAULT '',
+    ADD COLUMN og_image VARCHAR(2048) NOT NULL DEFAULT '',
+    ADD COLUMN noindex BOOLEAN NOT NULL DEFAULT FALSE;
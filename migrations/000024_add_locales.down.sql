@@ -0,0 +1,14 @@
+ALTER TABLE custom_pages
+    DROP CONSTRAINT IF EXISTS custom_pages_translation_group_locale_key,
+    DROP COLUMN IF EXISTS translation_group,
+    DROP COLUMN IF EXISTS locale;
+
+ALTER TABLE news
+    DROP CONSTRAINT IF EXISTS news_translation_group_locale_key,
+    DROP COLUMN IF EXISTS translation_group,
+    DROP COLUMN IF EXISTS locale;
+
+ALTER TABLE categories
+    DROP CONSTRAINT IF EXISTS categories_translation_group_locale_key,
+    DROP COLUMN IF EXISTS translation_group,
+    DROP COLUMN IF EXISTS locale;
@@ -0,0 +1,17 @@
+-- Content is written in a locale, and the variants of the same content in
+-- other locales share a translation group. Existing content is English and
+-- each item starts a group of its own.
+ALTER TABLE categories
+    ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'en',
+    ADD COLUMN translation_group UUID NOT NULL DEFAULT uuid_generate_v4(),
+    ADD CONSTRAINT categories_translation_group_locale_key UNIQUE (translation_group, locale);
+
+ALTER TABLE news
+    ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'en',
+    ADD COLUMN translation_group UUID NOT NULL DEFAULT uuid_generate_v4(),
+    ADD CONSTRAINT news_translation_group_locale_key UNIQUE (translation_group, locale);
+
+ALTER TABLE custom_pages
+    ADD COLUMN locale VARCHAR(10) NOT NULL DEFAULT 'en',
+    ADD COLUMN translation_group UUID NOT NULL DEFAULT uuid_generate_v4(),
+    ADD CONSTRAINT custom_pages_translation_group_locale_key UNIQUE (translation_group, locale);
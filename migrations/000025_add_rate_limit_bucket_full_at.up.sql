@@ -0,0 +1,3 @@
+ALTER TABLE rate_limit_buckets ADD COLUMN full_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+CREATE INDEX idx_rate_limit_buckets_full_at ON rate_limit_buckets (full_at);
@@ -0,0 +1,8 @@
+-- Whether the stored HTML of news and custom pages was sanitized when the
+-- application last rendered all of it. The table holds at most one row, and
+-- no row means stored content was never rendered.
+CREATE TABLE content_render_state (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    sanitized BOOLEAN NOT NULL,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,3 @@
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS trained_text,
+    DROP COLUMN IF EXISTS trained_class;
@@ -0,0 +1,7 @@
+-- What each comment taught the spam filter: the class its text was counted
+-- in ('spam' or 'ham', empty when untrained) and the text it was counted
+-- with, so a later decision reverses exactly that. Earlier training was not
+-- recorded and stays in the counts.
+ALTER TABLE comments
+    ADD COLUMN trained_class VARCHAR(10) NOT NULL DEFAULT '',
+    ADD COLUMN trained_text TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_news_tags;
+
+ALTER TABLE news DROP COLUMN IF EXISTS tags;
@@ -0,0 +1,4 @@
+-- Free-form tags of an article, lowercased, each with its own feed.
+ALTER TABLE news ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_news_tags ON news USING GIN (tags);
@@ -3,14 +3,50 @@ package apperror
 import "errors"
 
 var (
-	ErrInvalidToken         = errors.New("invalid token")
-	ErrInvalidCredentials   = errors.New("invalid credentials")
-	ErrNotFound             = errors.New("resource not found")
-	ErrUnauthorized         = errors.New("unauthorized")
-	ErrInvalidTokenClaims   = errors.New("invalid token claims")
-	ErrInvalidTokenType     = errors.New("invalid token type")
-	ErrDatabaseConnection   = errors.New("database connection failed")
-	ErrGenerateAccessToken  = errors.New("failed to generate access token")
-	ErrGenerateRefreshToken = errors.New("failed to generate refresh token")
-	ErrDuplicateKey         = errors.New("duplicate key value violates unique constraint")
+	ErrInvalidToken           = errors.New("invalid token")
+	ErrInvalidCredentials     = errors.New("invalid credentials")
+	ErrNotFound               = errors.New("resource not found")
+	ErrUnauthorized           = errors.New("unauthorized")
+	ErrInvalidTokenClaims     = errors.New("invalid token claims")
+	ErrInvalidTokenType       = errors.New("invalid token type")
+	ErrDatabaseConnection     = errors.New("database connection failed")
+	ErrGenerateAccessToken    = errors.New("failed to generate access token")
+	ErrGenerateRefreshToken   = errors.New("failed to generate refresh token")
+	ErrDuplicateKey           = errors.New("duplicate key value violates unique constraint")
+	ErrInvalidParentComment   = errors.New("parent comment does not belong to this news")
+	ErrMaxCommentDepth        = errors.New("maximum comment nesting depth exceeded")
+	ErrInvalidCommentStatus   = errors.New("invalid comment status")
+	ErrCommentRejected        = errors.New("comment rejected by content filter")
+	ErrInvalidChallenge       = errors.New("invalid or expired challenge")
+	ErrInvalidProofOfWork     = errors.New("nonce does not solve the challenge")
+	ErrChallengeUsed          = errors.New("challenge already used")
+	ErrInvalidEditToken       = errors.New("invalid comment edit token")
+	ErrCommentNotEditable     = errors.New("comment can no longer be edited")
+	ErrCommentsClosed         = errors.New("comments are closed for this news")
+	ErrInvalidReaction        = errors.New("invalid reaction")
+	ErrInvalidVote            = errors.New("invalid vote")
+	ErrInvalidReportReason    = errors.New("invalid report reason")
+	ErrInvalidPath            = errors.New("invalid URL path")
+	ErrPathTooLong            = errors.New("URL path too long")
+	ErrReservedPath           = errors.New("URL path is reserved")
+	ErrInvalidRedirect        = errors.New("invalid redirect target")
+	ErrInvalidRedirectCode    = errors.New("invalid redirect status code")
+	ErrRedirectLoop           = errors.New("redirect would loop")
+	ErrInvalidParentPage      = errors.New("invalid parent page")
+	ErrInvalidSlug            = errors.New("invalid page slug")
+	ErrInvalidTheme           = errors.New("invalid theme")
+	ErrInvalidContentFormat   = errors.New("invalid content format")
+	ErrInvalidBlocks          = errors.New("invalid content blocks")
+	ErrUnknownView            = errors.New("unknown view")
+	ErrInvalidMenuName        = errors.New("invalid menu name")
+	ErrInvalidMenuItem        = errors.New("invalid menu item")
+	ErrMenuTooDeep            = errors.New("menu is nested too deeply")
+	ErrMediaTooLarge          = errors.New("media file too large")
+	ErrUnsupportedMedia       = errors.New("unsupported media type")
+	ErrMediaInUse             = errors.New("media is referenced by content")
+	ErrInvalidFeaturedMedia   = errors.New("featured media is not an image in the library")
+	ErrUnsupportedLocale      = errors.New("unsupported locale")
+	ErrInvalidTranslation     = errors.New("content to translate does not exist")
+	ErrTranslationExists      = errors.New("translation already exists in this locale")
+	ErrInvalidTranslationType = errors.New("invalid translation type")
 )
@@ -0,0 +1,369 @@
+// Package blocks parses, validates and renders structured content: a JSON
+// document holding a list of typed blocks such as paragraphs, headings and
+// images.
+//
+// The text of paragraphs, headings, quotes, list items and captions may hold
+// inline HTML, and html blocks hold raw HTML, so the rendered HTML is not
+// sanitized. Every other value is escaped.
+package blocks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/RizqiSugiarto/coding-test/pkg/sanitize"
+)
+
+// Block types.
+const (
+	TypeParagraph = "paragraph"
+	TypeHeading   = "heading"
+	TypeImage     = "image"
+	TypeQuote     = "quote"
+	TypeEmbed     = "embed"
+	TypeCode      = "code"
+	TypeList      = "list"
+	TypeHTML      = "html"
+)
+
+// List styles.
+const (
+	ListUnordered = "unordered"
+	ListOrdered   = "ordered"
+)
+
+// Reasons a document is invalid, stable for callers to describe them in
+// their own words.
+const (
+	ReasonDocument    = "document"
+	ReasonRequired    = "required"
+	ReasonType        = "type"
+	ReasonNotAllowed  = "not_allowed"
+	ReasonLevel       = "level"
+	ReasonURLOrPath   = "url_or_path"
+	ReasonAbsoluteURL = "absolute_url"
+	ReasonStyle       = "style"
+	ReasonNoItems     = "no_items"
+)
+
+// fields lists, per block type, the fields a block of that type may set.
+var fields = map[string][]string{
+	TypeParagraph: {"text"},
+	TypeHeading:   {"text", "level"},
+	TypeImage:     {"url", "alt", "caption"},
+	TypeQuote:     {"text", "cite"},
+	TypeEmbed:     {"url", "caption"},
+	TypeCode:      {"code", "language"},
+	TypeList:      {"style", "items"},
+	TypeHTML:      {"html"},
+}
+
+// Document is structured content.
+type Document struct {
+	Blocks []Block `json:"blocks"`
+}
+
+// Block is one block of a document. Which fields apply depends on its type.
+type Block struct {
+	Type     string   `json:"type"`
+	Text     string   `json:"text,omitempty"`
+	Level    int      `json:"level,omitempty"`
+	URL      string   `json:"url,omitempty"`
+	Alt      string   `json:"alt,omitempty"`
+	Caption  string   `json:"caption,omitempty"`
+	Cite     string   `json:"cite,omitempty"`
+	Code     string   `json:"code,omitempty"`
+	Language string   `json:"language,omitempty"`
+	Style    string   `json:"style,omitempty"`
+	Items    []string `json:"items,omitempty"`
+	HTML     string   `json:"html,omitempty"`
+}
+
+// Error tells which part of a document is invalid and why. It matches
+// apperror.ErrInvalidBlocks.
+type Error struct {
+	// Index is the position of the invalid block, or -1 when the document
+	// itself is invalid.
+	Index int
+	Field string
+	// Type is the type of the invalid block, empty for the document.
+	Type    string
+	Reason  string
+	Message string
+}
+
+// Path locates the invalid value, e.g. "blocks[2].level".
+func (e *Error) Path() string {
+	if e.Index < 0 {
+		return "blocks"
+	}
+
+	path := "blocks[" + strconv.Itoa(e.Index) + "]"
+	if e.Field != "" {
+		path += "." + e.Field
+	}
+
+	return path
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%v: %s %s", apperror.ErrInvalidBlocks, e.Path(), e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return apperror.ErrInvalidBlocks
+}
+
+// Parse reads a document from its JSON source and validates every block.
+func Parse(source string) (*Document, error) {
+	decoder := json.NewDecoder(strings.NewReader(source))
+	decoder.DisallowUnknownFields()
+
+	var doc Document
+	if err := decoder.Decode(&doc); err != nil || decoder.More() {
+		return nil, &Error{Index: -1, Reason: ReasonDocument, Message: "must be a JSON object with a list of blocks"}
+	}
+
+	if doc.Blocks == nil {
+		return nil, &Error{Index: -1, Reason: ReasonRequired, Message: "is required"}
+	}
+
+	for i := range doc.Blocks {
+		if err := doc.Blocks[i].validate(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return &doc, nil
+}
+
+func (b *Block) validate(index int) error {
+	invalid := func(field, reason, message string) error {
+		return &Error{Index: index, Field: field, Type: b.Type, Reason: reason, Message: message}
+	}
+
+	allowed, ok := fields[b.Type]
+	if !ok {
+		return invalid("type", ReasonType, "must be one of paragraph, heading, image, quote, embed, code, list or html")
+	}
+
+	for _, field := range b.set() {
+		if !slices.Contains(allowed, field) {
+			return invalid(field, ReasonNotAllowed, "is not allowed in "+b.Type+" blocks")
+		}
+	}
+
+	switch b.Type {
+	case TypeParagraph, TypeQuote:
+		if strings.TrimSpace(b.Text) == "" {
+			return invalid("text", ReasonRequired, "is required")
+		}
+	case TypeHeading:
+		if strings.TrimSpace(b.Text) == "" {
+			return invalid("text", ReasonRequired, "is required")
+		}
+
+		if b.Level < 1 || b.Level > 6 {
+			return invalid("level", ReasonLevel, "must be between 1 and 6")
+		}
+	case TypeImage:
+		if !validURL(b.URL, true) {
+			return invalid("url", ReasonURLOrPath, "must be an absolute http(s) URL or a site path")
+		}
+	case TypeEmbed:
+		if !validURL(b.URL, false) {
+			return invalid("url", ReasonAbsoluteURL, "must be an absolute http(s) URL")
+		}
+	case TypeCode:
+		if b.Code == "" {
+			return invalid("code", ReasonRequired, "is required")
+		}
+	case TypeList:
+		if b.Style != "" && b.Style != ListUnordered && b.Style != ListOrdered {
+			return invalid("style", ReasonStyle, "must be ordered or unordered")
+		}
+
+		if len(b.Items) == 0 {
+			return invalid("items", ReasonNoItems, "must list at least one item")
+		}
+
+		for j, item := range b.Items {
+			if strings.TrimSpace(item) == "" {
+				return invalid("items["+strconv.Itoa(j)+"]", ReasonRequired, "is required")
+			}
+		}
+	case TypeHTML:
+		if strings.TrimSpace(b.HTML) == "" {
+			return invalid("html", ReasonRequired, "is required")
+		}
+	}
+
+	return nil
+}
+
+// set returns the names of the fields set on the block besides its type.
+func (b *Block) set() []string {
+	var names []string
+
+	for name, isSet := range map[string]bool{
+		"text": b.Text != "", "level": b.Level != 0, "url": b.URL != "", "alt": b.Alt != "",
+		"caption": b.Caption != "", "cite": b.Cite != "", "code": b.Code != "",
+		"language": b.Language != "", "style": b.Style != "", "items": b.Items != nil, "html": b.HTML != "",
+	} {
+		if isSet {
+			names = append(names, name)
+		}
+	}
+
+	// Report the first offending field the same way every time
+	slices.Sort(names)
+
+	return names
+}
+
+// validURL reports whether raw is an absolute http(s) URL or, when sitePath
+// is set, a path on this site.
+func validURL(raw string, sitePath bool) bool {
+	u, err := url.Parse(raw)
+	if err != nil || raw == "" {
+		return false
+	}
+
+	if u.Scheme == "" {
+		return sitePath && u.Host == "" && strings.HasPrefix(u.Path, "/")
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// HTML renders the document to HTML, one element per block.
+func (d *Document) HTML() string {
+	var b bytes.Buffer
+
+	for _, block := range d.Blocks {
+		block.writeHTML(&b)
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func (b *Block) writeHTML(w *bytes.Buffer) {
+	switch b.Type {
+	case TypeParagraph:
+		fmt.Fprintf(w, "<p>%s</p>", b.Text)
+	case TypeHeading:
+		fmt.Fprintf(w, "<h%d>%s</h%d>", b.Level, b.Text, b.Level)
+	case TypeImage:
+		fmt.Fprintf(w, `<figure><img src="%s" alt="%s">`, html.EscapeString(b.URL), html.EscapeString(b.Alt))
+		writeCaption(w, b.Caption)
+		w.WriteString("</figure>")
+	case TypeQuote:
+		fmt.Fprintf(w, "<figure><blockquote><p>%s</p></blockquote>", b.Text)
+		writeCaption(w, html.EscapeString(b.Cite))
+		w.WriteString("</figure>")
+	case TypeEmbed:
+		// Embedded players are never kept by the sanitizer, so an embed is a
+		// link that frontends may turn into a player
+		href := html.EscapeString(b.URL)
+		fmt.Fprintf(w, `<figure class="embed"><a href="%s">%s</a>`, href, href)
+		writeCaption(w, b.Caption)
+		w.WriteString("</figure>")
+	case TypeCode:
+		w.WriteString("<pre><code")
+
+		if b.Language != "" {
+			fmt.Fprintf(w, ` class="language-%s"`, html.EscapeString(b.Language))
+		}
+
+		fmt.Fprintf(w, ">%s</code></pre>", html.EscapeString(b.Code))
+	case TypeList:
+		tag := "ul"
+		if b.Style == ListOrdered {
+			tag = "ol"
+		}
+
+		fmt.Fprintf(w, "<%s>", tag)
+
+		for _, item := range b.Items {
+			fmt.Fprintf(w, "<li>%s</li>", item)
+		}
+
+		fmt.Fprintf(w, "</%s>", tag)
+	case TypeHTML:
+		w.WriteString(b.HTML)
+	}
+}
+
+func writeCaption(w *bytes.Buffer, caption string) {
+	if caption != "" {
+		fmt.Fprintf(w, "<figcaption>%s</figcaption>", caption)
+	}
+}
+
+// Text renders the document to plain text, blocks separated by blank lines.
+// Images are represented by their caption or alt text and embeds by their
+// caption and URL.
+func (d *Document) Text() string {
+	var paragraphs []string
+
+	for _, block := range d.Blocks {
+		if text := block.text(); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+	}
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+func (b *Block) text() string {
+	switch b.Type {
+	case TypeParagraph, TypeHeading:
+		return sanitize.Text(b.Text)
+	case TypeImage:
+		if b.Caption != "" {
+			return sanitize.Text(b.Caption)
+		}
+
+		return strings.TrimSpace(b.Alt)
+	case TypeQuote:
+		text := sanitize.Text(b.Text)
+		if cite := strings.TrimSpace(b.Cite); cite != "" {
+			text += "\n— " + cite
+		}
+
+		return text
+	case TypeEmbed:
+		if caption := sanitize.Text(b.Caption); caption != "" {
+			return caption + "\n" + b.URL
+		}
+
+		return b.URL
+	case TypeCode:
+		return strings.TrimRight(b.Code, "\n")
+	case TypeList:
+		lines := make([]string, 0, len(b.Items))
+
+		for i, item := range b.Items {
+			marker := "-"
+			if b.Style == ListOrdered {
+				marker = strconv.Itoa(i+1) + "."
+			}
+
+			lines = append(lines, marker+" "+sanitize.Text(item))
+		}
+
+		return strings.Join(lines, "\n")
+	case TypeHTML:
+		return sanitize.Text(b.HTML)
+	}
+
+	return ""
+}
@@ -0,0 +1,204 @@
+package blocks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Valid(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{name: "empty document", source: `{"blocks":[]}`},
+		{name: "paragraph", source: `{"blocks":[{"type":"paragraph","text":"Hello"}]}`},
+		{name: "heading", source: `{"blocks":[{"type":"heading","text":"Title","level":6}]}`},
+		{name: "image with absolute URL", source: `{"blocks":[{"type":"image","url":"https://cdn.example.com/a.png","alt":"A"}]}`},
+		{name: "image with site path", source: `{"blocks":[{"type":"image","url":"/uploads/a.png","caption":"A"}]}`},
+		{name: "quote", source: `{"blocks":[{"type":"quote","text":"Wise words","cite":"Someone"}]}`},
+		{name: "embed", source: `{"blocks":[{"type":"embed","url":"http://video.example.com/v/1"}]}`},
+		{name: "code", source: `{"blocks":[{"type":"code","code":"x := 1","language":"go"}]}`},
+		{name: "list without style", source: `{"blocks":[{"type":"list","items":["a","b"]}]}`},
+		{name: "ordered list", source: `{"blocks":[{"type":"list","style":"ordered","items":["a"]}]}`},
+		{name: "html", source: `{"blocks":[{"type":"html","html":"<hr>"}]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.source)
+
+			require.NoError(t, err)
+			assert.NotNil(t, doc)
+		})
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		path   string
+		reason string
+	}{
+		{name: "not JSON", source: `blocks`, path: "blocks", reason: ReasonDocument},
+		{name: "not an object", source: `[]`, path: "blocks", reason: ReasonDocument},
+		{name: "unknown document field", source: `{"blocks":[],"extra":1}`, path: "blocks", reason: ReasonDocument},
+		{name: "trailing data", source: `{"blocks":[]}{}`, path: "blocks", reason: ReasonDocument},
+		{name: "missing blocks", source: `{}`, path: "blocks", reason: ReasonRequired},
+		{name: "null blocks", source: `{"blocks":null}`, path: "blocks", reason: ReasonRequired},
+		{name: "unknown block field", source: `{"blocks":[{"type":"paragraph","text":"a","color":"red"}]}`, path: "blocks", reason: ReasonDocument},
+		{name: "unknown type", source: `{"blocks":[{"type":"video","url":"https://a.example"}]}`, path: "blocks[0].type", reason: ReasonType},
+		{name: "missing type", source: `{"blocks":[{"text":"a"}]}`, path: "blocks[0].type", reason: ReasonType},
+		{name: "field of another type", source: `{"blocks":[{"type":"paragraph","text":"a","level":2}]}`, path: "blocks[0].level", reason: ReasonNotAllowed},
+		{name: "blank paragraph", source: `{"blocks":[{"type":"paragraph","text":"  "}]}`, path: "blocks[0].text", reason: ReasonRequired},
+		{name: "blank quote", source: `{"blocks":[{"type":"quote","cite":"a"}]}`, path: "blocks[0].text", reason: ReasonRequired},
+		{name: "heading without text", source: `{"blocks":[{"type":"heading","level":1}]}`, path: "blocks[0].text", reason: ReasonRequired},
+		{name: "heading without level", source: `{"blocks":[{"type":"heading","text":"a"}]}`, path: "blocks[0].level", reason: ReasonLevel},
+		{name: "heading level too high", source: `{"blocks":[{"type":"heading","text":"a","level":7}]}`, path: "blocks[0].level", reason: ReasonLevel},
+		{name: "image without URL", source: `{"blocks":[{"type":"image","alt":"a"}]}`, path: "blocks[0].url", reason: ReasonURLOrPath},
+		{name: "image with javascript URL", source: `{"blocks":[{"type":"image","url":"javascript:alert(1)"}]}`, path: "blocks[0].url", reason: ReasonURLOrPath},
+		{name: "image with relative path", source: `{"blocks":[{"type":"image","url":"uploads/a.png"}]}`, path: "blocks[0].url", reason: ReasonURLOrPath},
+		{name: "image with protocol-relative URL", source: `{"blocks":[{"type":"image","url":"//evil.example/a.png"}]}`, path: "blocks[0].url", reason: ReasonURLOrPath},
+		{name: "embed with site path", source: `{"blocks":[{"type":"embed","url":"/videos/1"}]}`, path: "blocks[0].url", reason: ReasonAbsoluteURL},
+		{name: "embed with ftp URL", source: `{"blocks":[{"type":"embed","url":"ftp://files.example.com/a"}]}`, path: "blocks[0].url", reason: ReasonAbsoluteURL},
+		{name: "code without code", source: `{"blocks":[{"type":"code","language":"go"}]}`, path: "blocks[0].code", reason: ReasonRequired},
+		{name: "list with unknown style", source: `{"blocks":[{"type":"list","style":"nested","items":["a"]}]}`, path: "blocks[0].style", reason: ReasonStyle},
+		{name: "list without items", source: `{"blocks":[{"type":"list","items":[]}]}`, path: "blocks[0].items", reason: ReasonNoItems},
+		{name: "list with blank item", source: `{"blocks":[{"type":"list","items":["a"," "]}]}`, path: "blocks[0].items[1]", reason: ReasonRequired},
+		{name: "blank html", source: `{"blocks":[{"type":"html","html":" "}]}`, path: "blocks[0].html", reason: ReasonRequired},
+		{
+			name:   "index of the first invalid block",
+			source: `{"blocks":[{"type":"paragraph","text":"a"},{"type":"heading","text":"b","level":0}]}`,
+			path:   "blocks[1].level",
+			reason: ReasonLevel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse(tt.source)
+
+			assert.Nil(t, doc)
+			require.ErrorIs(t, err, apperror.ErrInvalidBlocks)
+
+			var blockErr *Error
+			require.True(t, errors.As(err, &blockErr))
+			assert.Equal(t, tt.path, blockErr.Path())
+			assert.Equal(t, tt.reason, blockErr.Reason)
+		})
+	}
+}
+
+func TestParse_NotAllowedIsStable(t *testing.T) {
+	// Several foreign fields are reported in the same order every time
+	for range 20 {
+		_, err := Parse(`{"blocks":[{"type":"code","code":"x","url":"https://a.example","alt":"a","text":"t"}]}`)
+
+		var blockErr *Error
+		require.True(t, errors.As(err, &blockErr))
+		assert.Equal(t, "blocks[0].alt", blockErr.Path())
+		assert.Equal(t, TypeCode, blockErr.Type)
+	}
+}
+
+func TestDocument_HTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		block    Block
+		expected string
+	}{
+		{name: "paragraph keeps inline HTML", block: Block{Type: TypeParagraph, Text: "a <b>bold</b> move"}, expected: "<p>a <b>bold</b> move</p>\n"},
+		{name: "heading", block: Block{Type: TypeHeading, Text: "Title", Level: 3}, expected: "<h3>Title</h3>\n"},
+		{
+			name:     "image escapes attributes",
+			block:    Block{Type: TypeImage, URL: `/a.png?x=1&y="2"`, Alt: `a "quoted" <alt>`},
+			expected: `<figure><img src="/a.png?x=1&amp;y=&#34;2&#34;" alt="a &#34;quoted&#34; &lt;alt&gt;"></figure>` + "\n",
+		},
+		{
+			name:     "image with caption",
+			block:    Block{Type: TypeImage, URL: "/a.png", Caption: "By <i>me</i>"},
+			expected: `<figure><img src="/a.png" alt=""><figcaption>By <i>me</i></figcaption></figure>` + "\n",
+		},
+		{
+			name:     "quote escapes cite",
+			block:    Block{Type: TypeQuote, Text: "Wise", Cite: "<script>"},
+			expected: "<figure><blockquote><p>Wise</p></blockquote><figcaption>&lt;script&gt;</figcaption></figure>\n",
+		},
+		{name: "quote without cite", block: Block{Type: TypeQuote, Text: "Wise"}, expected: "<figure><blockquote><p>Wise</p></blockquote></figure>\n"},
+		{
+			name:     "embed is a link",
+			block:    Block{Type: TypeEmbed, URL: "https://v.example/?a=1&b=2", Caption: "Clip"},
+			expected: `<figure class="embed"><a href="https://v.example/?a=1&amp;b=2">https://v.example/?a=1&amp;b=2</a><figcaption>Clip</figcaption></figure>` + "\n",
+		},
+		{
+			name:     "code is escaped",
+			block:    Block{Type: TypeCode, Code: "if a < b && c {}", Language: `go"x`},
+			expected: `<pre><code class="language-go&#34;x">if a &lt; b &amp;&amp; c {}</code></pre>` + "\n",
+		},
+		{name: "code without language", block: Block{Type: TypeCode, Code: "x"}, expected: "<pre><code>x</code></pre>\n"},
+		{name: "unordered list", block: Block{Type: TypeList, Items: []string{"a", "<em>b</em>"}}, expected: "<ul><li>a</li><li><em>b</em></li></ul>\n"},
+		{name: "ordered list", block: Block{Type: TypeList, Style: ListOrdered, Items: []string{"a", "b"}}, expected: "<ol><li>a</li><li>b</li></ol>\n"},
+		{name: "html is raw", block: Block{Type: TypeHTML, HTML: `<div class="x">raw</div>`}, expected: `<div class="x">raw</div>` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &Document{Blocks: []Block{tt.block}}
+
+			assert.Equal(t, tt.expected, doc.HTML())
+		})
+	}
+}
+
+func TestDocument_HTML_JoinsBlocks(t *testing.T) {
+	doc, err := Parse(`{"blocks":[{"type":"heading","text":"T","level":1},{"type":"paragraph","text":"P"}]}`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "<h1>T</h1>\n<p>P</p>\n", doc.HTML())
+	assert.Empty(t, (&Document{Blocks: []Block{}}).HTML())
+}
+
+func TestDocument_Text(t *testing.T) {
+	tests := []struct {
+		name     string
+		block    Block
+		expected string
+	}{
+		{name: "paragraph drops tags", block: Block{Type: TypeParagraph, Text: "a <b>bold</b> move"}, expected: "a bold move"},
+		{name: "heading", block: Block{Type: TypeHeading, Text: "Title", Level: 2}, expected: "Title"},
+		{name: "image caption", block: Block{Type: TypeImage, URL: "/a.png", Alt: "alt", Caption: "By <i>me</i>"}, expected: "By me"},
+		{name: "image alt", block: Block{Type: TypeImage, URL: "/a.png", Alt: " alt "}, expected: "alt"},
+		{name: "image without text", block: Block{Type: TypeImage, URL: "/a.png"}, expected: ""},
+		{name: "quote with cite", block: Block{Type: TypeQuote, Text: "Wise", Cite: "Someone"}, expected: "Wise\n— Someone"},
+		{name: "quote without cite", block: Block{Type: TypeQuote, Text: "Wise"}, expected: "Wise"},
+		{name: "embed with caption", block: Block{Type: TypeEmbed, URL: "https://v.example/1", Caption: "Clip"}, expected: "Clip\nhttps://v.example/1"},
+		{name: "embed without caption", block: Block{Type: TypeEmbed, URL: "https://v.example/1"}, expected: "https://v.example/1"},
+		{name: "code keeps markup", block: Block{Type: TypeCode, Code: "<b>x</b>\n\n"}, expected: "<b>x</b>"},
+		{name: "unordered list", block: Block{Type: TypeList, Items: []string{"a", "<em>b</em>"}}, expected: "- a\n- b"},
+		{name: "ordered list", block: Block{Type: TypeList, Style: ListOrdered, Items: []string{"a", "b"}}, expected: "1. a\n2. b"},
+		{name: "html drops tags", block: Block{Type: TypeHTML, HTML: "<div>raw</div>"}, expected: "raw"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &Document{Blocks: []Block{tt.block}}
+
+			assert.Equal(t, tt.expected, doc.Text())
+		})
+	}
+}
+
+func TestDocument_Text_JoinsBlocks(t *testing.T) {
+	doc := &Document{Blocks: []Block{
+		{Type: TypeHeading, Text: "T", Level: 1},
+		{Type: TypeImage, URL: "/a.png"},
+		{Type: TypeParagraph, Text: "P"},
+	}}
+
+	// Blocks without text leave no empty paragraph behind
+	assert.Equal(t, "T\n\nP", doc.Text())
+}
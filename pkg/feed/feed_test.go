@@ -0,0 +1,154 @@
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testPublished = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	testUpdated   = time.Date(2024, time.March, 2, 8, 30, 0, 0, time.UTC)
+)
+
+// testFeed holds the characters XML must escape in every value written.
+func testFeed() *Feed {
+	return &Feed{
+		Title:       `News & "Views" <daily>`,
+		Description: "Tom's <b>picks</b> & more",
+		Link:        "https://example.com/?a=1&b=2",
+		Self:        "https://example.com/feeds/tags/r%26d.rss",
+		Updated:     testUpdated,
+		Items: []Item{
+			{
+				ID:        "urn:uuid:550e8400-e29b-41d4-a716-446655440000",
+				Title:     "1 < 2 && 3 > 2",
+				Link:      "https://example.com/news/1?ref=feed&x=\"y\"",
+				Summary:   "Plain <text> with ]]> inside",
+				Content:   `<p class="lead">Hello &amp; <script>alert('x')</script></p>`,
+				Published: testPublished,
+				Updated:   testUpdated,
+			},
+		},
+	}
+}
+
+func TestWriteRSS(t *testing.T) {
+	var body bytes.Buffer
+	require.NoError(t, WriteRSS(&body, testFeed()))
+
+	out := body.String()
+	assert.True(t, strings.HasPrefix(out, xml.Header))
+	assert.Contains(t, out, `<title>News &amp; &#34;Views&#34; &lt;daily&gt;</title>`)
+	assert.Contains(t, out, `<link>https://example.com/?a=1&amp;b=2</link>`)
+	assert.Contains(t, out, `<atom:link href="https://example.com/feeds/tags/r%26d.rss" rel="self" type="application/rss+xml"></atom:link>`)
+	assert.Contains(t, out, `<description>Plain &lt;text&gt; with ]]&gt; inside</description>`)
+	assert.Contains(t, out, `<content:encoded>&lt;p class=&#34;lead&#34;&gt;Hello &amp;amp; &lt;script&gt;`)
+	assert.NotContains(t, out, "<script>")
+	assert.Contains(t, out, `<guid isPermaLink="false">urn:uuid:550e8400-e29b-41d4-a716-446655440000</guid>`)
+	assert.Contains(t, out, "<pubDate>Fri, 01 Mar 2024 05:00:00 +0000</pubDate>")
+	assert.Contains(t, out, "<lastBuildDate>Sat, 02 Mar 2024 08:30:00 +0000</lastBuildDate>")
+
+	// Every value reads back as it was written
+	var doc rss
+	require.NoError(t, xml.Unmarshal(body.Bytes(), &doc))
+
+	f := testFeed()
+	assert.Equal(t, f.Title, doc.Channel.Title)
+	assert.Equal(t, f.Description, doc.Channel.Description)
+	require.Len(t, doc.Channel.Items, 1)
+	assert.Equal(t, f.Items[0].Title, doc.Channel.Items[0].Title)
+	assert.Equal(t, f.Items[0].Link, doc.Channel.Items[0].Link)
+	assert.Equal(t, f.Items[0].Summary, doc.Channel.Items[0].Description)
+}
+
+func TestWriteRSS_WithoutContent(t *testing.T) {
+	f := testFeed()
+	f.Items[0].Content = ""
+
+	var body bytes.Buffer
+	require.NoError(t, WriteRSS(&body, f))
+
+	assert.NotContains(t, body.String(), "content:encoded>")
+}
+
+func TestWriteAtom(t *testing.T) {
+	var body bytes.Buffer
+	require.NoError(t, WriteAtom(&body, testFeed()))
+
+	out := body.String()
+	assert.True(t, strings.HasPrefix(out, xml.Header))
+	assert.Contains(t, out, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	assert.Contains(t, out, `<title>News &amp; &#34;Views&#34; &lt;daily&gt;</title>`)
+	assert.Contains(t, out, `<subtitle>Tom&#39;s &lt;b&gt;picks&lt;/b&gt; &amp; more</subtitle>`)
+	assert.Contains(t, out, `<link href="https://example.com/?a=1&amp;b=2" rel="alternate" type="text/html"></link>`)
+	assert.Contains(t, out, `<link href="https://example.com/news/1?ref=feed&amp;x=&#34;y&#34;" rel="alternate" type="text/html"></link>`)
+	assert.Contains(t, out, `<summary type="text">Plain &lt;text&gt; with ]]&gt; inside</summary>`)
+	assert.Contains(t, out, `<content type="html">&lt;p class=&#34;lead&#34;&gt;Hello &amp;amp; &lt;script&gt;`)
+	assert.NotContains(t, out, "<script>")
+	assert.Contains(t, out, "<published>2024-03-01T05:00:00Z</published>")
+	assert.Contains(t, out, "<updated>2024-03-02T08:30:00Z</updated>")
+
+	// Every value reads back as it was written
+	var doc atomFeed
+	require.NoError(t, xml.Unmarshal(body.Bytes(), &doc))
+
+	f := testFeed()
+	assert.Equal(t, f.Title, doc.Title)
+	assert.Equal(t, f.Description, doc.Subtitle)
+	assert.Equal(t, f.Self, doc.ID)
+	require.Len(t, doc.Entries, 1)
+	assert.Equal(t, f.Items[0].Title, doc.Entries[0].Title)
+	assert.Equal(t, f.Items[0].Link, doc.Entries[0].Link.Href)
+	require.NotNil(t, doc.Entries[0].Summary)
+	assert.Equal(t, f.Items[0].Summary, doc.Entries[0].Summary.Body)
+	require.NotNil(t, doc.Entries[0].Content)
+	assert.Equal(t, f.Items[0].Content, doc.Entries[0].Content.Body)
+}
+
+func TestWriteAtom_WithoutSummaryOrContent(t *testing.T) {
+	f := testFeed()
+	f.Items[0].Summary = ""
+	f.Items[0].Content = ""
+
+	var body bytes.Buffer
+	require.NoError(t, WriteAtom(&body, f))
+
+	assert.NotContains(t, body.String(), "<summary")
+	assert.NotContains(t, body.String(), "<content")
+}
+
+func TestWrite_EmptyFeed(t *testing.T) {
+	tests := []struct {
+		name     string
+		write    func(*bytes.Buffer, *Feed) error
+		expected string
+	}{
+		{
+			name:     "rss",
+			write:    func(b *bytes.Buffer, f *Feed) error { return WriteRSS(b, f) },
+			expected: "<lastBuildDate>Thu, 01 Jan 1970 00:00:00 +0000</lastBuildDate>",
+		},
+		{
+			name:     "atom",
+			write:    func(b *bytes.Buffer, f *Feed) error { return WriteAtom(b, f) },
+			expected: "<updated>1970-01-01T00:00:00Z</updated>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			require.NoError(t, tt.write(&body, &Feed{Title: "News", Link: "https://example.com/"}))
+
+			assert.Contains(t, body.String(), tt.expected)
+			assert.NotContains(t, body.String(), "<item>")
+			assert.NotContains(t, body.String(), "<entry>")
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+)
+
+// JPEG markers.
+const (
+	markerSOI  = 0xD8
+	markerEOI  = 0xD9
+	markerSOS  = 0xDA
+	markerAPP0 = 0xE0
+	markerAPP1 = 0xE1
+	markerIPTC = 0xED // APP13, Photoshop and IPTC metadata
+	markerCOM  = 0xFE
+)
+
+const tagOrientation = 0x0112
+
+var (
+	errNotJPEG      = errors.New("imaging: not a JPEG image")
+	errInvalidJPEG  = errors.New("imaging: malformed JPEG segment")
+	exifHeader      = []byte("Exif\x00\x00")
+	segmentsToStrip = []byte{markerAPP1, markerIPTC, markerCOM}
+)
+
+// segment is a JPEG marker segment before the image data, its payload
+// excluding the length.
+type segment struct {
+	marker  byte
+	payload []byte
+}
+
+// readSegments splits a JPEG image into the marker segments in front of the
+// first scan and the rest of the file, starting with the SOS marker.
+func readSegments(data []byte) (segments []segment, rest []byte, err error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != markerSOI {
+		return nil, nil, errNotJPEG
+	}
+
+	for i := 2; ; {
+		// Markers may be preceded by any number of fill bytes
+		for i < len(data) && data[i] == 0xFF {
+			i++
+		}
+
+		if i >= len(data) || data[i-1] != 0xFF {
+			return nil, nil, errInvalidJPEG
+		}
+
+		marker := data[i]
+		if marker == markerSOS || marker == markerEOI {
+			return segments, data[i-1:], nil
+		}
+
+		if i+3 > len(data) {
+			return nil, nil, errInvalidJPEG
+		}
+
+		length := int(binary.BigEndian.Uint16(data[i+1:]))
+		if length < 2 || i+1+length > len(data) {
+			return nil, nil, errInvalidJPEG
+		}
+
+		segments = append(segments, segment{marker: marker, payload: data[i+3 : i+1+length]})
+		i += 1 + length
+	}
+}
+
+// Orientation returns the EXIF orientation of a JPEG image, from 1 (upright)
+// to 8. Images without one, or that are not JPEG, are upright.
+func Orientation(data []byte) int {
+	segments, _, err := readSegments(data)
+	if err != nil {
+		return 1
+	}
+
+	for _, seg := range segments {
+		if seg.marker == markerAPP1 && bytes.HasPrefix(seg.payload, exifHeader) {
+			if orientation := exifOrientation(seg.payload[len(exifHeader):]); orientation != 0 {
+				return orientation
+			}
+		}
+	}
+
+	return 1
+}
+
+// exifOrientation reads the orientation tag of the first IFD of TIFF data,
+// or returns 0.
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+
+		if order.Uint16(tiff[entry:]) != tagOrientation {
+			continue
+		}
+
+		if value := int(order.Uint16(tiff[entry+8:])); value >= 1 && value <= 8 {
+			return value
+		}
+
+		return 0
+	}
+
+	return 0
+}
+
+// StripMetadata removes EXIF, XMP, IPTC and comment segments from a JPEG
+// image without re-encoding it, so camera details and locations are not
+// published. The orientation is kept in a minimal EXIF segment. Color
+// profiles are kept.
+func StripMetadata(data []byte) ([]byte, error) {
+	segments, rest, err := readSegments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	orientation := Orientation(data)
+
+	var out bytes.Buffer
+
+	out.Grow(len(data))
+	out.Write([]byte{0xFF, markerSOI})
+
+	// JFIF requires its APP0 segment right after SOI
+	if len(segments) > 0 && segments[0].marker == markerAPP0 {
+		writeSegment(&out, segments[0])
+		segments = segments[1:]
+	}
+
+	if orientation != 1 {
+		writeSegment(&out, segment{marker: markerAPP1, payload: orientationExif(orientation)})
+	}
+
+	for _, seg := range segments {
+		if !bytes.Contains(segmentsToStrip, []byte{seg.marker}) {
+			writeSegment(&out, seg)
+		}
+	}
+
+	out.Write(rest)
+
+	return out.Bytes(), nil
+}
+
+func writeSegment(out *bytes.Buffer, seg segment) {
+	out.Write([]byte{0xFF, seg.marker})
+	_ = binary.Write(out, binary.BigEndian, uint16(len(seg.payload)+2)) //nolint:errcheck // bytes.Buffer does not fail
+	out.Write(seg.payload)
+}
+
+// orientationExif builds an EXIF payload holding only an orientation.
+func orientationExif(orientation int) []byte {
+	payload := append([]byte{}, exifHeader...)
+	payload = append(payload, 'M', 'M', 0, 42, 0, 0, 0, 8) // big endian, first IFD at 8
+	payload = append(payload, 0, 1)                        // one entry
+	payload = append(payload, tagOrientation>>8, tagOrientation&0xFF, 0, 3, 0, 0, 0, 1, 0, byte(orientation), 0, 0)
+	payload = append(payload, 0, 0, 0, 0) // no next IFD
+
+	return payload
+}
@@ -0,0 +1,233 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testTag is an IFD entry holding a single SHORT value.
+type testTag struct {
+	id    uint16
+	value uint16
+}
+
+// testTIFF builds TIFF data in the given byte order whose first IFD holds
+// tags.
+func testTIFF(order binary.AppendByteOrder, tags ...testTag) []byte {
+	var data []byte
+
+	if order == binary.LittleEndian {
+		data = append(data, 'I', 'I')
+	} else {
+		data = append(data, 'M', 'M')
+	}
+
+	data = order.AppendUint16(data, 42)
+	data = order.AppendUint32(data, 8)
+	data = order.AppendUint16(data, uint16(len(tags)))
+
+	for _, tag := range tags {
+		data = order.AppendUint16(data, tag.id)
+		data = order.AppendUint16(data, 3) // SHORT
+		data = order.AppendUint32(data, 1)
+		data = order.AppendUint16(data, tag.value)
+		data = append(data, 0, 0)
+	}
+
+	return order.AppendUint32(data, 0) // no next IFD
+}
+
+// testSegment encodes a JPEG marker segment.
+func testSegment(marker byte, payload []byte) []byte {
+	data := []byte{0xFF, marker}
+	data = binary.BigEndian.AppendUint16(data, uint16(len(payload)+2))
+
+	return append(data, payload...)
+}
+
+// testJPEG wraps segments between SOI and a scan, enough to read the
+// segments but not to decode the image.
+func testJPEG(segments ...[]byte) []byte {
+	data := []byte{0xFF, markerSOI}
+	for _, seg := range segments {
+		data = append(data, seg...)
+	}
+
+	return append(data, 0xFF, markerSOS, 0, 2, 0xFF, markerEOI)
+}
+
+func exifSegment(tiff []byte) []byte {
+	return testSegment(markerAPP1, append(append([]byte{}, exifHeader...), tiff...))
+}
+
+func TestOrientation(t *testing.T) {
+	orders := map[string]binary.AppendByteOrder{
+		"little endian": binary.LittleEndian,
+		"big endian":    binary.BigEndian,
+	}
+
+	for name, order := range orders {
+		for orientation := 1; orientation <= 8; orientation++ {
+			t.Run(fmt.Sprintf("%s orientation %d", name, orientation), func(t *testing.T) {
+				tiff := testTIFF(order, testTag{id: 0x010F, value: 7}, testTag{id: tagOrientation, value: uint16(orientation)})
+
+				assert.Equal(t, orientation, Orientation(testJPEG(exifSegment(tiff))))
+			})
+		}
+	}
+
+	valid := testTIFF(binary.BigEndian, testTag{id: tagOrientation, value: 6})
+	untagged := testTIFF(binary.BigEndian, testTag{id: 0x010F, value: 7})
+
+	tests := []struct {
+		name     string
+		data     []byte
+		expected int
+	}{
+		{
+			name:     "not a JPEG",
+			data:     []byte("\x89PNG\r\n\x1a\n"),
+			expected: 1,
+		},
+		{
+			name:     "no EXIF segment",
+			data:     testJPEG(testSegment(markerAPP0, []byte("JFIF\x00"))),
+			expected: 1,
+		},
+		{
+			name:     "XMP segment before EXIF",
+			data:     testJPEG(testSegment(markerAPP1, []byte("http://ns.adobe.com/xap/1.0/\x00<x/>")), exifSegment(valid)),
+			expected: 6,
+		},
+		{
+			name:     "APP1 without EXIF header",
+			data:     testJPEG(testSegment(markerAPP1, valid)),
+			expected: 1,
+		},
+		{
+			name:     "truncated TIFF header",
+			data:     testJPEG(exifSegment(valid[:6])),
+			expected: 1,
+		},
+		{
+			name:     "unknown byte order",
+			data:     testJPEG(exifSegment(append([]byte("XX"), valid[2:]...))),
+			expected: 1,
+		},
+		{
+			name:     "IFD offset past the data",
+			data:     testJPEG(exifSegment(append(append([]byte{}, valid[:4]...), 0, 0, 1, 0))),
+			expected: 1,
+		},
+		{
+			name:     "IFD offset inside the header",
+			data:     testJPEG(exifSegment(append(append([]byte{}, valid[:4]...), 0, 0, 0, 4))),
+			expected: 1,
+		},
+		{
+			name:     "entry count past the data",
+			data:     testJPEG(exifSegment(append(append(append([]byte{}, untagged[:8]...), 0, 9), untagged[10:]...))),
+			expected: 1,
+		},
+		{
+			name:     "truncated IFD entry",
+			data:     testJPEG(exifSegment(valid[:16])),
+			expected: 1,
+		},
+		{
+			name:     "orientation out of range",
+			data:     testJPEG(exifSegment(testTIFF(binary.LittleEndian, testTag{id: tagOrientation, value: 9}))),
+			expected: 1,
+		},
+		{
+			name:     "zero orientation",
+			data:     testJPEG(exifSegment(testTIFF(binary.LittleEndian, testTag{id: tagOrientation}))),
+			expected: 1,
+		},
+		{
+			name:     "segment length past the data",
+			data:     []byte{0xFF, markerSOI, 0xFF, markerAPP1, 0xFF, 0xFF, 'E', 'x', 'i', 'f'},
+			expected: 1,
+		},
+		{
+			name:     "segment length below its own size",
+			data:     []byte{0xFF, markerSOI, 0xFF, markerAPP1, 0, 1, 0xFF, markerSOS},
+			expected: 1,
+		},
+		{
+			name:     "segment without a marker",
+			data:     append([]byte{0xFF, markerSOI, 0}, exifSegment(valid)...),
+			expected: 1,
+		},
+		{
+			name:     "truncated marker",
+			data:     []byte{0xFF, markerSOI, 0xFF, markerAPP1, 0},
+			expected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Orientation(tt.data))
+		})
+	}
+}
+
+func TestStripMetadata(t *testing.T) {
+	jfif := testSegment(markerAPP0, []byte("JFIF\x00\x01\x01\x00\x00\x01\x00\x01\x00\x00"))
+	icc := testSegment(0xE2, []byte("ICC_PROFILE\x00\x01\x01profile"))
+	xmp := testSegment(markerAPP1, []byte("http://ns.adobe.com/xap/1.0/\x00<x:gps/>"))
+	iptc := testSegment(markerIPTC, []byte("Photoshop 3.0\x00byline"))
+	comment := testSegment(markerCOM, []byte("secret location"))
+
+	t.Run("keeps JFIF and color profiles and drops the rest", func(t *testing.T) {
+		tiff := testTIFF(binary.LittleEndian, testTag{id: 0x8825, value: 1}, testTag{id: tagOrientation, value: 1})
+		data := testJPEG(jfif, exifSegment(tiff), xmp, icc, iptc, comment)
+
+		stripped, err := StripMetadata(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, testJPEG(jfif, icc), stripped)
+	})
+
+	t.Run("keeps the orientation in a minimal EXIF segment after JFIF", func(t *testing.T) {
+		for orientation := 2; orientation <= 8; orientation++ {
+			tiff := testTIFF(binary.LittleEndian, testTag{id: 0x010F, value: 7}, testTag{id: tagOrientation, value: uint16(orientation)})
+			data := testJPEG(jfif, exifSegment(tiff), comment)
+
+			stripped, err := StripMetadata(data)
+
+			require.NoError(t, err)
+			assert.Equal(t, orientation, Orientation(stripped))
+			assert.True(t, bytes.HasPrefix(stripped[2:], jfif))
+			assert.Equal(t, testJPEG(jfif, testSegment(markerAPP1, orientationExif(orientation))), stripped)
+		}
+	})
+
+	t.Run("keeps the scan as it is", func(t *testing.T) {
+		data := append(testJPEG(comment), []byte("trailing")...)
+
+		stripped, err := StripMetadata(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, append(testJPEG(), []byte("trailing")...), stripped)
+	})
+
+	t.Run("error - not a JPEG", func(t *testing.T) {
+		_, err := StripMetadata([]byte("GIF89a"))
+
+		assert.ErrorIs(t, err, errNotJPEG)
+	})
+
+	t.Run("error - truncated segment", func(t *testing.T) {
+		// Cut inside the comment segment
+		_, err := StripMetadata(testJPEG(comment)[:10])
+
+		assert.ErrorIs(t, err, errInvalidJPEG)
+	})
+}
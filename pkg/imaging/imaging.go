@@ -0,0 +1,315 @@
+// Package imaging decodes, orients, crops, resizes and encodes JPEG, PNG and
+// GIF images in pure Go, to derive the sizes of uploaded images, and strips
+// the metadata of JPEG and PNG originals.
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // registers GIF for image.Decode
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Formats images are decoded from and encoded to.
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+)
+
+// Size is a configured derivative size. Images are scaled down to Width,
+// keeping their aspect ratio, or, when Height is set too, cropped to
+// Width × Height first.
+type Size struct {
+	Name   string
+	Width  int
+	Height int
+}
+
+// Crop reports whether images are cropped to the size's aspect ratio.
+func (s Size) Crop() bool {
+	return s.Height > 0
+}
+
+// Fit returns the dimensions of a width × height image scaled to the size.
+// Images are never enlarged: smaller images keep their dimensions, or are
+// only cropped to the aspect ratio.
+func (s Size) Fit(width, height int) (w, h int) {
+	if width <= 0 || height <= 0 {
+		return 0, 0
+	}
+
+	if !s.Crop() {
+		if width <= s.Width {
+			return width, height
+		}
+
+		return s.Width, max(1, int(math.Round(float64(height)*float64(s.Width)/float64(width))))
+	}
+
+	if width >= s.Width && height >= s.Height {
+		return s.Width, s.Height
+	}
+
+	scale := math.Min(float64(width)/float64(s.Width), float64(height)/float64(s.Height))
+
+	return max(1, int(float64(s.Width)*scale)), max(1, int(float64(s.Height)*scale))
+}
+
+// ParseSizes reads sizes written as name:width or name:widthxheight, e.g.
+// "thumbnail:150x150" or "medium:960".
+func ParseSizes(specs []string) ([]Size, error) {
+	sizes := make([]Size, 0, len(specs))
+	seen := make(map[string]bool, len(specs))
+
+	for _, spec := range specs {
+		name, dims, ok := strings.Cut(strings.TrimSpace(spec), ":")
+		if !ok || name == "" || strings.ContainsAny(name, "/.") || seen[name] {
+			return nil, fmt.Errorf("imaging: invalid size %q", spec)
+		}
+
+		widthText, heightText, crop := strings.Cut(dims, "x")
+
+		size := Size{Name: name}
+
+		var err error
+
+		if size.Width, err = strconv.Atoi(widthText); err != nil || size.Width <= 0 {
+			return nil, fmt.Errorf("imaging: invalid width in size %q", spec)
+		}
+
+		if crop {
+			if size.Height, err = strconv.Atoi(heightText); err != nil || size.Height <= 0 {
+				return nil, fmt.Errorf("imaging: invalid height in size %q", spec)
+			}
+		}
+
+		seen[name] = true
+		sizes = append(sizes, size)
+	}
+
+	return sizes, nil
+}
+
+// Decode decodes an image and turns JPEG images upright according to their
+// EXIF orientation.
+func Decode(data []byte) (image.Image, string, error) {
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if format == FormatJPEG {
+		img = Orient(img, Orientation(data))
+	}
+
+	return img, format, nil
+}
+
+// Orient applies an EXIF orientation to img, so that it displays upright.
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+
+			switch orientation {
+			case 2: // mirrored
+				sx, sy = w-1-x, y
+			case 3: // rotated 180°
+				sx, sy = w-1-x, h-1-y
+			case 4: // flipped
+				sx, sy = x, h-1-y
+			case 5: // transposed
+				sx, sy = y, x
+			case 6: // rotated 90° clockwise
+				sx, sy = y, h-1-x
+			case 7: // transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // rotated 90° counter-clockwise
+				sx, sy = w-1-y, x
+			}
+
+			dst.Set(x, y, img.At(b.Min.X+sx, b.Min.Y+sy))
+		}
+	}
+
+	return dst
+}
+
+// Derive scales img to size, cropping it around the focal point (fractions
+// of the width and height, 0.5 being the center) when the size crops.
+func Derive(img image.Image, size Size, focalX, focalY float64) *image.RGBA {
+	b := img.Bounds()
+	w, h := size.Fit(b.Dx(), b.Dy())
+
+	area := b
+	if size.Crop() {
+		area = cropArea(b, w, h, focalX, focalY)
+	}
+
+	return resize(img, area, w, h)
+}
+
+// cropArea is the largest part of bounds with the aspect ratio of w × h,
+// centered on the focal point as far as the bounds allow.
+func cropArea(bounds image.Rectangle, w, h int, focalX, focalY float64) image.Rectangle {
+	bw, bh := bounds.Dx(), bounds.Dy()
+
+	cw, ch := bw, int(math.Round(float64(bw)*float64(h)/float64(w)))
+	if ch > bh {
+		cw, ch = int(math.Round(float64(bh)*float64(w)/float64(h))), bh
+	}
+
+	x := clamp(int(math.Round(focalX*float64(bw)-float64(cw)/2)), 0, bw-cw)
+	y := clamp(int(math.Round(focalY*float64(bh)-float64(ch)/2)), 0, bh-ch)
+
+	return image.Rect(x, y, x+cw, y+ch).Add(bounds.Min)
+}
+
+func clamp(v, lo, hi int) int {
+	return min(max(v, lo), hi)
+}
+
+// contribution is the weight of a source pixel in a target pixel.
+type contribution struct {
+	index  int
+	weight float32
+}
+
+// contributions maps each of dstLen target pixels to the source pixels it
+// covers when srcLen pixels are scaled to dstLen, weighted by how much of
+// each it covers.
+func contributions(srcLen, dstLen int) [][]contribution {
+	scale := float64(srcLen) / float64(dstLen)
+	result := make([][]contribution, dstLen)
+
+	for i := range result {
+		start, end := float64(i)*scale, float64(i+1)*scale
+
+		for j := int(start); j < srcLen && float64(j) < end; j++ {
+			covered := math.Min(end, float64(j+1)) - math.Max(start, float64(j))
+			if covered > 0 {
+				result[i] = append(result[i], contribution{index: j, weight: float32(covered / scale)})
+			}
+		}
+	}
+
+	return result
+}
+
+// resize scales the area of img to w × h by averaging the source pixels
+// each target pixel covers, horizontally and then vertically. Colors are
+// averaged premultiplied, so transparent pixels do not bleed.
+func resize(img image.Image, area image.Rectangle, w, h int) *image.RGBA {
+	src := image.NewRGBA(image.Rect(0, 0, area.Dx(), area.Dy()))
+	draw.Draw(src, src.Bounds(), img, area.Min, draw.Src)
+
+	sw, sh := area.Dx(), area.Dy()
+	columns, rows := contributions(sw, w), contributions(sh, h)
+
+	// Horizontal pass into a w × sh buffer
+	tmp := make([]float32, w*sh*4)
+
+	for y := 0; y < sh; y++ {
+		line := src.Pix[y*src.Stride:]
+
+		for x, contribs := range columns {
+			var r, g, b, a float32
+
+			for _, c := range contribs {
+				p := line[c.index*4:]
+				r += float32(p[0]) * c.weight
+				g += float32(p[1]) * c.weight
+				b += float32(p[2]) * c.weight
+				a += float32(p[3]) * c.weight
+			}
+
+			t := tmp[(y*w+x)*4:]
+			t[0], t[1], t[2], t[3] = r, g, b, a
+		}
+	}
+
+	// Vertical pass into the result
+	dst := image.NewRGBA(image.Rect(0, 0, w, h))
+
+	for y, contribs := range rows {
+		for x := 0; x < w; x++ {
+			var r, g, b, a float32
+
+			for _, c := range contribs {
+				t := tmp[(c.index*w+x)*4:]
+				r += t[0] * c.weight
+				g += t[1] * c.weight
+				b += t[2] * c.weight
+				a += t[3] * c.weight
+			}
+
+			p := dst.Pix[y*dst.Stride+x*4:]
+			p[0], p[1], p[2], p[3] = channel(r), channel(g), channel(b), channel(a)
+		}
+	}
+
+	return dst
+}
+
+func channel(v float32) uint8 {
+	return uint8(min(max(math.Round(float64(v)), 0), math.MaxUint8))
+}
+
+// Encode writes img as JPEG at the given quality, or else as PNG. No
+// metadata is written.
+func Encode(w io.Writer, img image.Image, format string, quality int) error {
+	if format == FormatJPEG {
+		return jpeg.Encode(w, img, &jpeg.Options{Quality: quality})
+	}
+
+	return png.Encode(w, img)
+}
+
+// DerivedFormat is the format derivatives of an image in format are
+// encoded in: JPEG stays JPEG and everything else, e.g. a GIF's first
+// frame, becomes PNG.
+func DerivedFormat(format string) string {
+	if format == FormatJPEG {
+		return FormatJPEG
+	}
+
+	return FormatPNG
+}
+
+// DecodeConfig returns the format and upright dimensions of an image without
+// decoding it.
+func DecodeConfig(data []byte) (format string, width, height int, err error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", 0, 0, err
+	}
+
+	width, height = config.Width, config.Height
+	if format == FormatJPEG && Orientation(data) >= 5 {
+		width, height = height, width
+	}
+
+	return format, width, height, nil
+}
@@ -0,0 +1,187 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testPixels is a 3 × 2 image whose pixels are told apart by their gray
+// level: A B C on the top row and D E F below.
+var testPixels = [][]uint8{
+	{'A', 'B', 'C'},
+	{'D', 'E', 'F'},
+}
+
+func grayImage(rows [][]uint8) *image.Gray {
+	img := image.NewGray(image.Rect(0, 0, len(rows[0]), len(rows)))
+
+	for y, row := range rows {
+		for x, v := range row {
+			img.SetGray(x, y, color.Gray{Y: v})
+		}
+	}
+
+	return img
+}
+
+func grayRows(img image.Image) [][]uint8 {
+	b := img.Bounds()
+	rows := make([][]uint8, b.Dy())
+
+	for y := range rows {
+		rows[y] = make([]uint8, b.Dx())
+		for x := range rows[y] {
+			gray, _ := color.GrayModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.Gray)
+			rows[y][x] = gray.Y
+		}
+	}
+
+	return rows
+}
+
+func TestOrient(t *testing.T) {
+	tests := []struct {
+		name        string
+		orientation int
+		expected    [][]uint8
+	}{
+		{name: "upright", orientation: 1, expected: [][]uint8{{'A', 'B', 'C'}, {'D', 'E', 'F'}}},
+		{name: "mirrored", orientation: 2, expected: [][]uint8{{'C', 'B', 'A'}, {'F', 'E', 'D'}}},
+		{name: "rotated 180°", orientation: 3, expected: [][]uint8{{'F', 'E', 'D'}, {'C', 'B', 'A'}}},
+		{name: "flipped", orientation: 4, expected: [][]uint8{{'D', 'E', 'F'}, {'A', 'B', 'C'}}},
+		{name: "transposed", orientation: 5, expected: [][]uint8{{'A', 'D'}, {'B', 'E'}, {'C', 'F'}}},
+		{name: "rotated 90° clockwise", orientation: 6, expected: [][]uint8{{'D', 'A'}, {'E', 'B'}, {'F', 'C'}}},
+		{name: "transversed", orientation: 7, expected: [][]uint8{{'F', 'C'}, {'E', 'B'}, {'D', 'A'}}},
+		{name: "rotated 90° counter-clockwise", orientation: 8, expected: [][]uint8{{'C', 'F'}, {'B', 'E'}, {'A', 'D'}}},
+		{name: "unknown orientation", orientation: 9, expected: [][]uint8{{'A', 'B', 'C'}, {'D', 'E', 'F'}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, grayRows(Orient(grayImage(testPixels), tt.orientation)))
+		})
+	}
+
+	t.Run("image not at the origin", func(t *testing.T) {
+		img := grayImage([][]uint8{{0, 0, 0, 0}, {0, 'A', 'B', 'C'}, {0, 'D', 'E', 'F'}}).SubImage(image.Rect(1, 1, 4, 3))
+
+		assert.Equal(t, [][]uint8{{'D', 'A'}, {'E', 'B'}, {'F', 'C'}}, grayRows(Orient(img, 6)))
+	})
+}
+
+func TestSize_Fit(t *testing.T) {
+	tests := []struct {
+		name           string
+		size           Size
+		width, height  int
+		expectedWidth  int
+		expectedHeight int
+	}{
+		{name: "scaled to the width", size: Size{Width: 100}, width: 400, height: 300, expectedWidth: 100, expectedHeight: 75},
+		{name: "smaller image kept", size: Size{Width: 100}, width: 80, height: 60, expectedWidth: 80, expectedHeight: 60},
+		{name: "thin image keeps a pixel", size: Size{Width: 100}, width: 1000, height: 2, expectedWidth: 100, expectedHeight: 1},
+		{name: "cropped to the size", size: Size{Width: 100, Height: 100}, width: 400, height: 300, expectedWidth: 100, expectedHeight: 100},
+		{name: "smaller image only cropped", size: Size{Width: 100, Height: 50}, width: 60, height: 60, expectedWidth: 60, expectedHeight: 30},
+		{name: "empty image", size: Size{Width: 100}, width: 0, height: 10, expectedWidth: 0, expectedHeight: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, h := tt.size.Fit(tt.width, tt.height)
+
+			assert.Equal(t, tt.expectedWidth, w)
+			assert.Equal(t, tt.expectedHeight, h)
+		})
+	}
+}
+
+func TestCropArea(t *testing.T) {
+	wide := image.Rect(0, 0, 400, 200)
+	tall := image.Rect(0, 0, 200, 400)
+
+	tests := []struct {
+		name           string
+		bounds         image.Rectangle
+		w, h           int
+		focalX, focalY float64
+		expected       image.Rectangle
+	}{
+		{name: "centered", bounds: wide, w: 100, h: 100, focalX: 0.5, focalY: 0.5, expected: image.Rect(100, 0, 300, 200)},
+		{name: "focal point to the left", bounds: wide, w: 100, h: 100, focalX: 0.3, focalY: 0.5, expected: image.Rect(20, 0, 220, 200)},
+		{name: "focal point at the left edge", bounds: wide, w: 100, h: 100, focalX: 0, focalY: 0.5, expected: image.Rect(0, 0, 200, 200)},
+		{name: "focal point at the right edge", bounds: wide, w: 100, h: 100, focalX: 1, focalY: 0.5, expected: image.Rect(200, 0, 400, 200)},
+		{name: "focal point towards the top", bounds: tall, w: 100, h: 50, focalX: 0.5, focalY: 0.2, expected: image.Rect(0, 30, 200, 130)},
+		{name: "focal point at the bottom edge", bounds: tall, w: 100, h: 50, focalX: 0.5, focalY: 1, expected: image.Rect(0, 300, 200, 400)},
+		{name: "same aspect ratio", bounds: wide, w: 200, h: 100, focalX: 0.9, focalY: 0.1, expected: wide},
+		{name: "bounds not at the origin", bounds: image.Rect(10, 20, 410, 220), w: 100, h: 100, focalX: 0.5, focalY: 0.5, expected: image.Rect(110, 20, 310, 220)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cropArea(tt.bounds, tt.w, tt.h, tt.focalX, tt.focalY))
+		})
+	}
+}
+
+func TestDerive(t *testing.T) {
+	// A white left half and a black right half
+	img := image.NewGray(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 2; x++ {
+			img.SetGray(x, y, color.Gray{Y: 0xFF})
+		}
+	}
+
+	t.Run("scaled by averaging", func(t *testing.T) {
+		derived := Derive(img, Size{Width: 1}, 0.5, 0.5)
+
+		assert.Equal(t, image.Rect(0, 0, 1, 1), derived.Bounds())
+		assert.Equal(t, [][]uint8{{0x80}}, grayRows(derived))
+	})
+
+	t.Run("cropped around the focal point", func(t *testing.T) {
+		left := Derive(img, Size{Width: 1, Height: 1}, 0, 0.5)
+		right := Derive(img, Size{Width: 1, Height: 1}, 1, 0.5)
+
+		assert.Equal(t, [][]uint8{{0xFF}}, grayRows(left))
+		assert.Equal(t, [][]uint8{{0}}, grayRows(right))
+	})
+}
+
+func TestDecodeConfig(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewGray(image.Rect(0, 0, 3, 2)), nil))
+
+	// Insert an EXIF segment right after SOI
+	withOrientation := func(orientation int) []byte {
+		tiff := testTIFF(binary.LittleEndian, testTag{id: tagOrientation, value: uint16(orientation)})
+
+		return append(append(append([]byte{}, buf.Bytes()[:2]...), exifSegment(tiff)...), buf.Bytes()[2:]...)
+	}
+
+	for orientation := 1; orientation <= 8; orientation++ {
+		data := withOrientation(orientation)
+
+		format, width, height, err := DecodeConfig(data)
+		require.NoError(t, err)
+
+		img, _, err := Decode(data)
+		require.NoError(t, err)
+
+		assert.Equal(t, FormatJPEG, format)
+		assert.Equal(t, image.Rect(0, 0, width, height), img.Bounds(), "orientation %d", orientation)
+
+		if orientation >= 5 {
+			assert.Equal(t, []int{2, 3}, []int{width, height}, "orientation %d", orientation)
+		} else {
+			assert.Equal(t, []int{3, 2}, []int{width, height}, "orientation %d", orientation)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+)
+
+const chunkIEND = "IEND"
+
+var (
+	errNotPNG     = errors.New("imaging: not a PNG image")
+	errInvalidPNG = errors.New("imaging: malformed PNG chunk")
+	pngSignature  = []byte("\x89PNG\r\n\x1a\n")
+	// chunksToStrip hold EXIF data, text such as authors, comments or
+	// software, and the last modification time.
+	chunksToStrip = []string{"eXIf", "tEXt", "zTXt", "iTXt", "tIME"}
+)
+
+// StripPNGMetadata removes EXIF, text and timestamp chunks from a PNG image
+// without re-encoding it, along with anything after its end. Color profiles
+// and other chunks are kept.
+func StripPNGMetadata(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return nil, errNotPNG
+	}
+
+	var out bytes.Buffer
+
+	out.Grow(len(data))
+	out.Write(pngSignature)
+
+	for i := len(pngSignature); ; {
+		// Each chunk is its length, type, data and CRC
+		if i+12 > len(data) {
+			return nil, errInvalidPNG
+		}
+
+		length := int(binary.BigEndian.Uint32(data[i:]))
+		if length > len(data)-i-12 {
+			return nil, errInvalidPNG
+		}
+
+		chunkType := string(data[i+4 : i+8])
+		end := i + 12 + length
+
+		if !slices.Contains(chunksToStrip, chunkType) {
+			out.Write(data[i:end])
+		}
+
+		if chunkType == chunkIEND {
+			return out.Bytes(), nil
+		}
+
+		i = end
+	}
+}
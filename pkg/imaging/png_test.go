@@ -0,0 +1,96 @@
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testChunk encodes a PNG chunk with its CRC.
+func testChunk(chunkType string, payload []byte) []byte {
+	body := append([]byte(chunkType), payload...)
+	data := binary.BigEndian.AppendUint32(nil, uint32(len(payload)))
+	data = append(data, body...)
+
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(body))
+}
+
+// testPNG encodes a width by height PNG image with chunks inserted after its
+// IHDR chunk, which follows the signature.
+func testPNG(t *testing.T, width, height int, chunks ...[]byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+
+	const ihdrEnd = 8 + 25
+
+	data := append([]byte{}, buf.Bytes()[:ihdrEnd]...)
+	for _, chunk := range chunks {
+		data = append(data, chunk...)
+	}
+
+	return append(data, buf.Bytes()[ihdrEnd:]...)
+}
+
+func TestStripPNGMetadata(t *testing.T) {
+	exif := testChunk("eXIf", testTIFF(binary.BigEndian, testTag{id: tagOrientation, value: 6}))
+	text := testChunk("tEXt", []byte("Comment\x00secret location"))
+	compressed := testChunk("zTXt", []byte("Author\x00\x00x"))
+	international := testChunk("iTXt", []byte("Description\x00\x00\x00\x00\x00secret"))
+	modified := testChunk("tIME", []byte{0x07, 0xEA, 10, 18, 12, 0, 0})
+	physical := testChunk("pHYs", []byte{0, 0, 0x0B, 0x13, 0, 0, 0x0B, 0x13, 1})
+
+	t.Run("drops EXIF, text and time chunks", func(t *testing.T) {
+		data := testPNG(t, 3, 2, exif, text, compressed, physical, international, modified)
+
+		stripped, err := StripPNGMetadata(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, testPNG(t, 3, 2, physical), stripped)
+		assert.NotContains(t, string(stripped), "secret")
+
+		config, err := png.DecodeConfig(bytes.NewReader(stripped))
+		require.NoError(t, err)
+		assert.Equal(t, 3, config.Width)
+		assert.Equal(t, 2, config.Height)
+	})
+
+	t.Run("drops data after the end", func(t *testing.T) {
+		data := append(testPNG(t, 1, 1), []byte("secret")...)
+
+		stripped, err := StripPNGMetadata(data)
+
+		require.NoError(t, err)
+		assert.Equal(t, testPNG(t, 1, 1), stripped)
+	})
+
+	t.Run("error - not a PNG", func(t *testing.T) {
+		_, err := StripPNGMetadata(testJPEG())
+
+		assert.ErrorIs(t, err, errNotPNG)
+	})
+
+	t.Run("error - chunk length past the data", func(t *testing.T) {
+		data := testPNG(t, 1, 1, text)
+
+		// Cut inside the text chunk
+		_, err := StripPNGMetadata(data[:8+25+10])
+
+		assert.ErrorIs(t, err, errInvalidPNG)
+	})
+
+	t.Run("error - no end chunk", func(t *testing.T) {
+		data := testPNG(t, 1, 1)
+
+		_, err := StripPNGMetadata(data[:len(data)-12])
+
+		assert.ErrorIs(t, err, errInvalidPNG)
+	})
+}
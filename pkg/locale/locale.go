@@ -0,0 +1,110 @@
+// Package locale negotiates the language content is shown in from the
+// preferences of a reader.
+package locale
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Normalize returns tag in the form locales are compared in: lower case,
+// with hyphens, e.g. en_US becomes en-us.
+func Normalize(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+// base is the language of a tag without its region or script, e.g. en for
+// en-us.
+func base(tag string) string {
+	language, _, _ := strings.Cut(tag, "-")
+
+	return language
+}
+
+// Parse reads the locales of an Accept-Language header, most preferred
+// first. Locales of equal weight keep their order, and those with a zero
+// weight or malformed, as well as the * wildcard, are left out.
+func Parse(header string) []string {
+	type weighted struct {
+		tag    string
+		weight float64
+	}
+
+	var ranges []weighted
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+
+		tag = Normalize(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		weight := 1.0
+
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			value, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+
+			weight = value
+		}
+
+		if weight <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, weighted{tag, weight})
+	}
+
+	slices.SortStableFunc(ranges, func(a, b weighted) int {
+		return cmp.Compare(b.weight, a.weight)
+	})
+
+	tags := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		tags = append(tags, r.tag)
+	}
+
+	return tags
+}
+
+// Negotiate returns the fallback chain of supported locales for a reader
+// preferring preferred: for each preferred locale, the supported locale
+// equal to it or, failing that, those of the same language, followed by the
+// remaining supported locales in their order. Supported locales must be
+// normalized.
+func Negotiate(preferred, supported []string) []string {
+	chain := make([]string, 0, len(supported))
+
+	add := func(tag string) {
+		if !slices.Contains(chain, tag) {
+			chain = append(chain, tag)
+		}
+	}
+
+	for _, tag := range preferred {
+		tag = Normalize(tag)
+
+		if slices.Contains(supported, tag) {
+			add(tag)
+
+			continue
+		}
+
+		for _, s := range supported {
+			if base(s) == base(tag) {
+				add(s)
+			}
+		}
+	}
+
+	for _, s := range supported {
+		add(s)
+	}
+
+	return chain
+}
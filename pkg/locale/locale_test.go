@@ -0,0 +1,90 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		expected string
+	}{
+		{name: "lower case", tag: "en", expected: "en"},
+		{name: "region", tag: "en-US", expected: "en-us"},
+		{name: "underscore", tag: "en_US", expected: "en-us"},
+		{name: "spaces", tag: "  ID ", expected: "id"},
+		{name: "empty", tag: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Normalize(tt.tag))
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected []string
+	}{
+		{name: "empty", header: "", expected: []string{}},
+		{name: "single", header: "id", expected: []string{"id"}},
+		{name: "order kept without weights", header: "id, en", expected: []string{"id", "en"}},
+		{name: "sorted by weight", header: "en;q=0.5, id;q=0.9, fr", expected: []string{"fr", "id", "en"}},
+		{name: "equal weights keep their order", header: "de;q=0.8, en;q=0.8, id;q=0.8", expected: []string{"de", "en", "id"}},
+		{name: "explicit q=1", header: "en;q=0.7, id;q=1", expected: []string{"id", "en"}},
+		{name: "three decimals", header: "en;q=0.001, id;q=0.002", expected: []string{"id", "en"}},
+		{name: "zero weight left out", header: "en;q=0, id", expected: []string{"id"}},
+		{name: "zero with decimals left out", header: "en;q=0.000, id;q=0.1", expected: []string{"id"}},
+		{name: "negative weight left out", header: "en;q=-1, id", expected: []string{"id"}},
+		{name: "malformed weight left out", header: "en;q=high, id;q=0.5", expected: []string{"id"}},
+		{name: "empty weight left out", header: "en;q=, id", expected: []string{"id"}},
+		{name: "wildcard left out", header: "*;q=0.5, id", expected: []string{"id"}},
+		{name: "blank entries skipped", header: " , id,, en ", expected: []string{"id", "en"}},
+		{name: "spaces around weight", header: "en ; q=0.4 , id ; q=0.6", expected: []string{"id", "en"}},
+		{name: "tags normalized", header: "en-US;q=0.9, ID", expected: []string{"id", "en-us"}},
+		{name: "browser header", header: "id-ID,id;q=0.9,en-US;q=0.8,en;q=0.7", expected: []string{"id-id", "id", "en-us", "en"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Parse(tt.header))
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	supported := []string{"en", "id", "pt-br"}
+
+	tests := []struct {
+		name      string
+		preferred []string
+		expected  []string
+	}{
+		{name: "no preference", preferred: nil, expected: []string{"en", "id", "pt-br"}},
+		{name: "exact match first", preferred: []string{"id"}, expected: []string{"id", "en", "pt-br"}},
+		{name: "same language", preferred: []string{"id-ID"}, expected: []string{"id", "en", "pt-br"}},
+		{name: "region of a supported language", preferred: []string{"pt-PT"}, expected: []string{"pt-br", "en", "id"}},
+		{name: "base of a supported region", preferred: []string{"pt"}, expected: []string{"pt-br", "en", "id"}},
+		{name: "unsupported falls back in order", preferred: []string{"fr"}, expected: []string{"en", "id", "pt-br"}},
+		{name: "preferences in order", preferred: []string{"fr", "pt-br", "id"}, expected: []string{"pt-br", "id", "en"}},
+		{name: "no repeats", preferred: []string{"id", "id-ID", "ID"}, expected: []string{"id", "en", "pt-br"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Negotiate(tt.preferred, supported))
+		})
+	}
+}
+
+func TestNegotiate_ParsedHeader(t *testing.T) {
+	chain := Negotiate(Parse("en-GB;q=0.3, id-ID;q=0.8, fr"), []string{"en", "id"})
+
+	assert.Equal(t, []string{"id", "en"}, chain)
+}
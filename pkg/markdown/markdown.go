@@ -0,0 +1,38 @@
+// Package markdown renders Markdown to HTML: CommonMark with tables,
+// footnotes, strikethrough, autolinks and heading anchors. Raw HTML in the
+// source is kept, so the output is not sanitized.
+package markdown
+
+import (
+	"strings"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+// converter aligns table cells with the align attribute rather than inline
+// styles, which HTML sanitizing policies are more likely to allow.
+var converter = goldmark.New(
+	goldmark.WithExtensions(
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Strikethrough,
+		extension.Linkify,
+		extension.TaskList,
+		extension.Footnote,
+	),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	goldmark.WithRendererOptions(html.WithUnsafe()),
+)
+
+// ToHTML renders Markdown source to HTML. Headings get an id derived from
+// their text, so they can be linked to.
+func ToHTML(source string) string {
+	var b strings.Builder
+
+	// Rendering into a strings.Builder cannot fail
+	_ = converter.Convert([]byte(source), &b)
+
+	return b.String()
+}
@@ -0,0 +1,53 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{name: "empty", source: "", expected: ""},
+		{name: "paragraph", source: "Hello *world*", expected: "<p>Hello <em>world</em></p>\n"},
+		{name: "heading anchor", source: "## Getting Started", expected: "<h2 id=\"getting-started\">Getting Started</h2>\n"},
+		{name: "duplicate heading anchors", source: "# Intro\n\n# Intro", expected: "<h1 id=\"intro\">Intro</h1>\n<h1 id=\"intro-1\">Intro</h1>\n"},
+		{name: "link", source: "[docs](https://example.com)", expected: "<p><a href=\"https://example.com\">docs</a></p>\n"},
+		{name: "autolink", source: "See https://example.com now", expected: "<p>See <a href=\"https://example.com\">https://example.com</a> now</p>\n"},
+		{name: "strikethrough", source: "~~old~~", expected: "<p><del>old</del></p>\n"},
+		{name: "code is escaped", source: "`<b>`", expected: "<p><code>&lt;b&gt;</code></p>\n"},
+		{name: "fenced code", source: "```go\nx := 1 < 2\n```", expected: "<pre><code class=\"language-go\">x := 1 &lt; 2\n</code></pre>\n"},
+		{name: "text is escaped", source: "1 < 2 & 3", expected: "<p>1 &lt; 2 &amp; 3</p>\n"},
+		{
+			name:     "task list",
+			source:   "- [x] done\n- [ ] todo",
+			expected: "<ul>\n<li><input checked=\"\" disabled=\"\" type=\"checkbox\"> done</li>\n<li><input disabled=\"\" type=\"checkbox\"> todo</li>\n</ul>\n",
+		},
+		{
+			name:   "table alignment as attributes",
+			source: "| a | b |\n|:--|--:|\n| 1 | 2 |",
+			expected: "<table>\n<thead>\n<tr>\n<th align=\"left\">a</th>\n<th align=\"right\">b</th>\n</tr>\n</thead>\n" +
+				"<tbody>\n<tr>\n<td align=\"left\">1</td>\n<td align=\"right\">2</td>\n</tr>\n</tbody>\n</table>\n",
+		},
+		{name: "raw HTML kept for the sanitizer", source: "<span class=\"x\">hi</span>", expected: "<p><span class=\"x\">hi</span></p>\n"},
+		{name: "raw HTML block kept", source: "<script>alert(1)</script>", expected: "<script>alert(1)</script>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ToHTML(tt.source))
+		})
+	}
+}
+
+func TestToHTML_Footnotes(t *testing.T) {
+	html := ToHTML("Claim[^1]\n\n[^1]: Source")
+
+	assert.Contains(t, html, `<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref">1</a></sup>`)
+	assert.Contains(t, html, `<li id="fn:1">`)
+	assert.Contains(t, html, "Source")
+}
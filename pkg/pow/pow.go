@@ -0,0 +1,165 @@
+// Package pow issues and verifies signed, expiring hashcash-style
+// proof-of-work challenges.
+//
+// A challenge is solved by finding a nonce such that
+// sha256(challenge + ":" + nonce) starts with at least Difficulty zero bits.
+package pow
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+)
+
+const (
+	// Algorithm is the hash function challenges are solved with.
+	Algorithm = "sha256"
+
+	_saltSize     = 16
+	_payloadParts = 4
+	_bitsPerByte  = 8
+)
+
+// Challenge is an issued challenge. Token is the string handed to clients.
+type Challenge struct {
+	Token      string
+	Scope      string
+	Difficulty int
+	ExpiresAt  time.Time
+}
+
+// Issuer signs challenges with an HMAC key, so they can be verified without
+// storing them.
+type Issuer struct {
+	secret     []byte
+	difficulty int
+	ttl        time.Duration
+	now        func() time.Time
+}
+
+// NewIssuer creates an issuer of challenges with the given difficulty in
+// bits, valid for ttl.
+func NewIssuer(secret []byte, difficulty int, ttl time.Duration) *Issuer {
+	return &Issuer{
+		secret:     secret,
+		difficulty: difficulty,
+		ttl:        ttl,
+		now:        time.Now,
+	}
+}
+
+// Issue creates a challenge bound to scope, e.g. the resource it unlocks.
+func (i *Issuer) Issue(scope string) (*Challenge, error) {
+	salt := make([]byte, _saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	expiresAt := i.now().Add(i.ttl).Truncate(time.Second)
+
+	payload := strings.Join([]string{
+		scope,
+		base64.RawURLEncoding.EncodeToString(salt),
+		strconv.Itoa(i.difficulty),
+		strconv.FormatInt(expiresAt.Unix(), 10),
+	}, "|")
+
+	encoded := base64.RawURLEncoding.EncodeToString([]byte(payload))
+
+	return &Challenge{
+		Token:      encoded + "." + i.sign(encoded),
+		Scope:      scope,
+		Difficulty: i.difficulty,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+// Verify checks that token was issued by this issuer for scope, has not
+// expired, and that nonce solves it.
+func (i *Issuer) Verify(token, scope, nonce string) (*Challenge, error) {
+	challenge, err := i.parse(token)
+	if err != nil {
+		return nil, err
+	}
+
+	if challenge.Scope != scope || !i.now().Before(challenge.ExpiresAt) {
+		return nil, apperror.ErrInvalidChallenge
+	}
+
+	sum := sha256.Sum256([]byte(token + ":" + nonce))
+	if leadingZeroBits(sum[:]) < challenge.Difficulty {
+		return nil, apperror.ErrInvalidProofOfWork
+	}
+
+	return challenge, nil
+}
+
+// ID returns a stable identifier of a challenge token for replay tracking.
+func ID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (i *Issuer) parse(token string) (*Challenge, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(i.sign(encoded))) {
+		return nil, apperror.ErrInvalidChallenge
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, apperror.ErrInvalidChallenge
+	}
+
+	parts := strings.Split(string(payload), "|")
+	if len(parts) != _payloadParts {
+		return nil, apperror.ErrInvalidChallenge
+	}
+
+	difficulty, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return nil, apperror.ErrInvalidChallenge
+	}
+
+	expires, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		return nil, apperror.ErrInvalidChallenge
+	}
+
+	return &Challenge{
+		Token:      token,
+		Scope:      parts[0],
+		Difficulty: difficulty,
+		ExpiresAt:  time.Unix(expires, 0),
+	}, nil
+}
+
+func (i *Issuer) sign(encoded string) string {
+	mac := hmac.New(sha256.New, i.secret)
+	mac.Write([]byte(encoded))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func leadingZeroBits(sum []byte) int {
+	count := 0
+
+	for _, b := range sum {
+		if b != 0 {
+			return count + bits.LeadingZeros8(b)
+		}
+
+		count += _bitsPerByte
+	}
+
+	return count
+}
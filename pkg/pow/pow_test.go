@@ -0,0 +1,182 @@
+package pow
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testScope = "550e8400-e29b-41d4-a716-446655440000"
+
+var testTime = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestIssuer(difficulty int) *Issuer {
+	issuer := NewIssuer([]byte("test-secret"), difficulty, time.Minute)
+	issuer.now = func() time.Time { return testTime }
+
+	return issuer
+}
+
+// solve finds a nonce whose hash has at least the given leading zero bits,
+// or exactly that many when exact is set.
+func solve(t *testing.T, token string, difficulty int, exact bool) string {
+	t.Helper()
+
+	for nonce := 0; nonce < 1<<22; nonce++ {
+		sum := sha256.Sum256([]byte(token + ":" + strconv.Itoa(nonce)))
+
+		zeros := leadingZeroBits(sum[:])
+		if zeros == difficulty || (!exact && zeros > difficulty) {
+			return strconv.Itoa(nonce)
+		}
+	}
+
+	require.FailNow(t, "no nonce found")
+
+	return ""
+}
+
+func TestIssuer_Issue(t *testing.T) {
+	issuer := newTestIssuer(8)
+
+	challenge, err := issuer.Issue(testScope)
+	require.NoError(t, err)
+
+	assert.Equal(t, testScope, challenge.Scope)
+	assert.Equal(t, 8, challenge.Difficulty)
+	assert.Equal(t, testTime.Add(time.Minute), challenge.ExpiresAt)
+
+	other, err := issuer.Issue(testScope)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, challenge.Token, other.Token, "challenges are salted")
+	assert.NotEqual(t, ID(challenge.Token), ID(other.Token))
+	assert.Equal(t, ID(challenge.Token), ID(challenge.Token))
+}
+
+func TestIssuer_Verify(t *testing.T) {
+	const difficulty = 10
+
+	issuer := newTestIssuer(difficulty)
+
+	challenge, err := issuer.Issue(testScope)
+	require.NoError(t, err)
+
+	t.Run("success - solved at the difficulty", func(t *testing.T) {
+		verified, err := issuer.Verify(challenge.Token, testScope, solve(t, challenge.Token, difficulty, true))
+
+		require.NoError(t, err)
+		assert.Equal(t, challenge.Scope, verified.Scope)
+		assert.Equal(t, challenge.Difficulty, verified.Difficulty)
+		assert.True(t, challenge.ExpiresAt.Equal(verified.ExpiresAt))
+	})
+
+	t.Run("error - one bit short of the difficulty", func(t *testing.T) {
+		_, err := issuer.Verify(challenge.Token, testScope, solve(t, challenge.Token, difficulty-1, true))
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidProofOfWork)
+	})
+
+	t.Run("error - another scope", func(t *testing.T) {
+		_, err := issuer.Verify(challenge.Token, "another", solve(t, challenge.Token, difficulty, false))
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidChallenge)
+	})
+
+	t.Run("error - signed with another secret", func(t *testing.T) {
+		forged, err := NewIssuer([]byte("other-secret"), difficulty, time.Minute).Issue(testScope)
+		require.NoError(t, err)
+
+		_, err = issuer.Verify(forged.Token, testScope, solve(t, forged.Token, difficulty, false))
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidChallenge)
+	})
+
+	t.Run("error - lowered difficulty", func(t *testing.T) {
+		encoded, signature, _ := strings.Cut(challenge.Token, ".")
+		payload, err := base64.RawURLEncoding.DecodeString(encoded)
+		require.NoError(t, err)
+
+		lowered := base64.RawURLEncoding.EncodeToString([]byte(strings.Replace(string(payload), "|10|", "|0|", 1)))
+		token := lowered + "." + signature
+
+		_, err = issuer.Verify(token, testScope, "0")
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidChallenge)
+	})
+
+	t.Run("error - malformed tokens", func(t *testing.T) {
+		for _, token := range []string{"", "no-signature", "bm90LWEtY2hhbGxlbmdl.c2lnbmF0dXJl", "!!!." + issuer.sign("!!!")} {
+			_, err := issuer.Verify(token, testScope, "0")
+
+			assert.ErrorIs(t, err, apperror.ErrInvalidChallenge, token)
+		}
+	})
+
+	t.Run("error - signed payload with missing parts", func(t *testing.T) {
+		encoded := base64.RawURLEncoding.EncodeToString([]byte(testScope + "|salt|10"))
+
+		_, err := issuer.Verify(encoded+"."+issuer.sign(encoded), testScope, "0")
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidChallenge)
+	})
+}
+
+func TestIssuer_VerifyExpiry(t *testing.T) {
+	issuer := newTestIssuer(0)
+
+	challenge, err := issuer.Issue(testScope)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		valid bool
+	}{
+		{name: "just issued", now: testTime, valid: true},
+		{name: "a second before expiry", now: challenge.ExpiresAt.Add(-time.Second), valid: true},
+		{name: "at expiry", now: challenge.ExpiresAt, valid: false},
+		{name: "after expiry", now: challenge.ExpiresAt.Add(time.Hour), valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer.now = func() time.Time { return tt.now }
+
+			_, err := issuer.Verify(challenge.Token, testScope, "0")
+
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, apperror.ErrInvalidChallenge)
+			}
+		})
+	}
+}
+
+func TestLeadingZeroBits(t *testing.T) {
+	tests := []struct {
+		name     string
+		sum      []byte
+		expected int
+	}{
+		{name: "no leading zeros", sum: []byte{0x80, 0}, expected: 0},
+		{name: "within the first byte", sum: []byte{0x10, 0xFF}, expected: 3},
+		{name: "whole first byte", sum: []byte{0, 0xFF}, expected: 8},
+		{name: "across bytes", sum: []byte{0, 0x01}, expected: 15},
+		{name: "all zeros", sum: []byte{0, 0}, expected: 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, leadingZeroBits(tt.sum))
+		})
+	}
+}
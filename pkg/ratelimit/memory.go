@@ -0,0 +1,69 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// _sweepInterval is the number of requests between sweeps of full buckets.
+const _sweepInterval = 1000
+
+type memoryBucket struct {
+	tokens  float64
+	updated time.Time
+	fullAt  time.Time
+}
+
+// MemoryStore keeps buckets in process memory. Limits are per replica.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]memoryBucket
+	takes   int
+	now     func() time.Time
+}
+
+// NewMemoryStore creates an empty in-memory bucket store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]memoryBucket),
+		now:     time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, policy Policy) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = memoryBucket{tokens: float64(policy.Limit), updated: now}
+	}
+
+	tokens, result := Take(bucket.tokens, bucket.updated, now, policy)
+
+	s.buckets[key] = memoryBucket{
+		tokens:  tokens,
+		updated: now,
+		fullAt:  now.Add(result.ResetAfter),
+	}
+
+	s.takes++
+	if s.takes%_sweepInterval == 0 {
+		s.sweep(now)
+	}
+
+	return result, nil
+}
+
+// sweep drops buckets that have refilled completely, since a missing bucket
+// behaves the same as a full one.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, bucket := range s.buckets {
+		if !bucket.fullAt.After(now) {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Package ratelimit implements token bucket rate limiting with pluggable
+// bucket storage.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Policy describes a token bucket holding up to Limit tokens that refills
+// completely over Period. Each request takes one token.
+type Policy struct {
+	Name   string
+	Limit  int
+	Period time.Duration
+}
+
+// Result is the state of a bucket after a request took, or failed to take,
+// a token from it.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	ResetAfter time.Duration
+	RetryAfter time.Duration
+}
+
+// Store keeps buckets by key and takes tokens from them atomically.
+type Store interface {
+	Take(ctx context.Context, key string, policy Policy) (Result, error)
+}
+
+// Purger is implemented by stores that rely on a periodic job, rather than
+// the requests themselves, to delete buckets that have refilled completely.
+type Purger interface {
+	Purge(ctx context.Context, limit int) (int, error)
+}
+
+// Take refills a bucket that held tokens at last up to now, then takes one
+// token from it if available. It returns the tokens left in the bucket.
+func Take(tokens float64, last, now time.Time, policy Policy) (float64, Result) {
+	limit := float64(policy.Limit)
+	rate := limit / policy.Period.Seconds()
+
+	if elapsed := now.Sub(last); elapsed > 0 {
+		tokens = math.Min(limit, tokens+elapsed.Seconds()*rate)
+	}
+
+	result := Result{Limit: policy.Limit}
+
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - tokens) / rate)
+	}
+
+	result.Remaining = int(math.Floor(tokens))
+	result.ResetAfter = secondsToDuration((limit - tokens) / rate)
+
+	return tokens, result
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,149 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTake(t *testing.T) {
+	// Refills 5 tokens a minute, one every 12 seconds
+	policy := Policy{Name: "login", Limit: 5, Period: time.Minute}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		tokens         float64
+		last           time.Time
+		expectedTokens float64
+		expected       Result
+	}{
+		{
+			name:           "full bucket",
+			tokens:         5,
+			last:           now,
+			expectedTokens: 4,
+			expected:       Result{Allowed: true, Limit: 5, Remaining: 4, ResetAfter: 12 * time.Second},
+		},
+		{
+			name:           "last token",
+			tokens:         1,
+			last:           now,
+			expectedTokens: 0,
+			expected:       Result{Allowed: true, Limit: 5, Remaining: 0, ResetAfter: time.Minute},
+		},
+		{
+			name:           "empty bucket",
+			tokens:         0,
+			last:           now,
+			expectedTokens: 0,
+			expected:       Result{Limit: 5, Remaining: 0, ResetAfter: time.Minute, RetryAfter: 12 * time.Second},
+		},
+		{
+			name:           "partly refilled bucket waits for the rest of a token",
+			tokens:         0,
+			last:           now.Add(-3 * time.Second),
+			expectedTokens: 0.25,
+			expected:       Result{Limit: 5, Remaining: 0, ResetAfter: 57 * time.Second, RetryAfter: 9 * time.Second},
+		},
+		{
+			name:           "refilled token is taken",
+			tokens:         0.5,
+			last:           now.Add(-6 * time.Second),
+			expectedTokens: 0,
+			expected:       Result{Allowed: true, Limit: 5, Remaining: 0, ResetAfter: time.Minute},
+		},
+		{
+			name:           "refill stops at the limit",
+			tokens:         2,
+			last:           now.Add(-time.Hour),
+			expectedTokens: 4,
+			expected:       Result{Allowed: true, Limit: 5, Remaining: 4, ResetAfter: 12 * time.Second},
+		},
+		{
+			name:           "clock going backwards does not drain",
+			tokens:         3,
+			last:           now.Add(time.Minute),
+			expectedTokens: 2,
+			expected:       Result{Allowed: true, Limit: 5, Remaining: 2, ResetAfter: 36 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, result := Take(tt.tokens, tt.last, now, policy)
+
+			assert.InDelta(t, tt.expectedTokens, tokens, 1e-9)
+			assert.Equal(t, tt.expected.Allowed, result.Allowed)
+			assert.Equal(t, tt.expected.Limit, result.Limit)
+			assert.Equal(t, tt.expected.Remaining, result.Remaining)
+			assert.InDelta(t, tt.expected.ResetAfter, result.ResetAfter, float64(time.Millisecond))
+			assert.InDelta(t, tt.expected.RetryAfter, result.RetryAfter, float64(time.Millisecond))
+		})
+	}
+}
+
+func TestMemoryStore_Take(t *testing.T) {
+	policy := Policy{Name: "comment", Limit: 2, Period: 10 * time.Second}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	take := func(key string) Result {
+		result, err := store.Take(t.Context(), key, policy)
+		assert.NoError(t, err)
+
+		return result
+	}
+
+	t.Run("new bucket starts full", func(t *testing.T) {
+		result := take("a")
+
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 1, result.Remaining)
+		assert.Equal(t, 5*time.Second, result.ResetAfter)
+	})
+
+	t.Run("empty bucket denies", func(t *testing.T) {
+		assert.True(t, take("a").Allowed)
+
+		result := take("a")
+
+		assert.False(t, result.Allowed)
+		assert.Equal(t, 10*time.Second, result.ResetAfter)
+		assert.Equal(t, 5*time.Second, result.RetryAfter)
+	})
+
+	t.Run("keys have their own buckets", func(t *testing.T) {
+		assert.True(t, take("b").Allowed)
+	})
+
+	t.Run("bucket refills over time", func(t *testing.T) {
+		now = now.Add(5 * time.Second)
+
+		result := take("a")
+
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 0, result.Remaining)
+		assert.False(t, take("a").Allowed)
+	})
+
+	t.Run("sweep drops refilled buckets only", func(t *testing.T) {
+		now = now.Add(6 * time.Second)
+		assert.True(t, take("c").Allowed)
+
+		// a was last taken empty 6 seconds ago, c was just taken
+		store.sweep(now)
+
+		assert.NotContains(t, store.buckets, "b")
+		assert.Contains(t, store.buckets, "a")
+		assert.Contains(t, store.buckets, "c")
+
+		now = now.Add(10 * time.Second)
+		store.sweep(now)
+
+		assert.Empty(t, store.buckets)
+	})
+}
@@ -0,0 +1,190 @@
+// Package render renders HTML views from a theme directory with
+// html/template.
+//
+// A theme holds three kinds of templates:
+//
+//	layouts/*.html   define the "layout" template wrapping every view
+//	partials/*.html  define templates shared by layouts and views
+//	views/*.html     one file per view, e.g. views/news.html is the "news" view
+//
+// Views define the blocks the layout pulls in, such as "title" and "content".
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+)
+
+const _layoutTemplate = "layout"
+
+// Renderer executes the views of a theme.
+type Renderer struct {
+	fsys      fs.FS
+	funcs     template.FuncMap
+	hotReload bool
+	views     map[string]*template.Template
+}
+
+// Option configures a Renderer.
+type Option func(*Renderer)
+
+// HotReload re-parses the theme on every render, so template edits show up
+// without a restart. It is meant for development.
+func HotReload(enabled bool) Option {
+	return func(r *Renderer) {
+		r.hotReload = enabled
+	}
+}
+
+// Funcs adds template functions, replacing the defaults of the same name.
+func Funcs(funcs template.FuncMap) Option {
+	return func(r *Renderer) {
+		for name, fn := range funcs {
+			r.funcs[name] = fn
+		}
+	}
+}
+
+// New parses the theme in fsys. A theme that fails to parse is reported
+// here rather than on the first request.
+func New(fsys fs.FS, opts ...Option) (*Renderer, error) {
+	r := &Renderer{
+		fsys: fsys,
+		funcs: template.FuncMap{
+			"content": PlainText,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	views, err := r.load()
+	if err != nil {
+		return nil, err
+	}
+
+	r.views = views
+
+	return r, nil
+}
+
+// Render executes a view inside the layout and writes it to w. Nothing is
+// written if the view fails to execute.
+func (r *Renderer) Render(w io.Writer, view string, data interface{}) error {
+	views, err := r.current()
+	if err != nil {
+		return err
+	}
+
+	tmpl, ok := views[view]
+	if !ok {
+		return fmt.Errorf("render - Render - %q: %w", view, apperror.ErrUnknownView)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, _layoutTemplate, data); err != nil {
+		return err
+	}
+
+	_, err = buf.WriteTo(w)
+
+	return err
+}
+
+func (r *Renderer) current() (map[string]*template.Template, error) {
+	if !r.hotReload {
+		return r.views, nil
+	}
+
+	return r.load()
+}
+
+// load parses the layouts and partials once, then every view on top of its
+// own copy of them, so views can define the same blocks.
+func (r *Renderer) load() (map[string]*template.Template, error) {
+	shared, err := r.glob("layouts/*.html", "partials/*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(shared) == 0 {
+		return nil, fmt.Errorf("render - load - no layouts or partials: %w", apperror.ErrInvalidTheme)
+	}
+
+	base, err := template.New("").Funcs(r.funcs).ParseFS(r.fsys, shared...)
+	if err != nil {
+		return nil, fmt.Errorf("render - load - layouts: %w", err)
+	}
+
+	files, err := r.glob("views/*.html")
+	if err != nil {
+		return nil, err
+	}
+
+	views := make(map[string]*template.Template, len(files))
+
+	for _, file := range files {
+		tmpl, err := base.Clone()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = tmpl.ParseFS(r.fsys, file); err != nil {
+			return nil, fmt.Errorf("render - load - %s: %w", file, err)
+		}
+
+		views[strings.TrimSuffix(path.Base(file), ".html")] = tmpl
+	}
+
+	return views, nil
+}
+
+func (r *Renderer) glob(patterns ...string) ([]string, error) {
+	var files []string
+
+	for _, pattern := range patterns {
+		matches, err := fs.Glob(r.fsys, pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, matches...)
+	}
+
+	return files, nil
+}
+
+// PlainText embeds stored content as escaped text, one paragraph per block
+// of lines separated by a blank line. It is the default "content" template
+// function, so markup in stored content is shown rather than executed.
+func PlainText(content string) template.HTML {
+	var b strings.Builder
+
+	for _, paragraph := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(paragraph), "\n", "<br>"))
+		b.WriteString("</p>\n")
+	}
+
+	return template.HTML(b.String()) //nolint:gosec // every paragraph is escaped above
+}
+
+// TrustedHTML embeds stored content as markup. It is only safe for content
+// that was sanitized when it was written.
+func TrustedHTML(content string) template.HTML {
+	return template.HTML(content) //nolint:gosec // content is sanitized on write
+}
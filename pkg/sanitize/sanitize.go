@@ -0,0 +1,390 @@
+// Package sanitize cleans HTML against an allowlist of elements, attributes,
+// URL schemes and inline style properties. Everything not allowed is dropped:
+// disallowed elements keep their text, while script-like elements are removed
+// together with their content.
+package sanitize
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+const _anyElement = "*"
+
+// dropped elements are removed with their content whatever the policy says,
+// since their content is code or markup rather than text.
+var dropped = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+	"template": true, "noscript": true, "svg": true, "math": true, "frameset": true,
+	"noembed": true, "noframes": true, "xmp": true, "plaintext": true,
+}
+
+// void elements have no end tag.
+var void = map[string]bool{
+	"area": true, "br": true, "col": true, "hr": true, "img": true, "wbr": true,
+}
+
+// urlAttributes hold a URL whose scheme is checked against the policy.
+var urlAttributes = map[string]bool{
+	"href": true, "src": true, "cite": true, "action": true, "formaction": true,
+	"poster": true, "background": true, "longdesc": true, "usemap": true, "srcset": true,
+}
+
+// styleValuePattern accepts plain values such as "red", "#fff", "1.5em" or
+// "rgb(0, 0, 0)", which leaves no room for url(), expression() or escapes.
+var styleValuePattern = regexp.MustCompile(`^[a-zA-Z0-9#%.,\s()-]+$`)
+
+// Policy lists the markup Sanitize keeps.
+type Policy struct {
+	elements   map[string]bool
+	attributes map[string]map[string]bool
+	schemes    map[string]bool
+	styles     map[string]bool
+}
+
+// Option configures a Policy.
+type Option func(*Policy)
+
+// Elements allows the given elements, e.g. "p" or "a".
+func Elements(names ...string) Option {
+	return func(p *Policy) {
+		for _, name := range names {
+			p.elements[normalize(name)] = true
+		}
+	}
+}
+
+// Attributes allows attributes given as "element:attribute", e.g. "a:href",
+// or "*:attribute" for every allowed element. Event handler attributes are
+// never kept.
+func Attributes(names ...string) Option {
+	return func(p *Policy) {
+		for _, name := range names {
+			element, attribute, ok := strings.Cut(name, ":")
+			if !ok {
+				element, attribute = _anyElement, name
+			}
+
+			element, attribute = normalize(element), normalize(attribute)
+			if p.attributes[element] == nil {
+				p.attributes[element] = map[string]bool{}
+			}
+
+			p.attributes[element][attribute] = true
+		}
+	}
+}
+
+// URLSchemes allows absolute URLs with the given schemes, e.g. "https".
+// Relative URLs are always allowed.
+func URLSchemes(schemes ...string) Option {
+	return func(p *Policy) {
+		for _, scheme := range schemes {
+			p.schemes[normalize(scheme)] = true
+		}
+	}
+}
+
+// StyleProperties allows the given CSS properties in style attributes, e.g.
+// "color". Style attributes also need to be allowed with Attributes.
+func StyleProperties(properties ...string) Option {
+	return func(p *Policy) {
+		for _, property := range properties {
+			p.styles[normalize(property)] = true
+		}
+	}
+}
+
+// New returns a policy allowing nothing but text beyond the given options.
+func New(opts ...Option) *Policy {
+	p := &Policy{
+		elements:   map[string]bool{},
+		attributes: map[string]map[string]bool{},
+		schemes:    map[string]bool{},
+		styles:     map[string]bool{},
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Sanitize returns content with everything the policy does not allow
+// removed. Text is re-escaped and the allowed elements left open are closed,
+// so the result is safe to embed as is.
+func (p *Policy) Sanitize(content string) string {
+	var (
+		b    strings.Builder
+		open []string
+		skip string
+		nest int
+	)
+
+	z := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		// The tokenizer stops with an error token at the end of the input
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+
+		token := z.Token()
+
+		// Inside a dropped element, only track nesting until it is closed
+		if skip != "" {
+			switch {
+			case tt == html.StartTagToken && token.Data == skip:
+				nest++
+			case tt == html.EndTagToken && token.Data == skip:
+				nest--
+				if nest == 0 {
+					skip = ""
+				}
+			}
+
+			continue
+		}
+
+		switch tt {
+		case html.TextToken:
+			b.WriteString(html.EscapeString(token.Data))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if dropped[token.Data] {
+				if tt == html.StartTagToken {
+					skip, nest = token.Data, 1
+				}
+
+				continue
+			}
+
+			if !p.elements[token.Data] {
+				continue
+			}
+
+			p.writeStartTag(&b, token)
+
+			if tt == html.StartTagToken && !void[token.Data] {
+				open = append(open, token.Data)
+			}
+		case html.EndTagToken:
+			open = closeElement(&b, open, token.Data)
+		case html.CommentToken, html.DoctypeToken, html.ErrorToken:
+			// Comments and doctypes are never kept
+		}
+	}
+
+	for i := len(open) - 1; i >= 0; i-- {
+		writeEndTag(&b, open[i])
+	}
+
+	return b.String()
+}
+
+func (p *Policy) writeStartTag(b *strings.Builder, token html.Token) {
+	b.WriteString("<")
+	b.WriteString(token.Data)
+
+	for _, attr := range token.Attr {
+		value, ok := p.attribute(token.Data, attr)
+		if !ok {
+			continue
+		}
+
+		b.WriteString(" ")
+		b.WriteString(attr.Key)
+		b.WriteString(`="`)
+		b.WriteString(html.EscapeString(value))
+		b.WriteString(`"`)
+	}
+
+	b.WriteString(">")
+}
+
+// attribute returns the value to keep for an attribute and whether to keep
+// it at all.
+func (p *Policy) attribute(element string, attr html.Attribute) (string, bool) {
+	if attr.Namespace != "" || strings.HasPrefix(attr.Key, "on") {
+		return "", false
+	}
+
+	if !p.attributes[element][attr.Key] && !p.attributes[_anyElement][attr.Key] {
+		return "", false
+	}
+
+	switch {
+	case urlAttributes[attr.Key]:
+		return attr.Val, p.allowedURL(attr.Val)
+	case attr.Key == "style":
+		style := p.style(attr.Val)
+
+		return style, style != ""
+	default:
+		return attr.Val, true
+	}
+}
+
+func (p *Policy) allowedURL(raw string) bool {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return false
+	}
+
+	return u.Scheme == "" || p.schemes[strings.ToLower(u.Scheme)]
+}
+
+// style keeps the declarations of allowed properties with plain values.
+func (p *Policy) style(raw string) string {
+	var kept []string
+
+	for _, declaration := range strings.Split(raw, ";") {
+		property, value, ok := strings.Cut(declaration, ":")
+		if !ok {
+			continue
+		}
+
+		property, value = normalize(property), strings.TrimSpace(value)
+
+		lower := strings.ToLower(value)
+		if !p.styles[property] || !styleValuePattern.MatchString(value) ||
+			strings.Contains(lower, "url(") || strings.Contains(lower, "expression(") {
+			continue
+		}
+
+		kept = append(kept, property+": "+value)
+	}
+
+	return strings.Join(kept, "; ")
+}
+
+// closeElement writes the end tag of the innermost open element named name,
+// closing the elements opened inside it first. End tags of elements that
+// are not open are dropped.
+func closeElement(b *strings.Builder, open []string, name string) []string {
+	for i := len(open) - 1; i >= 0; i-- {
+		if open[i] != name {
+			continue
+		}
+
+		for j := len(open) - 1; j >= i; j-- {
+			writeEndTag(b, open[j])
+		}
+
+		return open[:i]
+	}
+
+	return open
+}
+
+func writeEndTag(b *strings.Builder, name string) {
+	b.WriteString("</")
+	b.WriteString(name)
+	b.WriteString(">")
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// blockElements start a new paragraph of text.
+var blockElements = map[string]bool{
+	"p": true, "div": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"blockquote": true, "pre": true, "ul": true, "ol": true, "li": true, "table": true, "tr": true,
+	"figure": true, "figcaption": true, "hr": true, "section": true, "article": true,
+}
+
+// Text returns the text of content without any markup, one paragraph per
+// block element and separated by blank lines. Line breaks are kept only
+// where they show: at br elements and inside pre. Script-like elements are
+// dropped with their content.
+func Text(content string) string {
+	var (
+		paragraphs []string
+		current    strings.Builder
+		skip       string
+		nest       int
+		pre        int
+	)
+
+	flush := func() {
+		if text := collapse(current.String()); text != "" {
+			paragraphs = append(paragraphs, text)
+		}
+
+		current.Reset()
+	}
+
+	z := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+
+		token := z.Token()
+
+		if skip != "" {
+			switch {
+			case tt == html.StartTagToken && token.Data == skip:
+				nest++
+			case tt == html.EndTagToken && token.Data == skip:
+				nest--
+				if nest == 0 {
+					skip = ""
+				}
+			}
+
+			continue
+		}
+
+		switch tt {
+		case html.TextToken:
+			if pre == 0 {
+				token.Data = strings.ReplaceAll(token.Data, "\n", " ")
+			}
+
+			current.WriteString(token.Data)
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			switch {
+			case tt == html.StartTagToken && dropped[token.Data]:
+				skip, nest = token.Data, 1
+			case token.Data == "br":
+				current.WriteString("\n")
+			case blockElements[token.Data]:
+				flush()
+			}
+
+			if token.Data == "pre" && tt == html.StartTagToken {
+				pre++
+			} else if token.Data == "pre" && tt == html.EndTagToken && pre > 0 {
+				pre--
+			}
+		case html.CommentToken, html.DoctypeToken, html.ErrorToken:
+			// Comments and doctypes have no text
+		}
+	}
+
+	flush()
+
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// collapse collapses the whitespace within each line of text and drops
+// blank lines.
+func collapse(text string) string {
+	var lines []string
+
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,123 @@
+package sanitize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testPolicy() *Policy {
+	return New(
+		Elements("p", "a", "b", "em", "img", "ul", "li", "span", "br"),
+		Attributes("a:href", "a:title", "img:src", "img:alt", "span:style", "class"),
+		URLSchemes("http", "https", "mailto"),
+		StyleProperties("color", "text-align"),
+	)
+}
+
+func TestPolicy_Sanitize(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		// Allowed markup
+		{name: "plain text", content: "Hello, world", expected: "Hello, world"},
+		{name: "allowed elements", content: "<p>Hello <b>bold</b> <em>world</em></p>", expected: "<p>Hello <b>bold</b> <em>world</em></p>"},
+		{name: "text is re-escaped", content: "<p>1 &lt; 2 &amp;&amp; a &gt; b</p>", expected: "<p>1 &lt; 2 &amp;&amp; a &gt; b</p>"},
+		{name: "void element", content: "a<br>b<br/>c", expected: "a<br>b<br>c"},
+		{name: "upper case tags", content: "<P>Hi</P>", expected: "<p>Hi</p>"},
+
+		// Script and style bodies
+		{name: "script with its body", content: "<p>a</p><script>alert(1)</script><p>b</p>", expected: "<p>a</p><p>b</p>"},
+		{name: "script body with markup", content: "<script>document.write('<p>x</p>')</script>ok", expected: "ok"},
+		{name: "script body with a closing tag in a string", content: "<script>var s = '</p>';</script>ok", expected: "ok"},
+		{name: "upper case script", content: "<SCRIPT>alert(1)</SCRIPT>ok", expected: "ok"},
+		{name: "style with its body", content: "<style>p { color: red }</style><p>a</p>", expected: "<p>a</p>"},
+		{name: "unclosed script drops the rest", content: "<p>a</p><script>alert(1)<p>b</p>", expected: "<p>a</p>"},
+		{name: "iframe with fallback content", content: "<iframe src=\"https://evil.test\">fallback</iframe>ok", expected: "ok"},
+		{name: "nested svg", content: "<svg><svg><script>alert(1)</script></svg>hidden</svg>ok", expected: "ok"},
+		{name: "self-closing script", content: "<script src=\"https://evil.test/x.js\"/>ok", expected: "ok"},
+		{name: "comment", content: "a<!-- <script>alert(1)</script> -->b", expected: "ab"},
+		{name: "doctype", content: "<!DOCTYPE html><p>a</p>", expected: "<p>a</p>"},
+
+		// Event handler attributes
+		{name: "onclick", content: "<a href=\"/x\" onclick=\"alert(1)\">x</a>", expected: "<a href=\"/x\">x</a>"},
+		{name: "onerror on an image", content: "<img src=\"/a.png\" onerror=\"alert(1)\">", expected: "<img src=\"/a.png\">"},
+		{name: "mixed case handler", content: "<p OnMouseOver=\"alert(1)\">x</p>", expected: "<p>x</p>"},
+		{name: "handler allowed for every element", content: "<p onload=\"alert(1)\" class=\"lead\">x</p>", expected: "<p class=\"lead\">x</p>"},
+
+		// URL schemes
+		{name: "relative URL", content: "<a href=\"/about\">x</a>", expected: "<a href=\"/about\">x</a>"},
+		{name: "allowed scheme", content: "<a href=\"https://example.com/?a=1&amp;b=2\">x</a>", expected: "<a href=\"https://example.com/?a=1&amp;b=2\">x</a>"},
+		{name: "mailto", content: "<a href=\"mailto:me@example.com\">x</a>", expected: "<a href=\"mailto:me@example.com\">x</a>"},
+		{name: "javascript", content: "<a href=\"javascript:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "mixed case javascript", content: "<a href=\"JaVaScRiPt:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "javascript with leading whitespace", content: "<a href=\"  javascript:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "entity-encoded javascript", content: "<a href=\"jav&#x61;script:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "decimal entity-encoded javascript", content: "<a href=\"&#106;avascript:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "javascript split by a tab", content: "<a href=\"java&#9;script:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "javascript split by a newline", content: "<a href=\"java\nscript:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "data URL", content: "<img src=\"data:image/svg+xml;base64,PHN2Zz4=\">", expected: "<img>"},
+		{name: "upper case data URL", content: "<a href=\"DATA:text/html,<script>alert(1)</script>\">x</a>", expected: "<a>x</a>"},
+		{name: "vbscript", content: "<a href=\"vbscript:msgbox(1)\">x</a>", expected: "<a>x</a>"},
+
+		// Unclosed and nested tags
+		{name: "unclosed element is closed", content: "<p>Hello <b>world", expected: "<p>Hello <b>world</b></p>"},
+		{name: "misnested elements", content: "<b><em>x</b>y</em>", expected: "<b><em>x</em></b>y"},
+		{name: "stray end tag", content: "x</p></b>y", expected: "xy"},
+		{name: "nested lists", content: "<ul><li>a<ul><li>b</li></ul></li></ul>", expected: "<ul><li>a<ul><li>b</li></ul></li></ul>"},
+		{name: "unclosed tag at the end", content: "<p>a</p><b", expected: "<p>a</p>"},
+		{name: "unclosed attribute", content: "<a href=\"/x>x</a>", expected: ""},
+		{name: "disallowed element keeps its text", content: "<div><p>a</p><marquee>b</marquee></div>", expected: "<p>a</p>b"},
+		{name: "disallowed element nested in allowed", content: "<p><font color=\"red\">a</font></p>", expected: "<p>a</p>"},
+
+		// Disallowed attributes on allowed tags
+		{name: "attribute of another element", content: "<p title=\"t\">x</p>", expected: "<p>x</p>"},
+		{name: "unknown attribute", content: "<a href=\"/x\" target=\"_blank\" data-id=\"1\">x</a>", expected: "<a href=\"/x\">x</a>"},
+		{name: "attribute allowed for every element", content: "<em class=\"note\">x</em>", expected: "<em class=\"note\">x</em>"},
+		{name: "attribute values are re-escaped", content: "<a title='a\"b<c'>x</a>", expected: "<a title=\"a&#34;b&lt;c\">x</a>"},
+		{name: "namespaced attribute", content: "<a xlink:href=\"javascript:alert(1)\">x</a>", expected: "<a>x</a>"},
+		{name: "style on an element without it", content: "<p style=\"color: red\">x</p>", expected: "<p>x</p>"},
+		{name: "allowed style properties", content: "<span style=\"color: red; text-align:center\">x</span>", expected: "<span style=\"color: red; text-align: center\">x</span>"},
+		{name: "disallowed style property", content: "<span style=\"position: fixed; color: #fff\">x</span>", expected: "<span style=\"color: #fff\">x</span>"},
+		{name: "style with url()", content: "<span style=\"color: url(javascript:alert(1))\">x</span>", expected: "<span>x</span>"},
+		{name: "style with expression()", content: "<span style=\"color: expression(alert(1))\">x</span>", expected: "<span>x</span>"},
+		{name: "style with escapes", content: "<span style=\"color: \\72 ed\">x</span>", expected: "<span>x</span>"},
+	}
+
+	policy := testPolicy()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, policy.Sanitize(tt.content))
+		})
+	}
+}
+
+func TestNew_AllowsOnlyText(t *testing.T) {
+	assert.Equal(t, "Hello world", New().Sanitize("<p onclick=\"x\">Hello <b>world</b></p><script>alert(1)</script>"))
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{name: "inline markup", content: "<p>Hello <b>bold</b> world</p>", expected: "Hello bold world"},
+		{name: "paragraphs", content: "<h1>Title</h1><p>First</p><p>Second</p>", expected: "Title\n\nFirst\n\nSecond"},
+		{name: "line breaks", content: "<p>a<br>b</p>", expected: "a\nb"},
+		{name: "source newlines collapse", content: "<p>a\n  b</p>", expected: "a b"},
+		{name: "preformatted newlines kept", content: "<pre>a\n  b</pre>", expected: "a\nb"},
+		{name: "script and style dropped", content: "<style>p{}</style><p>a</p><script>alert(1)</script>", expected: "a"},
+		{name: "entities decoded", content: "<p>1 &lt; 2 &amp; 3</p>", expected: "1 < 2 & 3"},
+		{name: "empty", content: "<p> </p>", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Text(tt.content))
+		})
+	}
+}
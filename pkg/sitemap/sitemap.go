@@ -0,0 +1,155 @@
+// Package sitemap writes XML sitemaps, sitemap indexes and Google News
+// sitemaps.
+package sitemap
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// Limits of the sitemap protocol and of Google News sitemaps.
+const (
+	MaxURLs     = 50000
+	MaxNewsURLs = 1000
+)
+
+// ContentType is the content type of every sitemap.
+const ContentType = "application/xml; charset=utf-8"
+
+const (
+	_sitemapNS = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	_newsNS    = "http://www.google.com/schemas/sitemap-news/0.9"
+)
+
+// URL is a page listed in a sitemap. A zero LastMod is left out.
+type URL struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// Sitemap is a sitemap file listed in an index. A zero LastMod is left out.
+type Sitemap struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// Article is a news article listed in a Google News sitemap.
+type Article struct {
+	Loc       string
+	Title     string
+	Published time.Time
+}
+
+// Publication is the publication Google News lists articles under. Language
+// is an ISO 639 code, e.g. en.
+type Publication struct {
+	Name     string
+	Language string
+}
+
+type urlSet struct {
+	XMLName xml.Name `xml:"urlset"`
+	NS      string   `xml:"xmlns,attr"`
+	NewsNS  string   `xml:"xmlns:news,attr,omitempty"`
+	URLs    []urlEntry
+}
+
+type urlEntry struct {
+	XMLName xml.Name   `xml:"url"`
+	Loc     string     `xml:"loc"`
+	LastMod string     `xml:"lastmod,omitempty"`
+	News    *newsEntry `xml:"news:news,omitempty"`
+}
+
+type newsEntry struct {
+	Publication     newsPublication `xml:"news:publication"`
+	PublicationDate string          `xml:"news:publication_date"`
+	Title           string          `xml:"news:title"`
+}
+
+type newsPublication struct {
+	Name     string `xml:"news:name"`
+	Language string `xml:"news:language"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name `xml:"sitemapindex"`
+	NS       string   `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry
+}
+
+type sitemapEntry struct {
+	XMLName xml.Name `xml:"sitemap"`
+	Loc     string   `xml:"loc"`
+	LastMod string   `xml:"lastmod,omitempty"`
+}
+
+// WriteURLs writes a sitemap of urls.
+func WriteURLs(w io.Writer, urls []URL) error {
+	doc := urlSet{NS: _sitemapNS, URLs: make([]urlEntry, 0, len(urls))}
+
+	for _, u := range urls {
+		doc.URLs = append(doc.URLs, urlEntry{Loc: u.Loc, LastMod: lastMod(u.LastMod)})
+	}
+
+	return write(w, doc)
+}
+
+// WriteIndex writes a sitemap index of sitemaps.
+func WriteIndex(w io.Writer, sitemaps []Sitemap) error {
+	doc := sitemapIndex{NS: _sitemapNS, Sitemaps: make([]sitemapEntry, 0, len(sitemaps))}
+
+	for _, s := range sitemaps {
+		doc.Sitemaps = append(doc.Sitemaps, sitemapEntry{Loc: s.Loc, LastMod: lastMod(s.LastMod)})
+	}
+
+	return write(w, doc)
+}
+
+// WriteNews writes a Google News sitemap of articles of pub.
+func WriteNews(w io.Writer, pub Publication, articles []Article) error {
+	doc := urlSet{NS: _sitemapNS, NewsNS: _newsNS, URLs: make([]urlEntry, 0, len(articles))}
+
+	for _, a := range articles {
+		doc.URLs = append(doc.URLs, urlEntry{
+			Loc: a.Loc,
+			News: &newsEntry{
+				Publication:     newsPublication{Name: pub.Name, Language: pub.Language},
+				PublicationDate: a.Published.UTC().Format(time.RFC3339),
+				Title:           a.Title,
+			},
+		})
+	}
+
+	return write(w, doc)
+}
+
+func lastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func write(w io.Writer, doc any) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	if err := encoder.Encode(doc); err != nil {
+		return err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+
+	return err
+}
@@ -0,0 +1,118 @@
+package sitemap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testLastMod = time.Date(2024, time.March, 1, 12, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+
+func TestWriteURLs(t *testing.T) {
+	var body bytes.Buffer
+	require.NoError(t, WriteURLs(&body, []URL{
+		{Loc: "https://example.com/search?q=a&b=<c>", LastMod: testLastMod},
+		{Loc: `https://example.com/pages/"quoted"'s`},
+	}))
+
+	out := body.String()
+	assert.True(t, strings.HasPrefix(out, xml.Header))
+	assert.Contains(t, out, `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	assert.NotContains(t, out, "xmlns:news")
+	assert.Contains(t, out, "<loc>https://example.com/search?q=a&amp;b=&lt;c&gt;</loc>")
+	assert.Contains(t, out, "<lastmod>2024-03-01T05:00:00Z</lastmod>")
+	assert.Contains(t, out, "<loc>https://example.com/pages/&#34;quoted&#34;&#39;s</loc>")
+	assert.Equal(t, 1, strings.Count(out, "<lastmod>"), "a zero last modification time is left out")
+
+	// Every location reads back as it was written
+	var doc struct {
+		URLs []struct {
+			Loc string `xml:"loc"`
+		} `xml:"url"`
+	}
+	require.NoError(t, xml.Unmarshal(body.Bytes(), &doc))
+	require.Len(t, doc.URLs, 2)
+	assert.Equal(t, "https://example.com/search?q=a&b=<c>", doc.URLs[0].Loc)
+	assert.Equal(t, `https://example.com/pages/"quoted"'s`, doc.URLs[1].Loc)
+}
+
+func TestWriteIndex(t *testing.T) {
+	var body bytes.Buffer
+	require.NoError(t, WriteIndex(&body, []Sitemap{
+		{Loc: "https://example.com/sitemaps/news-1.xml?v=1&gz=0", LastMod: testLastMod},
+		{Loc: "https://example.com/sitemaps/pages-1.xml"},
+	}))
+
+	out := body.String()
+	assert.Contains(t, out, `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">`)
+	assert.Contains(t, out, "<loc>https://example.com/sitemaps/news-1.xml?v=1&amp;gz=0</loc>")
+	assert.Contains(t, out, "<lastmod>2024-03-01T05:00:00Z</lastmod>")
+	assert.Equal(t, 2, strings.Count(out, "<sitemap>"))
+	assert.Equal(t, 1, strings.Count(out, "<lastmod>"))
+}
+
+func TestWriteNews(t *testing.T) {
+	var body bytes.Buffer
+	require.NoError(t, WriteNews(&body, Publication{Name: "Tom & Jerry <News>", Language: "en"}, []Article{
+		{Loc: "https://example.com/news/1?a=1&b=2", Title: `1 < 2 & "three" > 2`, Published: testLastMod},
+	}))
+
+	out := body.String()
+	assert.Contains(t, out,
+		`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:news="http://www.google.com/schemas/sitemap-news/0.9">`)
+	assert.Contains(t, out, "<loc>https://example.com/news/1?a=1&amp;b=2</loc>")
+	assert.Contains(t, out, "<news:name>Tom &amp; Jerry &lt;News&gt;</news:name>")
+	assert.Contains(t, out, "<news:language>en</news:language>")
+	assert.Contains(t, out, "<news:publication_date>2024-03-01T05:00:00Z</news:publication_date>")
+	assert.Contains(t, out, "<news:title>1 &lt; 2 &amp; &#34;three&#34; &gt; 2</news:title>")
+	assert.NotContains(t, out, "<lastmod>")
+
+	// The title reads back as it was written
+	var doc struct {
+		URLs []struct {
+			Title string `xml:"news>title"`
+		} `xml:"url"`
+	}
+	require.NoError(t, xml.Unmarshal(body.Bytes(), &doc))
+	require.Len(t, doc.URLs, 1)
+	assert.Equal(t, `1 < 2 & "three" > 2`, doc.URLs[0].Title)
+}
+
+func TestWrite_Empty(t *testing.T) {
+	tests := []struct {
+		name     string
+		write    func(*bytes.Buffer) error
+		expected string
+	}{
+		{
+			name:     "urls",
+			write:    func(b *bytes.Buffer) error { return WriteURLs(b, nil) },
+			expected: `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></urlset>`,
+		},
+		{
+			name:     "index",
+			write:    func(b *bytes.Buffer) error { return WriteIndex(b, nil) },
+			expected: `<sitemapindex xmlns="http://www.sitemaps.org/schemas/sitemap/0.9"></sitemapindex>`,
+		},
+		{
+			name:  "news",
+			write: func(b *bytes.Buffer) error { return WriteNews(b, Publication{Name: "News", Language: "en"}, nil) },
+			expected: `<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" ` +
+				`xmlns:news="http://www.google.com/schemas/sitemap-news/0.9"></urlset>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body bytes.Buffer
+			require.NoError(t, tt.write(&body))
+
+			assert.Equal(t, xml.Header+tt.expected+"\n", body.String())
+		})
+	}
+}
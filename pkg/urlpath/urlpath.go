@@ -0,0 +1,50 @@
+// Package urlpath normalizes URL paths so that equivalent spellings of the
+// same path compare equal.
+package urlpath
+
+import (
+	"net/url"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+)
+
+// Normalize returns the canonical form of a URL path: a single leading
+// slash, no trailing slash, no empty or dot segments, lower case, and
+// percent-encoding only where a path segment requires it. "/About/",
+// "about" and "/%61bout" all normalize to "/about". Paths carrying a query,
+// a fragment, whitespace or control characters are rejected.
+func Normalize(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || strings.ContainsFunc(raw, invalidRune) {
+		return "", apperror.ErrInvalidPath
+	}
+
+	segments := strings.Split(raw, "/")
+	for i, segment := range segments {
+		decoded, err := url.PathUnescape(segment)
+		if err != nil || strings.Contains(decoded, "/") {
+			return "", apperror.ErrInvalidPath
+		}
+
+		segments[i] = strings.ToLower(decoded)
+	}
+
+	cleaned := path.Clean("/" + strings.Join(segments, "/"))
+	if cleaned == "/" {
+		return cleaned, nil
+	}
+
+	segments = strings.Split(cleaned[1:], "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return "/" + strings.Join(segments, "/"), nil
+}
+
+func invalidRune(r rune) bool {
+	return r == '?' || r == '#' || unicode.IsSpace(r) || unicode.IsControl(r)
+}
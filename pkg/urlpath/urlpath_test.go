@@ -0,0 +1,82 @@
+package urlpath
+
+import (
+	"testing"
+
+	"github.com/RizqiSugiarto/coding-test/pkg/apperror"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      string
+		expected string
+	}{
+		{name: "already canonical", raw: "/about", expected: "/about"},
+		{name: "root", raw: "/", expected: "/"},
+		{name: "missing leading slash", raw: "about", expected: "/about"},
+		{name: "trailing slash", raw: "/about/", expected: "/about"},
+		{name: "upper case", raw: "/About/Team", expected: "/about/team"},
+		{name: "surrounding whitespace", raw: "  /about  ", expected: "/about"},
+		{name: "empty segments", raw: "//about///team//", expected: "/about/team"},
+		{name: "dot segments", raw: "/about/./team/../history", expected: "/about/history"},
+		{name: "dot segments above the root", raw: "/../../about", expected: "/about"},
+		{name: "only dot segments", raw: "/./..", expected: "/"},
+		{name: "percent-encoded dot segments", raw: "/%2e%2E/about", expected: "/about"},
+		{name: "needlessly percent-encoded letters", raw: "/%61bout", expected: "/about"},
+		{name: "percent-encoded space", raw: "/about%20us", expected: "/about%20us"},
+		{name: "percent-encoded question mark", raw: "/faq%3f", expected: "/faq%3F"},
+		{name: "non-ASCII letters", raw: "/Café", expected: "/caf%C3%A9"},
+		{name: "percent-encoded non-ASCII letters", raw: "/caf%C3%A9", expected: "/caf%C3%A9"},
+		{name: "sub-delimiters kept", raw: "/a:b@c", expected: "/a:b@c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := Normalize(tt.raw)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, normalized)
+		})
+	}
+}
+
+func TestNormalize_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "empty", raw: ""},
+		{name: "only whitespace", raw: " \t "},
+		{name: "query", raw: "/about?lang=en"},
+		{name: "fragment", raw: "/about#team"},
+		{name: "inner space", raw: "/about us"},
+		{name: "tab", raw: "/about\tus"},
+		{name: "control character", raw: "/about\x00"},
+		{name: "non-breaking space", raw: "/about\u00a0us"},
+		{name: "percent-encoded slash", raw: "/about%2Fteam"},
+		{name: "malformed percent-encoding", raw: "/about%zz"},
+		{name: "truncated percent-encoding", raw: "/about%2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, err := Normalize(tt.raw)
+
+			assert.ErrorIs(t, err, apperror.ErrInvalidPath)
+			assert.Empty(t, normalized)
+		})
+	}
+}
+
+func TestNormalize_Idempotent(t *testing.T) {
+	for _, raw := range []string{"/About/", "/%61bout", "/Café/%20x", "//a/./b/..", "/faq%3f"} {
+		once, err := Normalize(raw)
+		assert.NoError(t, err)
+
+		twice, err := Normalize(once)
+		assert.NoError(t, err)
+		assert.Equal(t, once, twice, raw)
+	}
+}